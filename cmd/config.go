@@ -1,5 +1,7 @@
 package cmd
 
+import "time"
+
 const (
 	// ServerAddress is the default address for the gRPC server, if no override is specified in the flags
 	ServerAddress = "0.0.0.0:9090"
@@ -15,4 +17,20 @@ const (
 	// contacts application consists of only one service, so we identify both the
 	// service and the application as "atlas-contacts-app"
 	ApplicationID = "atlas-contacts-app"
+	// PurgeRetention is the default period deleted records are kept for before they are purged
+	PurgeRetention = 30 * 24 * time.Hour
+	// PurgeInterval is the default interval between two runs of the purge worker
+	PurgeInterval = time.Hour
+	// PurgeBatchSize is the default maximum number of rows of one account purged by a single statement
+	PurgeBatchSize = 500
+	// WebhookInterval is the default interval between two runs of the webhook delivery worker
+	WebhookInterval = 5 * time.Second
+	// WebhookMaxAttempts is the default number of attempts to deliver a webhook event
+	WebhookMaxAttempts = 8
+	// WebhookBackoff is the default delay before the first retry of a failed webhook delivery
+	WebhookBackoff = 30 * time.Second
+	// OutboxInterval is the default interval between two runs of the outbox relay
+	OutboxInterval = time.Second
+	// OutboxBatchSize is the default maximum number of outbox events published by one transaction
+	OutboxBatchSize = 100
 )
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/infobloxopen/atlas-contacts-app/pkg/carddav"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc"
+)
+
+// CardDAVPrefix is the path the CardDAV address books are served at
+const CardDAVPrefix = "/carddav/"
+
+// NewCardDAVHandler returns the handler of CardDAV requests which calls the
+// gRPC server listening on ServerAddress like the gateway does
+func NewCardDAVHandler() (*carddav.Handler, error) {
+	conn, err := grpc.Dial(ServerAddress, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	return carddav.NewHandler(CardDAVPrefix, pb.NewProfilesClient(conn), pb.NewContactsClient(conn)), nil
+}
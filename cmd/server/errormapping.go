@@ -28,7 +28,11 @@ var ErrorMappings = []errors.MapFunc{
 			"path/id", "the specified object was not found."),
 	),
 
-	pqerrors.NewUniqueMapping("emails_address_key", "Contacts", "Primary Email Address"),
+	// e-mail addresses are unique only within an account, so the conflict
+	// never reveals addresses stored by other tenants
+	pqerrors.NewUniqueMapping("emails_account_id_address_key", "Contacts", "Email Address"),
+
+	pqerrors.NewUniqueMapping("contacts_profile_id_carddav_name_key", "Contacts", "CardDAV Name"),
 
 	errors.NewMapping(
 		errors.CondHasPrefix("pq:"),
@@ -1,14 +1,21 @@
 package main
 
 import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	toolkit_auth "github.com/infobloxopen/atlas-app-toolkit/auth"
 	"github.com/infobloxopen/atlas-app-toolkit/errors"
 	"github.com/infobloxopen/atlas-app-toolkit/errors/mappers/validationerrors"
 	"github.com/infobloxopen/atlas-app-toolkit/gateway"
+	toolkit_gorm "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	"github.com/infobloxopen/atlas-app-toolkit/requestid"
 	"github.com/infobloxopen/atlas-contacts-app/cmd"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/audit"
 	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
 	"github.com/infobloxopen/atlas-contacts-app/pkg/svc"
 	"github.com/jinzhu/gorm"
@@ -31,28 +38,142 @@ func NewGRPCServer(logger *logrus.Logger, db *gorm.DB) (*grpc.Server, error) {
 		// authorization interceptor
 		interceptors = append(interceptors, toolkit_auth.UnaryServerInterceptor(AuthzAddr, cmd.ApplicationID))
 	}
+	// transaction interceptor runs every call in a database transaction which is
+	// committed if the call succeeds
+	interceptors = append(interceptors, toolkit_gorm.UnaryServerInterceptor(db))
+	// audit interceptor records changes of profiles, groups and contacts in the
+	// transaction of the call, so an audit event is committed with its change
+	interceptors = append(interceptors, audit.UnaryServerInterceptor())
+
+	// the toolkit provides unary interceptors only, streaming calls run within
+	// them and the received messages are validated as they arrive
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_logrus.StreamServerInterceptor(logrus.NewEntry(logger)),
+		wrapStreamServerInterceptor(requestid.UnaryServerInterceptor()),
+		wrapStreamServerInterceptor(errors.UnaryServerInterceptor(ErrorMappings...)),
+		validationStreamServerInterceptor,
+	}
+	if AuthzAddr != "" {
+		// streaming calls are authorized once, when they start
+		streamInterceptors = append(streamInterceptors, streamServerInterceptor(toolkit_auth.UnaryServerInterceptor(AuthzAddr, cmd.ApplicationID)))
+	}
 
 	// create new gRPC grpcServer with middleware chain
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(interceptors...)))
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(interceptors...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+	)
 
 	// register all of our services into the grpcServer
-	ps, err := svc.NewProfilesServer(db)
+	ps, err := svc.NewProfilesServer()
 	if err != nil {
 		return nil, err
 	}
 	pb.RegisterProfilesServer(grpcServer, ps)
 
-	gs, err := svc.NewGroupsServer(db)
+	gs, err := svc.NewGroupsServer()
 	if err != nil {
 		return nil, err
 	}
 	pb.RegisterGroupsServer(grpcServer, gs)
 
-	cs, err := svc.NewContactsServer(db)
+	sender, err := NewSMSSender(logger)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := svc.NewContactsServer(db, sender, NewContactEvents(logger), PurgeRetention)
 	if err != nil {
 		return nil, err
 	}
 	pb.RegisterContactsServer(grpcServer, cs)
 
+	as, err := svc.NewAuditLogServer()
+	if err != nil {
+		return nil, err
+	}
+	pb.RegisterAuditLogServer(grpcServer, as)
+
+	ws, err := svc.NewWebhooksServer()
+	if err != nil {
+		return nil, err
+	}
+	pb.RegisterWebhooksServer(grpcServer, ws)
+
+	ss, err := svc.NewSavedSearchesServer()
+	if err != nil {
+		return nil, err
+	}
+	pb.RegisterSavedSearchesServer(grpcServer, ss)
+
 	return grpcServer, nil
 }
+
+// NewSMSSender creates the SMS sender selected by the command-line flags:
+// the webhook sender if a webhook URL is provided, otherwise the local sender
+// which writes messages to the SMS log file or to the application log.
+func NewSMSSender(logger *logrus.Logger) (svc.SMSSender, error) {
+	if SMSWebhookURL != "" {
+		return svc.NewWebhookSMSSender(SMSWebhookURL, &http.Client{Timeout: 10 * time.Second}), nil
+	}
+	if SMSLogFile != "" {
+		f, err := os.OpenFile(SMSLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		return svc.NewLocalSMSSender(f), nil
+	}
+	return svc.NewLocalSMSSender(logger.Out), nil
+}
+
+// streamServerInterceptor applies a unary interceptor to the start of
+// streaming calls. It is meant for interceptors which only check the caller,
+// the request passed to them is nil.
+func streamServerInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		noop := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		}
+		if _, err := interceptor(ss.Context(), nil, &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}, noop); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// wrapStreamServerInterceptor applies a unary interceptor to the whole of
+// streaming calls: the handler runs within the interceptor with the context the
+// interceptor passes on, and its error is returned through the interceptor.
+func wrapStreamServerInterceptor(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := func(ctx context.Context, req interface{}) (interface{}, error) {
+			stream := grpc_middleware.WrapServerStream(ss)
+			stream.WrappedContext = ctx
+			return nil, handler(srv, stream)
+		}
+		_, err := interceptor(ss.Context(), nil, &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}, wrapped)
+		return err
+	}
+}
+
+// validationStreamServerInterceptor validates every message received from the
+// client, like the validation interceptor validates the requests of unary calls
+func validationStreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, validatingServerStream{ss})
+}
+
+// validatingServerStream rejects the received messages which aren't valid
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if v, ok := m.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return validationerrors.GetValidationError(err)
+		}
+	}
+	return nil
+}
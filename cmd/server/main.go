@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"flag"
 	"net"
 
@@ -9,6 +11,9 @@ import (
 
 	"fmt"
 	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
 	"time"
 
 	"database/sql"
@@ -19,27 +24,52 @@ import (
 	"github.com/infobloxopen/atlas-app-toolkit/server"
 	"github.com/infobloxopen/atlas-contacts-app/cmd"
 	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/svc"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 var (
-	ServerAddress      string
-	GatewayAddress     string
-	InternalAddress    string
-	SwaggerDir         string
-	DBConnectionString string
-	AuthzAddr          string
-	LogLevel           string
+	ServerAddress       string
+	GatewayAddress      string
+	InternalAddress     string
+	SwaggerDir          string
+	DBConnectionString  string
+	AuthzAddr           string
+	LogLevel            string
+	SMSWebhookURL       string
+	SMSLogFile          string
+	PurgeRetention      time.Duration
+	PurgeInterval       time.Duration
+	PurgeBatchSize      int
+	WebhookInterval     time.Duration
+	WebhookMaxAttempts  int
+	WebhookBackoff      time.Duration
+	WebhookAllowPrivate bool
+	OutboxInterval      time.Duration
+	OutboxBatchSize     int
+	OutboxLogFile       string
 )
 
 func main() {
-	doneC := make(chan error)
 	logger := NewLogger()
 
+	// "server purge" purges deleted records once and exits
+	if flag.Arg(0) == "purge" {
+		if err := RunPurge(logger); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+
+	doneC := make(chan error)
+
 	go func() { doneC <- ServeInternal(logger) }()
 	go func() { doneC <- ServeExternal(logger) }()
 
@@ -57,6 +87,18 @@ func init() {
 	flag.StringVar(&DBConnectionString, "db", cmd.DBConnectionString, "the database address")
 	flag.StringVar(&AuthzAddr, "authz", "", "address of the authorization service")
 	flag.StringVar(&LogLevel, "log", "info", "log level")
+	flag.StringVar(&SMSWebhookURL, "sms-webhook", "", "URL of the webhook used to deliver SMS messages")
+	flag.StringVar(&SMSLogFile, "sms-log", "", "file where SMS messages are written if no SMS webhook is provided")
+	flag.DurationVar(&PurgeRetention, "purge-retention", cmd.PurgeRetention, "period deleted records are kept for before they are purged")
+	flag.DurationVar(&PurgeInterval, "purge-interval", cmd.PurgeInterval, "interval between purges of deleted records, 0 disables the purge worker")
+	flag.IntVar(&PurgeBatchSize, "purge-batch-size", cmd.PurgeBatchSize, "maximum number of rows of one account purged at once")
+	flag.DurationVar(&WebhookInterval, "webhook-interval", cmd.WebhookInterval, "interval between deliveries of pending webhook events, 0 disables the delivery worker")
+	flag.IntVar(&WebhookMaxAttempts, "webhook-max-attempts", cmd.WebhookMaxAttempts, "maximum number of attempts to deliver a webhook event")
+	flag.DurationVar(&WebhookBackoff, "webhook-backoff", cmd.WebhookBackoff, "delay before the first retry of a failed webhook delivery, it doubles after every attempt")
+	flag.BoolVar(&WebhookAllowPrivate, "webhook-allow-private", false, "allow webhook deliveries to private, loopback and link-local addresses, e.g. in development")
+	flag.DurationVar(&OutboxInterval, "outbox-interval", cmd.OutboxInterval, "interval between relays of the outbox events, 0 disables the outbox relay")
+	flag.IntVar(&OutboxBatchSize, "outbox-batch-size", cmd.OutboxBatchSize, "maximum number of outbox events published by one transaction")
+	flag.StringVar(&OutboxLogFile, "outbox-log", "", "file where the outbox events are written in addition to the webhook subscribers")
 	flag.Parse()
 	resource.RegisterApplication(cmd.ApplicationID)
 }
@@ -97,6 +139,8 @@ func ServeInternal(logger *logrus.Logger) error {
 			w.WriteHeader(200)
 			w.Write([]byte("pong"))
 		})),
+		// expose application metrics, e.g. the number of purged records
+		server.WithHandler("/debug/vars", expvar.Handler()),
 	)
 	if err != nil {
 		return err
@@ -129,6 +173,36 @@ func ServeExternal(logger *logrus.Logger) error {
 		return err
 	}
 
+	// purge records deleted longer than the retention period ago
+	if PurgeInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go NewPurger(logger, db).Run(ctx, PurgeInterval)
+	}
+
+	// publish the events of changed resources stored in the outbox
+	if OutboxInterval > 0 {
+		relay, err := NewOutboxRelay(logger, db)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go relay.Run(ctx, OutboxInterval)
+	}
+
+	// deliver the events of changed resources to the webhook subscribers
+	if WebhookInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go NewWebhookWorker(logger, db).Run(ctx, WebhookInterval)
+	}
+
+	cardDAV, err := NewCardDAVHandler()
+	if err != nil {
+		return err
+	}
+
 	s, err := server.NewServer(
 		// register our grpc server
 		server.WithGrpcServer(grpcServer),
@@ -136,6 +210,12 @@ func ServeExternal(logger *logrus.Logger) error {
 		server.WithGateway(
 			gateway.WithGatewayOptions(
 				runtime.WithMetadata(gateway.NewPresenceAnnotator("PUT")),
+				runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher),
+				runtime.WithProtoErrorHandler(ProtoErrorHandler),
+				runtime.WithMetadata(ImportOptionsAnnotator),
+				// imported CSV and vCard files are uploaded as the raw request body
+				runtime.WithMarshalerOption(svc.CSVContentType, &pb.FileMarshaler{JSONPb: runtime.JSONPb{OrigName: true}}),
+				runtime.WithMarshalerOption(vcard.ContentType, &pb.FileMarshaler{JSONPb: runtime.JSONPb{OrigName: true}}),
 			),
 			 gateway.WithDialOptions(
 				[]grpc.DialOption{grpc.WithInsecure(), grpc.WithUnaryInterceptor(
@@ -145,12 +225,17 @@ func ServeExternal(logger *logrus.Logger) error {
 				)}...,
 			),
 			gateway.WithServerAddress(ServerAddress),
-			gateway.WithEndpointRegistration("/v1/", pb.RegisterProfilesHandlerFromEndpoint, pb.RegisterGroupsHandlerFromEndpoint, pb.RegisterContactsHandlerFromEndpoint),
+			// the handlers match in the order of registration, GET /contacts/{id}.vcf
+			// works only if the files handler precedes the contacts handler
+			gateway.WithEndpointRegistration("/v1/", pb.RegisterContactsFilesHandlerFromEndpoint, pb.RegisterProfilesHandlerFromEndpoint, pb.RegisterGroupsHandlerFromEndpoint, pb.RegisterContactsHandlerFromEndpoint, pb.RegisterAuditLogHandlerFromEndpoint, pb.RegisterWebhooksHandlerFromEndpoint, pb.RegisterSavedSearchesHandlerFromEndpoint),
 		),
 		// serve swagger at the root
 		server.WithHandler("/swagger", http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 			http.ServeFile(writer, request, SwaggerDir)
 		})),
+		// serve the profiles as CardDAV address books
+		server.WithHandler(CardDAVPrefix, cardDAV),
+		server.WithHandler("/.well-known/carddav", http.RedirectHandler(CardDAVPrefix, http.StatusMovedPermanently)),
 	)
 	if err != nil {
 		return err
@@ -172,6 +257,62 @@ func ServeExternal(logger *logrus.Logger) error {
 	return s.Serve(grpcL, gatewayL)
 }
 
+// IncomingHeaderMatcher forwards the Last-Event-ID header sent by reconnecting
+// clients of server-sent events in addition to the default headers
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "Last-Event-Id" {
+		return svc.LastEventIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// ImportOptionsAnnotator passes the options of an import, which are the query
+// parameters dry_run, format and mapping.<column>=<field>, as request metadata
+// because the body of the request is the imported file
+func ImportOptionsAnnotator(ctx context.Context, req *http.Request) metadata.MD {
+	if !strings.HasSuffix(req.URL.Path, ":import") {
+		return nil
+	}
+	var pairs []string
+	for key, vals := range req.URL.Query() {
+		switch {
+		case key == "dry_run":
+			pairs = append(pairs, svc.ImportDryRunMetadata, vals[0])
+		case key == "format":
+			pairs = append(pairs, svc.ImportFormatMetadata, vals[0])
+		case strings.HasPrefix(key, "mapping."):
+			mapping := url.Values{strings.TrimPrefix(key, "mapping."): vals[:1]}
+			pairs = append(pairs, svc.ImportMappingMetadata, mapping.Encode())
+		}
+	}
+	return metadata.Pairs(pairs...)
+}
+
+// ProtoErrorHandler is gateway.ProtoMessageErrorHandler which responds with
+// 412 Precondition Failed instead of 400 Bad Request if a change is rejected
+// because the provided entity tag is outdated
+func ProtoErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
+	if !svc.IsPreconditionFailed(err) {
+		gateway.ProtoMessageErrorHandler(ctx, mux, marshaler, w, req, err)
+		return
+	}
+	st := status.Convert(err)
+	buf, err := marshaler.Marshal(&gateway.RestError{
+		Status: &gateway.RestStatus{
+			HTTPStatus: http.StatusPreconditionFailed,
+			Code:       gateway.CodeName(st.Code()),
+			Message:    st.Message(),
+		},
+	})
+	if err != nil {
+		gateway.ProtoMessageErrorHandler(ctx, mux, marshaler, w, req, err)
+		return
+	}
+	w.Header().Set("Content-Type", marshaler.ContentType())
+	w.WriteHeader(http.StatusPreconditionFailed)
+	w.Write(buf)
+}
+
 func dbReady() error {
 	db, err := gorm.Open("postgres", DBConnectionString)
 	if err != nil {
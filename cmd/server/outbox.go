@@ -0,0 +1,28 @@
+package main
+
+import (
+	"os"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/outbox"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/webhook"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// NewOutboxRelay creates the relay of outbox events configured by the
+// command-line flags. The events are queued for the webhook subscribers and
+// written to the outbox log file if one is provided.
+func NewOutboxRelay(logger *logrus.Logger, db *gorm.DB) (*outbox.Relay, error) {
+	publishers := []outbox.EventPublisher{webhook.NewPublisher(db)}
+	if OutboxLogFile != "" {
+		f, err := os.OpenFile(OutboxLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		publishers = append(publishers, outbox.NewFilePublisher(f))
+	}
+	return outbox.NewRelay(
+		db, outbox.MultiPublisher(publishers...), OutboxBatchSize,
+		logrus.NewEntry(logger).WithField("worker", "outbox"),
+	), nil
+}
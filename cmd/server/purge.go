@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/purge"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// NewPurger creates the purger of deleted records configured by the
+// command-line flags
+func NewPurger(logger *logrus.Logger, db *gorm.DB) *purge.Purger {
+	return purge.NewPurger(db, PurgeRetention, PurgeBatchSize, logrus.NewEntry(logger).WithField("worker", "purge"))
+}
+
+// RunPurge purges deleted records once and returns
+func RunPurge(logger *logrus.Logger) error {
+	db, err := gorm.Open("postgres", DBConnectionString)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = NewPurger(logger, db).Purge(context.Background())
+	return err
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/svc"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+// NewContactEvents starts listening to the notifications about contact events
+// which wake up the watchers of contacts
+func NewContactEvents(logger *logrus.Logger) *svc.ContactEvents {
+	log := logrus.NewEntry(logger).WithField("worker", "contact-events")
+	listener := pq.NewListener(DBConnectionString, 10*time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Errorf("contact events listener: %v", err)
+		}
+	})
+
+	events := svc.NewContactEvents()
+	go func() {
+		if err := events.Listen(context.Background(), listener); err != nil {
+			log.Errorf("unable to listen to contact events: %v", err)
+		}
+	}()
+	return events
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/webhook"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// NewWebhookWorker creates the webhook delivery worker configured by the
+// command-line flags
+func NewWebhookWorker(logger *logrus.Logger, db *gorm.DB) *webhook.Worker {
+	return webhook.NewWorker(
+		db, webhook.NewClient(10*time.Second, WebhookAllowPrivate), WebhookMaxAttempts, WebhookBackoff,
+		logrus.NewEntry(logger).WithField("worker", "webhook"),
+	)
+}
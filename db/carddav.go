@@ -0,0 +1,13 @@
+package db
+
+// carddavNamesSQL makes the CardDAV resource names of contacts unique within
+// their address book. It is the same as the
+// migrations/0020_carddav_names.up.sql migration, keep them in sync.
+const carddavNamesSQL = `
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS carddav_name text NOT NULL DEFAULT '';
+
+-- a CardDAV resource name identifies a contact within its address book, the
+-- names of deleted contacts can be reused
+CREATE UNIQUE INDEX IF NOT EXISTS contacts_profile_id_carddav_name_key ON contacts (profile_id, carddav_name)
+  WHERE carddav_name <> '' AND deleted_at IS NULL;
+`
@@ -0,0 +1,53 @@
+package db
+
+// emailsUniqueSQL makes the e-mail addresses unique within an account only
+// among the contacts which aren't deleted. It is the same as the
+// migrations/0005_emails_account_address.up.sql and
+// 0017_deleted_contact_emails.up.sql migrations, keep them in sync.
+const emailsUniqueSQL = `
+-- the addresses of the baseline schema are unique across all the accounts
+ALTER TABLE emails DROP CONSTRAINT IF EXISTS emails_address_key;
+
+UPDATE emails SET account_id = contacts.account_id
+  FROM contacts
+  WHERE emails.contact_id = contacts.id AND emails.account_id IS NULL;
+
+ALTER TABLE emails ADD COLUMN IF NOT EXISTS contact_deleted boolean NOT NULL DEFAULT false;
+
+UPDATE emails SET contact_deleted = true
+  FROM contacts
+  WHERE emails.contact_id = contacts.id AND contacts.deleted_at IS NOT NULL AND NOT emails.contact_deleted;
+
+-- the unique constraint of e-mail addresses within an account, which covers
+-- the addresses of deleted contacts too, is replaced by the partial index
+DO $$
+  BEGIN
+    IF EXISTS (
+      SELECT 1 FROM pg_index JOIN pg_class ON pg_class.oid = pg_index.indexrelid
+      WHERE pg_class.relname = 'emails_account_id_address_key' AND pg_index.indpred IS NULL
+    ) THEN
+      ALTER TABLE emails DROP CONSTRAINT IF EXISTS emails_account_id_address_key;
+      DROP INDEX IF EXISTS emails_account_id_address_key;
+    END IF;
+  END $$;
+
+-- the e-mail addresses of deleted contacts can be used by other contacts
+CREATE UNIQUE INDEX IF NOT EXISTS emails_account_id_address_key ON emails (account_id, address)
+  WHERE NOT contact_deleted;
+
+-- contact_emails_deleted marks the e-mail addresses of a contact when it is
+-- deleted or undeleted, undeleting fails if one of the addresses is taken
+CREATE OR REPLACE FUNCTION contact_emails_deleted()
+  RETURNS trigger as $$
+  BEGIN
+    UPDATE emails SET contact_deleted = NEW.deleted_at IS NOT NULL WHERE contact_id = NEW.id;
+    RETURN NULL;
+  END $$ language plpgsql;
+
+DROP TRIGGER IF EXISTS contact_emails_deleted ON contacts;
+CREATE TRIGGER contact_emails_deleted
+  AFTER UPDATE OF deleted_at ON contacts
+  FOR EACH ROW
+  WHEN (OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+  EXECUTE PROCEDURE contact_emails_deleted();
+`
@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 
+	"github.com/infobloxopen/atlas-contacts-app/pkg/outbox"
 	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
@@ -18,7 +19,23 @@ func MigrateDB(dbSQL sql.DB) error {
 	// NOTE: Using db.AutoMigrate is a temporary measure to structure the contacts
 	// database schema. The atlas-app-toolkit team will come up with a better
 	// solution that uses database migration files.
-	return db.AutoMigrate(
-		&pb.ProfileORM{}, &pb.GroupORM{}, &pb.ContactORM{}, &pb.AddressORM{}, &pb.EmailORM{},
-	).Error
+	if err := db.AutoMigrate(
+		&pb.ProfileORM{}, &pb.GroupORM{}, &pb.ContactORM{}, &pb.AddressORM{}, &pb.EmailORM{}, &pb.PhoneNumberORM{},
+		&pb.AuditEventORM{}, &pb.WebhookSubscriptionORM{}, &pb.WebhookDeliveryORM{}, &pb.SavedSearchORM{},
+		&outbox.Event{},
+	).Error; err != nil {
+		return err
+	}
+	// the triggers of contact events and of group memberships can't be created
+	// by db.AutoMigrate, neither can the search document and the trigram
+	// indexes of contacts nor the partial unique indexes of e-mail addresses
+	// within an account and of CardDAV names within an address book
+	for _, stmt := range []string{
+		emailsUniqueSQL, carddavNamesSQL, contactEventsSQL, contactGroupsChangedSQL, contactSearchSQL, contactSuggestSQL,
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,4 @@
+
+DROP TRIGGER phone_numbers_updated_at on phone_numbers;
+
+DROP TABLE phone_numbers;
@@ -0,0 +1,16 @@
+
+CREATE TABLE phone_numbers (
+  id serial primary key,
+  created_at timestamptz DEFAULT current_timestamp,
+  updated_at timestamptz DEFAULT NULL,
+  is_primary boolean DEFAULT false,
+  number varchar(16) DEFAULT NULL,
+  type int DEFAULT 0,
+  account_id varchar(255),
+  contact_id int REFERENCES contacts(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER phone_numbers_updated_at
+  BEFORE UPDATE OR INSERT ON phone_numbers
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
@@ -0,0 +1,5 @@
+
+-- NOTE: this fails if the same address is used by more than one account
+ALTER TABLE emails DROP CONSTRAINT emails_account_id_address_key;
+
+ALTER TABLE emails ADD CONSTRAINT emails_address_key UNIQUE (address);
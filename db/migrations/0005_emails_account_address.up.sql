@@ -0,0 +1,8 @@
+
+UPDATE emails SET account_id = contacts.account_id
+  FROM contacts
+  WHERE emails.contact_id = contacts.id AND emails.account_id IS NULL;
+
+ALTER TABLE emails DROP CONSTRAINT emails_address_key;
+
+ALTER TABLE emails ADD CONSTRAINT emails_account_id_address_key UNIQUE (account_id, address);
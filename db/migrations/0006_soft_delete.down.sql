@@ -0,0 +1,9 @@
+
+-- rows which are only marked as deleted are removed for good
+DELETE FROM contacts WHERE deleted_at IS NOT NULL;
+DELETE FROM groups WHERE deleted_at IS NOT NULL;
+DELETE FROM profiles WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE contacts DROP COLUMN deleted_at;
+ALTER TABLE groups DROP COLUMN deleted_at;
+ALTER TABLE profiles DROP COLUMN deleted_at;
@@ -0,0 +1,8 @@
+
+ALTER TABLE contacts ADD COLUMN deleted_at timestamptz DEFAULT NULL;
+ALTER TABLE groups ADD COLUMN deleted_at timestamptz DEFAULT NULL;
+ALTER TABLE profiles ADD COLUMN deleted_at timestamptz DEFAULT NULL;
+
+CREATE INDEX contacts_deleted_at_idx ON contacts (deleted_at);
+CREATE INDEX groups_deleted_at_idx ON groups (deleted_at);
+CREATE INDEX profiles_deleted_at_idx ON profiles (deleted_at);
@@ -0,0 +1,13 @@
+
+CREATE TABLE audit_events (
+  id serial primary key,
+  account_id varchar(255),
+  created_at timestamptz DEFAULT current_timestamp,
+  subject text,
+  request_id text,
+  method text,
+  resource_id text,
+  diff jsonb
+);
+
+CREATE INDEX audit_events_account_id_created_at_idx ON audit_events (account_id, created_at);
@@ -0,0 +1,4 @@
+
+ALTER TABLE contacts DROP COLUMN version;
+ALTER TABLE groups DROP COLUMN version;
+ALTER TABLE profiles DROP COLUMN version;
@@ -0,0 +1,4 @@
+
+ALTER TABLE contacts ADD COLUMN version bigint NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN version bigint NOT NULL DEFAULT 0;
+ALTER TABLE profiles ADD COLUMN version bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+DROP INDEX contacts_updated_at_idx;
+
+DROP TRIGGER groups_updated_at ON groups;
+DROP TRIGGER profiles_updated_at ON profiles;
+
+ALTER TABLE groups DROP COLUMN updated_at;
+ALTER TABLE groups DROP COLUMN created_at;
+ALTER TABLE profiles DROP COLUMN updated_at;
+ALTER TABLE profiles DROP COLUMN created_at;
@@ -0,0 +1,16 @@
+ALTER TABLE profiles ADD COLUMN created_at timestamptz DEFAULT current_timestamp;
+ALTER TABLE profiles ADD COLUMN updated_at timestamptz DEFAULT NULL;
+ALTER TABLE groups ADD COLUMN created_at timestamptz DEFAULT current_timestamp;
+ALTER TABLE groups ADD COLUMN updated_at timestamptz DEFAULT NULL;
+
+CREATE TRIGGER profiles_updated_at
+  BEFORE UPDATE OR INSERT ON profiles
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE TRIGGER groups_updated_at
+  BEFORE UPDATE OR INSERT ON groups
+  FOR EACH ROW
+  EXECUTE PROCEDURE set_updated_at();
+
+CREATE INDEX contacts_updated_at_idx ON contacts (updated_at);
@@ -0,0 +1,10 @@
+DROP TRIGGER group_contacts_contact_event ON group_contacts;
+DROP TRIGGER phone_numbers_contact_event ON phone_numbers;
+DROP TRIGGER emails_contact_event ON emails;
+DROP TRIGGER contacts_event ON contacts;
+
+DROP FUNCTION contact_child_event();
+DROP FUNCTION contacts_event();
+DROP FUNCTION add_contact_event(text, int, text);
+
+DROP TABLE contact_events;
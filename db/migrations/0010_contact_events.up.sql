@@ -0,0 +1,93 @@
+CREATE TABLE IF NOT EXISTS contact_events (
+  id bigserial primary key,
+  account_id text,
+  contact_id int,
+  type text,
+  txid bigint DEFAULT txid_current(),
+  created_at timestamptz DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS contact_events_account_id_id_idx ON contact_events (account_id, id);
+
+-- add_contact_event stores an event of a contact and notifies the watchers of
+-- the account, an UPDATED event is stored only once per contact and transaction
+CREATE OR REPLACE FUNCTION add_contact_event(event_account_id text, event_contact_id int, event_type text)
+  RETURNS void as $$
+  BEGIN
+    IF event_type = 'UPDATED' AND EXISTS (
+      SELECT 1 FROM contact_events WHERE contact_id = event_contact_id AND txid = txid_current()
+    ) THEN
+      RETURN;
+    END IF;
+    -- the events of an account are committed in the order of their ids,
+    -- otherwise a watcher could skip an event committed after a newer one
+    PERFORM pg_advisory_xact_lock(hashtext('contact_events:' || event_account_id));
+    INSERT INTO contact_events (account_id, contact_id, type)
+      VALUES (event_account_id, event_contact_id, event_type);
+    PERFORM pg_notify('contact_events', event_account_id);
+  END $$ language plpgsql;
+
+CREATE OR REPLACE FUNCTION contacts_event()
+  RETURNS trigger as $$
+  BEGIN
+    IF TG_OP = 'INSERT' THEN
+      PERFORM add_contact_event(NEW.account_id, NEW.id, 'CREATED');
+    ELSIF TG_OP = 'DELETE' THEN
+      -- purged contacts were reported when they were marked as deleted
+      IF OLD.deleted_at IS NULL THEN
+        PERFORM add_contact_event(OLD.account_id, OLD.id, 'DELETED');
+      END IF;
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+      PERFORM add_contact_event(NEW.account_id, NEW.id, 'DELETED');
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+      PERFORM add_contact_event(NEW.account_id, NEW.id, 'CREATED');
+    ELSIF NEW.deleted_at IS NULL AND
+      to_jsonb(NEW) - 'version' - 'updated_at' <> to_jsonb(OLD) - 'version' - 'updated_at' THEN
+      PERFORM add_contact_event(NEW.account_id, NEW.id, 'UPDATED');
+    END IF;
+    RETURN NULL;
+  END $$ language plpgsql;
+
+-- contact_child_event reports a change of a row which belongs to a contact,
+-- e.g. an e-mail address, as an update of the contact
+CREATE OR REPLACE FUNCTION contact_child_event()
+  RETURNS trigger as $$
+  DECLARE
+    child_contact_id int;
+    contact record;
+  BEGIN
+    IF TG_OP = 'DELETE' THEN
+      child_contact_id := OLD.contact_id;
+    ELSE
+      child_contact_id := NEW.contact_id;
+    END IF;
+    SELECT id, account_id INTO contact FROM contacts WHERE id = child_contact_id AND deleted_at IS NULL;
+    IF FOUND THEN
+      PERFORM add_contact_event(contact.account_id, contact.id, 'UPDATED');
+    END IF;
+    RETURN NULL;
+  END $$ language plpgsql;
+
+DROP TRIGGER IF EXISTS contacts_event ON contacts;
+CREATE TRIGGER contacts_event
+  AFTER INSERT OR UPDATE OR DELETE ON contacts
+  FOR EACH ROW
+  EXECUTE PROCEDURE contacts_event();
+
+DROP TRIGGER IF EXISTS emails_contact_event ON emails;
+CREATE TRIGGER emails_contact_event
+  AFTER INSERT OR UPDATE OR DELETE ON emails
+  FOR EACH ROW
+  EXECUTE PROCEDURE contact_child_event();
+
+DROP TRIGGER IF EXISTS phone_numbers_contact_event ON phone_numbers;
+CREATE TRIGGER phone_numbers_contact_event
+  AFTER INSERT OR UPDATE OR DELETE ON phone_numbers
+  FOR EACH ROW
+  EXECUTE PROCEDURE contact_child_event();
+
+DROP TRIGGER IF EXISTS group_contacts_contact_event ON group_contacts;
+CREATE TRIGGER group_contacts_contact_event
+  AFTER INSERT OR UPDATE OR DELETE ON group_contacts
+  FOR EACH ROW
+  EXECUTE PROCEDURE contact_child_event();
@@ -0,0 +1,28 @@
+CREATE TABLE webhook_subscriptions
+(
+  id serial primary key,
+  account_id text,
+  url text,
+  event_types text,
+  secret text,
+  disabled boolean DEFAULT false
+);
+
+CREATE INDEX webhook_subscriptions_account_id_idx ON webhook_subscriptions (account_id);
+
+CREATE TABLE webhook_deliveries
+(
+  id serial primary key,
+  account_id text,
+  subscription_id int REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+  event_type text,
+  payload jsonb,
+  status int DEFAULT 0,
+  attempts int DEFAULT 0,
+  last_status_code int DEFAULT 0,
+  last_error text,
+  next_attempt_at timestamptz DEFAULT current_timestamp,
+  created_at timestamptz DEFAULT current_timestamp
+);
+
+CREATE INDEX webhook_deliveries_status_next_attempt_at_idx ON webhook_deliveries (status, next_attempt_at);
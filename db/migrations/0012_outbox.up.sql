@@ -0,0 +1,11 @@
+CREATE TABLE outbox
+(
+  id bigserial primary key,
+  account_id text,
+  type text,
+  payload jsonb,
+  created_at timestamptz DEFAULT current_timestamp,
+  published_at timestamptz
+);
+
+CREATE INDEX outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
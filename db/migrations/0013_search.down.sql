@@ -0,0 +1,12 @@
+DROP TRIGGER addresses_contact_search ON addresses;
+DROP TRIGGER emails_contact_search ON emails;
+DROP TRIGGER contacts_search ON contacts;
+
+DROP FUNCTION contact_child_search();
+DROP FUNCTION contacts_search();
+DROP FUNCTION contact_search_document(contacts);
+DROP FUNCTION contact_search_text(contacts);
+DROP FUNCTION contact_nicknames(jsonb);
+
+DROP INDEX contacts_search_idx;
+ALTER TABLE contacts DROP COLUMN search;
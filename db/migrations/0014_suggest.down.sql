@@ -0,0 +1,7 @@
+DROP INDEX emails_primary_address_trgm_idx;
+DROP INDEX contacts_nicknames_trgm_idx;
+DROP INDEX contacts_name_trgm_idx;
+
+DROP FUNCTION contact_name(text, text, text);
+
+DROP EXTENSION pg_trgm;
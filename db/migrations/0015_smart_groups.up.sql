@@ -0,0 +1 @@
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS member_filter text;
@@ -0,0 +1,12 @@
+CREATE TABLE saved_searches
+(
+  id serial primary key,
+  account_id text,
+  name text,
+  profile_id int REFERENCES profiles(id) ON DELETE CASCADE,
+  filter text,
+  order_by text,
+  fields text
+);
+
+CREATE INDEX saved_searches_account_id_idx ON saved_searches (account_id);
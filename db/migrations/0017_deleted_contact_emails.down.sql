@@ -0,0 +1,9 @@
+DROP TRIGGER contact_emails_deleted ON contacts;
+
+DROP FUNCTION contact_emails_deleted();
+
+DROP INDEX emails_account_id_address_key;
+
+ALTER TABLE emails ADD CONSTRAINT emails_account_id_address_key UNIQUE (account_id, address);
+
+ALTER TABLE emails DROP COLUMN contact_deleted;
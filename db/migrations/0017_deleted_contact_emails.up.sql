@@ -0,0 +1,26 @@
+ALTER TABLE emails ADD COLUMN contact_deleted boolean NOT NULL DEFAULT false;
+
+UPDATE emails SET contact_deleted = true
+  FROM contacts
+  WHERE emails.contact_id = contacts.id AND contacts.deleted_at IS NOT NULL;
+
+-- the e-mail addresses of deleted contacts can be used by other contacts
+ALTER TABLE emails DROP CONSTRAINT emails_account_id_address_key;
+
+CREATE UNIQUE INDEX emails_account_id_address_key ON emails (account_id, address)
+  WHERE NOT contact_deleted;
+
+-- contact_emails_deleted marks the e-mail addresses of a contact when it is
+-- deleted or undeleted, undeleting fails if one of the addresses is taken
+CREATE OR REPLACE FUNCTION contact_emails_deleted()
+  RETURNS trigger as $$
+  BEGIN
+    UPDATE emails SET contact_deleted = NEW.deleted_at IS NOT NULL WHERE contact_id = NEW.id;
+    RETURN NULL;
+  END $$ language plpgsql;
+
+CREATE TRIGGER contact_emails_deleted
+  AFTER UPDATE OF deleted_at ON contacts
+  FOR EACH ROW
+  WHEN (OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+  EXECUTE PROCEDURE contact_emails_deleted();
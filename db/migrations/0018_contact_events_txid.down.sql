@@ -0,0 +1 @@
+DROP INDEX contact_events_account_id_txid_id_idx;
@@ -0,0 +1,2 @@
+-- Sync reads the events of an account in the order of their transactions
+CREATE INDEX contact_events_account_id_txid_id_idx ON contact_events (account_id, txid, id);
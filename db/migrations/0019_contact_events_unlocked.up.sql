@@ -0,0 +1,14 @@
+-- the events are read in the order of their transactions, the events of an
+-- account don't have to be committed in the order of their ids any more
+CREATE OR REPLACE FUNCTION add_contact_event(event_account_id text, event_contact_id int, event_type text)
+  RETURNS void as $$
+  BEGIN
+    IF event_type = 'UPDATED' AND EXISTS (
+      SELECT 1 FROM contact_events WHERE contact_id = event_contact_id AND txid = txid_current()
+    ) THEN
+      RETURN;
+    END IF;
+    INSERT INTO contact_events (account_id, contact_id, type)
+      VALUES (event_account_id, event_contact_id, event_type);
+    PERFORM pg_notify('contact_events', event_account_id);
+  END $$ language plpgsql;
@@ -0,0 +1,3 @@
+DROP INDEX contacts_profile_id_carddav_name_key;
+
+ALTER TABLE contacts DROP COLUMN carddav_name;
@@ -0,0 +1,6 @@
+ALTER TABLE contacts ADD COLUMN carddav_name text NOT NULL DEFAULT '';
+
+-- a CardDAV resource name identifies a contact within its address book, the
+-- names of deleted contacts can be reused
+CREATE UNIQUE INDEX contacts_profile_id_carddav_name_key ON contacts (profile_id, carddav_name)
+  WHERE carddav_name <> '' AND deleted_at IS NULL;
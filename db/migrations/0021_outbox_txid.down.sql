@@ -0,0 +1,4 @@
+DROP INDEX outbox_unpublished_idx;
+CREATE INDEX outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
+
+ALTER TABLE outbox DROP COLUMN txid;
@@ -0,0 +1,6 @@
+-- the relay publishes the events in the order of their transactions, the
+-- events of an account don't have to be committed in the order of their ids
+ALTER TABLE outbox ADD COLUMN txid bigint NOT NULL DEFAULT 0;
+
+DROP INDEX outbox_unpublished_idx;
+CREATE INDEX outbox_unpublished_idx ON outbox (txid, id) WHERE published_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TRIGGER group_contacts_changed ON group_contacts;
+
+DROP FUNCTION contact_groups_changed();
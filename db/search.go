@@ -0,0 +1,101 @@
+package db
+
+// contactSearchSQL creates the search document of contacts and the triggers
+// which keep it up to date. It is the same as the
+// migrations/0013_search.up.sql migration, keep them in sync.
+const contactSearchSQL = `
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS search tsvector;
+
+CREATE INDEX IF NOT EXISTS contacts_search_idx ON contacts USING gin (search);
+
+-- contact_nicknames returns the nicknames of the contact as text, they are
+-- usually a JSON array of strings
+CREATE OR REPLACE FUNCTION contact_nicknames(nicknames jsonb)
+  RETURNS text as $$
+  SELECT CASE jsonb_typeof(nicknames)
+    WHEN 'array' THEN (SELECT string_agg(value, ' ') FROM jsonb_array_elements_text(nicknames))
+    ELSE nicknames #>> '{}'
+  END
+  $$ language sql IMMUTABLE;
+
+-- contact_search_text returns the text searched by Contacts.Search, it is
+-- highlighted in the search results
+CREATE OR REPLACE FUNCTION contact_search_text(contact contacts)
+  RETURNS text as $$
+  SELECT concat_ws(' ',
+    contact.first_name, contact.middle_name, contact.last_name, contact_nicknames(contact.nicknames),
+    (SELECT string_agg(address, ' ') FROM emails WHERE contact_id = contact.id),
+    contact.notes,
+    (SELECT string_agg(concat_ws(' ', address, city, state, zip, country), ' ') FROM addresses
+      WHERE home_address_contact_id = contact.id OR work_address_contact_id = contact.id)
+  )
+  $$ language sql STABLE;
+
+-- contact_search_document returns the indexed search document of the
+-- contact, the matches of the names rank higher than the matches of e-mail
+-- addresses, notes and addresses
+CREATE OR REPLACE FUNCTION contact_search_document(contact contacts)
+  RETURNS tsvector as $$
+  SELECT
+    setweight(to_tsvector('simple', concat_ws(' ',
+      contact.first_name, contact.middle_name, contact.last_name, contact_nicknames(contact.nicknames))), 'A') ||
+    setweight(to_tsvector('simple', coalesce(
+      (SELECT string_agg(address, ' ') FROM emails WHERE contact_id = contact.id), '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(contact.notes, '')), 'C') ||
+    setweight(to_tsvector('simple', coalesce(
+      (SELECT string_agg(concat_ws(' ', address, city, state, zip, country), ' ') FROM addresses
+        WHERE home_address_contact_id = contact.id OR work_address_contact_id = contact.id), '')), 'D')
+  $$ language sql STABLE;
+
+CREATE OR REPLACE FUNCTION contacts_search()
+  RETURNS trigger as $$
+  BEGIN
+    NEW.search := contact_search_document(NEW);
+    RETURN NEW;
+  END $$ language plpgsql;
+
+-- contact_child_search rebuilds the search document of the contact a changed
+-- row belongs to, e.g. an e-mail address. The arguments of the trigger are
+-- the columns which reference the contact.
+CREATE OR REPLACE FUNCTION contact_child_search()
+  RETURNS trigger as $$
+  DECLARE
+    ids int[] := '{}';
+  BEGIN
+    FOR i IN 0 .. TG_NARGS - 1 LOOP
+      IF TG_OP <> 'INSERT' THEN
+        ids := ids || (to_jsonb(OLD) ->> TG_ARGV[i])::int;
+      END IF;
+      IF TG_OP <> 'DELETE' THEN
+        ids := ids || (to_jsonb(NEW) ->> TG_ARGV[i])::int;
+      END IF;
+    END LOOP;
+    -- the update fires the contacts_search trigger
+    UPDATE contacts SET search = NULL WHERE id = ANY(ids);
+    RETURN NULL;
+  END $$ language plpgsql;
+
+DROP TRIGGER IF EXISTS contacts_search ON contacts;
+CREATE TRIGGER contacts_search
+  BEFORE INSERT OR UPDATE ON contacts
+  FOR EACH ROW
+  EXECUTE PROCEDURE contacts_search();
+
+DROP TRIGGER IF EXISTS emails_contact_search ON emails;
+CREATE TRIGGER emails_contact_search
+  AFTER INSERT OR UPDATE OR DELETE ON emails
+  FOR EACH ROW
+  EXECUTE PROCEDURE contact_child_search('contact_id');
+
+DROP TRIGGER IF EXISTS addresses_contact_search ON addresses;
+CREATE TRIGGER addresses_contact_search
+  AFTER INSERT OR UPDATE OR DELETE ON addresses
+  FOR EACH ROW
+  EXECUTE PROCEDURE contact_child_search('home_address_contact_id', 'work_address_contact_id');
+
+-- the existing contacts are indexed without triggering the events and the
+-- updated_at timestamps of changes
+ALTER TABLE contacts DISABLE TRIGGER USER;
+UPDATE contacts SET search = contact_search_document(contacts) WHERE search IS NULL;
+ALTER TABLE contacts ENABLE TRIGGER USER;
+`
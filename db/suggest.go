@@ -0,0 +1,24 @@
+package db
+
+// contactSuggestSQL creates the trigram indexes of the names, nicknames and
+// primary e-mail addresses of contacts. It is the same as the
+// migrations/0014_suggest.up.sql migration, keep them in sync.
+const contactSuggestSQL = `
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- contact_name returns the full name of the contact, it is immutable unlike
+-- concat_ws so it can be indexed
+CREATE OR REPLACE FUNCTION contact_name(first_name text, middle_name text, last_name text)
+  RETURNS text as $$
+  SELECT concat_ws(' ', first_name, middle_name, last_name)
+  $$ language sql IMMUTABLE;
+
+CREATE INDEX IF NOT EXISTS contacts_name_trgm_idx ON contacts
+  USING gin (contact_name(first_name, middle_name, last_name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS contacts_nicknames_trgm_idx ON contacts
+  USING gin (contact_nicknames(nicknames) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS emails_primary_address_trgm_idx ON emails
+  USING gin (address gin_trgm_ops) WHERE is_primary;
+`
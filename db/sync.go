@@ -0,0 +1,27 @@
+package db
+
+// contactGroupsChangedSQL creates the trigger which marks a contact as
+// updated when it is added to or removed from a group, so Sync reports its
+// groups. It is the same as the migrations/0022_contact_groups_changed.up.sql
+// migration, keep them in sync.
+const contactGroupsChangedSQL = `
+CREATE OR REPLACE FUNCTION contact_groups_changed()
+  RETURNS trigger as $$
+  DECLARE
+    member_contact_id int;
+  BEGIN
+    IF TG_OP = 'DELETE' THEN
+      member_contact_id := OLD.contact_id;
+    ELSE
+      member_contact_id := NEW.contact_id;
+    END IF;
+    UPDATE contacts SET updated_at = now() WHERE id = member_contact_id AND deleted_at IS NULL;
+    RETURN NULL;
+  END $$ language plpgsql;
+
+DROP TRIGGER IF EXISTS group_contacts_changed ON group_contacts;
+CREATE TRIGGER group_contacts_changed
+  AFTER INSERT OR DELETE ON group_contacts
+  FOR EACH ROW
+  EXECUTE PROCEDURE contact_groups_changed();
+`
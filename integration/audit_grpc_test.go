@@ -0,0 +1,181 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/cmd"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc"
+)
+
+func newAuditLogClient(t *testing.T) (pb.AuditLogClient, func()) {
+	conn, err := grpc.Dial(cmd.ServerAddress, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to connect to server: %v", err)
+	}
+	return pb.NewAuditLogClient(conn), func() {
+		if err := conn.Close(); err != nil {
+			t.Fatalf("unable to close client: %v", err)
+		}
+	}
+}
+
+// TestAuditEvents verifies that changes of contacts are recorded in the audit
+// log of the account that made them
+// 1. Create and delete a contact
+// 2. Ensure the audit log has the create and delete events
+// 3. Ensure the audit log of another account is empty
+func TestAuditEvents(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	auditLog, closeAuditLog := newAuditLogClient(t)
+	defer closeAuditLog()
+	res, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Boromir",
+			PrimaryEmail: "boromir@gondor.gov",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	if _, err := contacts.Delete(DefaultContext(t), &pb.DeleteContactRequest{
+		Id: res.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	resList, err := auditLog.List(DefaultContext(t), &pb.ListAuditEventRequest{})
+	if err != nil {
+		t.Fatalf("unable to list audit events: %s", err)
+	}
+	expected := []string{"/api.contacts.Contacts/Create", "/api.contacts.Contacts/Delete"}
+	if len(resList.GetResults()) != len(expected) {
+		t.Fatalf("unexpected number of audit events: have %d; expected %d",
+			len(resList.GetResults()), len(expected),
+		)
+	}
+	for i, event := range resList.GetResults() {
+		if event.GetMethod() != expected[i] {
+			t.Errorf("unexpected audit event method: have %s; expected %s",
+				event.GetMethod(), expected[i],
+			)
+		}
+		if event.GetResourceId() != "contacts/1" {
+			t.Errorf("unexpected audit event resource id: have %s; expected %s",
+				event.GetResourceId(), "contacts/1",
+			)
+		}
+	}
+	resList, err = auditLog.List(OtherAccountContext(t), &pb.ListAuditEventRequest{})
+	if err != nil {
+		t.Fatalf("unable to list audit events: %s", err)
+	}
+	if len(resList.GetResults()) != 0 {
+		t.Fatalf("unexpected number of audit events of another account: have %d; expected %d",
+			len(resList.GetResults()), 0,
+		)
+	}
+}
+
+// TestAuditEvents_batch verifies that every applied item of a batch is
+// recorded in the audit log
+// 1. Create a batch of two contacts and an invalid item in the best effort mode
+// 2. Update and delete both contacts by batches
+// 3. Ensure the audit log has an event of every applied item and none of the invalid one
+func TestAuditEvents_batch(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	auditLog, closeAuditLog := newAuditLogClient(t)
+	defer closeAuditLog()
+	created, err := contacts.BatchCreate(DefaultContext(t), &pb.BatchCreateContactsRequest{
+		Mode: pb.BatchMode_BEST_EFFORT,
+		Payload: []*pb.Contact{
+			{FirstName: "Elladan"},
+			{FirstName: "Elrohir"},
+			{FirstName: "Arwen", PrimaryEmail: "not an e-mail address"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create contacts: %s", err)
+	}
+	if len(created.GetErrors()) != 1 {
+		t.Fatalf("unexpected errors of the batch: have %v; expected the invalid item only", created.GetErrors())
+	}
+	updates := created.GetResults()[:2]
+	for _, c := range updates {
+		c.LastName = "Peredhel"
+	}
+	if _, err := contacts.BatchUpdate(DefaultContext(t), &pb.BatchUpdateContactsRequest{
+		Mode:    pb.BatchMode_ATOMIC,
+		Payload: updates,
+	}); err != nil {
+		t.Fatalf("unable to update contacts: %s", err)
+	}
+	if _, err := contacts.BatchDelete(DefaultContext(t), &pb.BatchDeleteContactsRequest{
+		Mode: pb.BatchMode_ATOMIC,
+		Ids:  []*resource.Identifier{updates[0].GetId(), updates[1].GetId()},
+	}); err != nil {
+		t.Fatalf("unable to delete contacts: %s", err)
+	}
+
+	resList, err := auditLog.List(DefaultContext(t), &pb.ListAuditEventRequest{})
+	if err != nil {
+		t.Fatalf("unable to list audit events: %s", err)
+	}
+	var have []string
+	for _, event := range resList.GetResults() {
+		have = append(have, event.GetMethod()+" "+event.GetResourceId())
+	}
+	expected := []string{
+		"/api.contacts.Contacts/BatchCreate contacts/1",
+		"/api.contacts.Contacts/BatchCreate contacts/2",
+		"/api.contacts.Contacts/BatchUpdate contacts/1",
+		"/api.contacts.Contacts/BatchUpdate contacts/2",
+		"/api.contacts.Contacts/BatchDelete contacts/1",
+		"/api.contacts.Contacts/BatchDelete contacts/2",
+	}
+	if fmt.Sprint(have) != fmt.Sprint(expected) {
+		t.Errorf("unexpected audit events: have %v; expected %v", have, expected)
+	}
+}
+
+// TestAuditEvents_import verifies that the contacts created by an import are
+// recorded in the audit log
+// 1. Import a file with two valid rows and an invalid one
+// 2. Ensure the audit log has an event of each created contact
+func TestAuditEvents_import(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	auditLog, closeAuditLog := newAuditLogClient(t)
+	defer closeAuditLog()
+	file := "first_name,primary_email\n" +
+		"Celeborn,celeborn@lorien.com\n" +
+		"Haldir,not an e-mail\n" +
+		"Rumil,rumil@lorien.com\n"
+	if res := importFile(t, contacts, &pb.ImportOptions{}, file); res.GetCreated() != 2 {
+		t.Fatalf("unexpected number of created contacts: have %d; expected 2", res.GetCreated())
+	}
+
+	resList, err := auditLog.List(DefaultContext(t), &pb.ListAuditEventRequest{})
+	if err != nil {
+		t.Fatalf("unable to list audit events: %s", err)
+	}
+	var have []string
+	for _, event := range resList.GetResults() {
+		have = append(have, event.GetMethod()+" "+event.GetResourceId())
+	}
+	expected := []string{
+		"/api.contacts.Contacts/Import contacts/1",
+		"/api.contacts.Contacts/Import contacts/2",
+	}
+	if fmt.Sprint(have) != fmt.Sprint(expected) {
+		t.Errorf("unexpected audit events: have %v; expected %v", have, expected)
+	}
+}
@@ -0,0 +1,200 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// makeCardDAVRequest issues a CardDAV request with the default authorization
+// token and returns the status, the headers and the body of the response
+func makeCardDAVRequest(t *testing.T, method, path string, header map[string]string, body string) (int, http.Header, string) {
+	code, resHeader, resBody, err := doCardDAVRequest(method, path, header, body)
+	if err != nil {
+		t.Fatalf("unable to send %s request: %v", method, err)
+	}
+	return code, resHeader, resBody
+}
+
+// doCardDAVRequest is makeCardDAVRequest which returns the error instead of
+// failing the test, so it can be called by other goroutines
+func doCardDAVRequest(method, path string, header map[string]string, body string) (int, http.Header, string, error) {
+	req, err := http.NewRequest(method, "http://localhost:8080"+path, strings.NewReader(body))
+	if err != nil {
+		return 0, nil, "", err
+	}
+	AddDefaultTokenToRequest(req)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	return res.StatusCode, res.Header, string(data), nil
+}
+
+// TestCardDAV verifies that the contacts of a profile can be synchronized by
+// an address-book client over CardDAV
+// 1. Create a profile and PUT a new vCard into its address book
+// 2. GET the vCard from the path it was put to and ensure its ETag and content
+// 3. Ensure the address book and the contact are listed by PROPFIND
+// 4. Synchronize the address book and delete the contact
+// 5. Ensure the next synchronization reports the contact as deleted
+func TestCardDAV(t *testing.T) {
+	dbTest.Reset(t)
+	client, closeClient := newProfilesClient(t)
+	defer closeClient()
+	profile, err := client.Create(DefaultContext(t), &pb.CreateProfileRequest{
+		Payload: &pb.Profile{Name: "Shire"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+	book := fmt.Sprintf("/carddav/%s/", profile.GetResult().GetId().GetResourceId())
+
+	href := book + "new.vcf"
+	code, header, _ := makeCardDAVRequest(t, http.MethodPut, href,
+		map[string]string{"Content-Type": "text/vcard", "If-None-Match": "*"},
+		"BEGIN:VCARD\r\nVERSION:3.0\r\nN:Baggins;Bilbo;;;\r\nFN:Bilbo Baggins\r\n"+
+			"EMAIL;TYPE=INTERNET:bilbo@shire.me\r\nEND:VCARD\r\n",
+	)
+	if code != http.StatusCreated {
+		t.Fatalf("unexpected status of PUT: %d - expected: %d", code, http.StatusCreated)
+	}
+	etag := header.Get("ETag")
+	if etag == "" {
+		t.Fatal("the created contact has no etag")
+	}
+
+	code, header, body := makeCardDAVRequest(t, http.MethodGet, href, nil, "")
+	if code != http.StatusOK {
+		t.Fatalf("unexpected status of GET: %d - expected: %d", code, http.StatusOK)
+	}
+	if header.Get("ETag") != etag {
+		t.Errorf("unexpected etag: %q - expected: %q", header.Get("ETag"), etag)
+	}
+	if !strings.Contains(body, "FN:Bilbo Baggins\r\n") || !strings.Contains(body, "bilbo@shire.me") {
+		t.Errorf("unexpected vCard: %s", body)
+	}
+
+	code, _, body = makeCardDAVRequest(t, "PROPFIND", book, map[string]string{"Depth": "1"},
+		`<propfind xmlns="DAV:"><prop><resourcetype/><displayname/><getetag/></prop></propfind>`,
+	)
+	if code != http.StatusMultiStatus {
+		t.Fatalf("unexpected status of PROPFIND: %d - expected: %d", code, http.StatusMultiStatus)
+	}
+	for _, s := range []string{"<href>" + book + "</href>", "Shire", "<href>" + href + "</href>", "addressbook"} {
+		if !strings.Contains(body, s) {
+			t.Errorf("PROPFIND response does not contain %q: %s", s, body)
+		}
+	}
+
+	syncReport := `<sync-collection xmlns="DAV:"><sync-token>%s</sync-token><sync-level>1</sync-level>` +
+		`<prop><getetag/></prop></sync-collection>`
+	code, _, body = makeCardDAVRequest(t, "REPORT", book, nil, fmt.Sprintf(syncReport, ""))
+	if code != http.StatusMultiStatus {
+		t.Fatalf("unexpected status of sync-collection: %d - expected: %d", code, http.StatusMultiStatus)
+	}
+	start, end := strings.Index(body, "<sync-token>"), strings.Index(body, "</sync-token>")
+	if start < 0 || end < start {
+		t.Fatalf("sync-collection response without sync token: %s", body)
+	}
+	token := body[start+len("<sync-token>") : end]
+
+	code, _, _ = makeCardDAVRequest(t, http.MethodDelete, href, map[string]string{"If-Match": etag}, "")
+	if code != http.StatusNoContent {
+		t.Fatalf("unexpected status of DELETE: %d - expected: %d", code, http.StatusNoContent)
+	}
+
+	code, _, body = makeCardDAVRequest(t, "REPORT", book, nil, fmt.Sprintf(syncReport, token))
+	if code != http.StatusMultiStatus {
+		t.Fatalf("unexpected status of sync-collection: %d - expected: %d", code, http.StatusMultiStatus)
+	}
+	if !strings.Contains(body, "<href>"+href+"</href><status>HTTP/1.1 404 Not Found</status>") {
+		t.Errorf("the deleted contact is not reported: %s", body)
+	}
+}
+
+// TestCardDAV_sameHref verifies that a CardDAV resource name is used by one
+// contact of an address book only
+// 1. PUT a new vCard and then another one to the same path with If-None-Match: *
+// 2. Ensure the second PUT fails and the first vCard is kept
+// 3. PUT different vCards to another path concurrently with If-None-Match: *
+// 4. Ensure exactly one of them is created and the others are rejected
+func TestCardDAV_sameHref(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, closeClient := newProfilesClient(t)
+	defer closeClient()
+	profile, err := client.Create(DefaultContext(t), &pb.CreateProfileRequest{
+		Payload: &pb.Profile{Name: "Shire"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create profile: %v", err)
+	}
+	book := fmt.Sprintf("/carddav/%s/", profile.GetResult().GetId().GetResourceId())
+	header := map[string]string{"Content-Type": "text/vcard", "If-None-Match": "*"}
+	card := func(first, last string) string {
+		return fmt.Sprintf("BEGIN:VCARD\r\nVERSION:3.0\r\nN:%s;%s;;;\r\nFN:%s %s\r\nEND:VCARD\r\n", last, first, first, last)
+	}
+
+	href := book + "hobbit.vcf"
+	if code, _, _ := makeCardDAVRequest(t, http.MethodPut, href, header, card("Bilbo", "Baggins")); code != http.StatusCreated {
+		t.Fatalf("unexpected status of PUT: %d - expected: %d", code, http.StatusCreated)
+	}
+	if code, _, _ := makeCardDAVRequest(t, http.MethodPut, href, header, card("Frodo", "Baggins")); code != http.StatusPreconditionFailed {
+		t.Errorf("unexpected status of second PUT: %d - expected: %d", code, http.StatusPreconditionFailed)
+	}
+	if _, _, body := makeCardDAVRequest(t, http.MethodGet, href, nil, ""); !strings.Contains(body, "FN:Bilbo Baggins\r\n") {
+		t.Errorf("unexpected vCard: %s", body)
+	}
+
+	href = book + "twin.vcf"
+	names := []string{"Elladan", "Elrohir", "Elrond", "Arwen", "Celebrian"}
+	codes := make(chan int, len(names))
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			code, _, _, err := doCardDAVRequest(http.MethodPut, href, header, card(name, "Peredhel"))
+			if err != nil {
+				t.Errorf("unable to send PUT request: %v", err)
+				return
+			}
+			codes <- code
+		}(name)
+	}
+	wg.Wait()
+	close(codes)
+	created := 0
+	for code := range codes {
+		switch code {
+		case http.StatusCreated:
+			created++
+		case http.StatusConflict, http.StatusPreconditionFailed:
+		default:
+			t.Errorf("unexpected status of concurrent PUT: %d", code)
+		}
+	}
+	if created != 1 {
+		t.Errorf("unexpected number of created contacts: %d - expected: 1", created)
+	}
+	if n := countRows(t, db, "contacts", "carddav_name = ? AND deleted_at IS NULL", "twin"); n != 1 {
+		t.Errorf("unexpected number of contacts named %q: %d - expected: 1", "twin", n)
+	}
+}
@@ -3,6 +3,8 @@
 package integration
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -266,6 +268,149 @@ func TestDeleteContact_REST(t *testing.T) {
 	})
 }
 
+// TestUndeleteContact_REST uses the REST gateway to delete a contact and then
+// restore it
+// 1. Create a contact entry with a POST request
+// 2. Delete the contact and ensure it can't be read anymore
+// 3. Undelete the contact with a POST request to /contacts/{id}:undelete
+// 4. Ensure the restored contact still has its e-mail addresses
+func TestUndeleteContact_REST(t *testing.T) {
+	dbTest.Reset(t)
+	contact := pb.Contact{
+		FirstName:    "Arwen",
+		PrimaryEmail: "arwen@rivendell.com",
+	}
+	resCreate, err := MakeRequestWithDefaults(
+		http.MethodPost,
+		"http://localhost:8080/v1/contacts",
+		contact,
+	)
+	if err != nil {
+		t.Fatalf("unable to create contact: %v", err)
+	}
+	createJSON, err := simplejson.NewFromReader(resCreate.Body)
+	if err != nil {
+		t.Fatalf("unable to unmarshal create contact response body: %v", err)
+	}
+	id, err := createJSON.GetPath("result", "id").String()
+	if err != nil {
+		t.Fatalf("unable to get contact id from response json: %v", err)
+	}
+	id = strings.TrimPrefix(id, fmt.Sprintf("%s/%s/", cmd.ApplicationID, "contacts"))
+	resDelete, err := MakeRequestWithDefaults(
+		http.MethodDelete,
+		fmt.Sprintf("http://localhost:8080/v1/contacts/%s", id),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to delete contact: %v", err)
+	}
+	ValidateResponseCode(t, resDelete, http.StatusOK)
+	resRead, err := MakeRequestWithDefaults(
+		http.MethodGet,
+		fmt.Sprintf("http://localhost:8080/v1/contacts/%s", id),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to get contact: %v", err)
+	}
+	ValidateResponseCode(t, resRead, http.StatusNotFound)
+	resUndelete, err := MakeRequestWithDefaults(
+		http.MethodPost,
+		fmt.Sprintf("http://localhost:8080/v1/contacts/%s:undelete", id),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to undelete contact: %v", err)
+	}
+	ValidateResponseCode(t, resUndelete, http.StatusOK)
+	undeleteJSON, err := simplejson.NewFromReader(resUndelete.Body)
+	if err != nil {
+		t.Fatalf("unable to unmarshal undelete contact response body: %v", err)
+	}
+	var tests = []struct {
+		name   string
+		json   *simplejson.Json
+		expect string
+	}{
+		{
+			name:   "contact first name",
+			json:   undeleteJSON.GetPath("result", "first_name"),
+			expect: `"Arwen"`,
+		},
+		{
+			name:   "contact primary email",
+			json:   undeleteJSON.GetPath("result", "primary_email"),
+			expect: `"arwen@rivendell.com"`,
+		},
+		{
+			name:   "success response",
+			json:   undeleteJSON.GetPath("success"),
+			expect: `{"code":"OK","status":200}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ValidateJSONSchema(t, test.json, test.expect)
+		})
+	}
+}
+
+// TestUpdateContact_REST_outdatedETag verifies that the REST gateway rejects
+// an update with an outdated If-Match header with 412 Precondition Failed
+// 1. Create a contact entry with a POST request
+// 2. Update the contact with a PUT request and the entity tag returned by create
+// 3. Ensure updating with the same If-Match header again fails with 412
+func TestUpdateContact_REST_outdatedETag(t *testing.T) {
+	dbTest.Reset(t)
+	resCreate, err := MakeRequestWithDefaults(
+		http.MethodPost,
+		"http://localhost:8080/v1/contacts",
+		pb.Contact{FirstName: "Radagast", PrimaryEmail: "radagast@rhosgobel.com"},
+	)
+	if err != nil {
+		t.Fatalf("unable to create contact: %v", err)
+	}
+	createJSON, err := simplejson.NewFromReader(resCreate.Body)
+	if err != nil {
+		t.Fatalf("unable to unmarshal create contact response body: %v", err)
+	}
+	id, err := createJSON.GetPath("result", "id").String()
+	if err != nil {
+		t.Fatalf("unable to get contact id from response json: %v", err)
+	}
+	id = strings.TrimPrefix(id, fmt.Sprintf("%s/%s/", cmd.ApplicationID, "contacts"))
+	etag, err := createJSON.GetPath("result", "etag").String()
+	if err != nil {
+		t.Fatalf("unable to get contact etag from response json: %v", err)
+	}
+	update := func() *http.Response {
+		body, err := json.Marshal(pb.Contact{FirstName: "Radagast", LastName: "the Brown"})
+		if err != nil {
+			t.Fatalf("unable to marshal contact: %v", err)
+		}
+		req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080/v1/contacts/%s", id), bytes.NewBuffer(body))
+		if err != nil {
+			t.Fatalf("unable to create update request: %v", err)
+		}
+		AddDefaultTokenToRequest(req)
+		req.Header.Set("If-Match", fmt.Sprintf("%q", etag))
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unable to update contact: %v", err)
+		}
+		return res
+	}
+	ValidateResponseCode(t, update(), http.StatusOK)
+	resOutdated := update()
+	ValidateResponseCode(t, resOutdated, http.StatusPreconditionFailed)
+	outdatedJSON, err := simplejson.NewFromReader(resOutdated.Body)
+	if err != nil {
+		t.Fatalf("unable to unmarshal update contact response body: %v", err)
+	}
+	ValidateJSONSchema(t, outdatedJSON.GetPath("error", "code"), `"FAILED_PRECONDITION"`)
+}
+
 // ValidateResponseCode checks the http status of a given request and will
 // fail the current test if it doesn't match the expected status code
 func ValidateResponseCode(t *testing.T, res *http.Response, expected int) {
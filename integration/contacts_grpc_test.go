@@ -3,12 +3,24 @@
 package integration
 
 import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
 	"github.com/infobloxopen/atlas-contacts-app/cmd"
 	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/svc"
 	_ "github.com/jinzhu/gorm/dialects/postgres"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func newContactsClient(t *testing.T) (pb.ContactsClient, func()) {
@@ -120,3 +132,938 @@ func TestDeleteContactEntry(t *testing.T) {
 		t.Fatal("expected non-nil error when deleting empty entry")
 	}
 }
+
+// TestListDeletedContacts verifies that deleted contacts are hidden from the
+// list of contacts unless they are explicitly requested
+// 1. Create two contacts and delete one of them
+// 2. Ensure only one contact is listed by default
+// 3. Ensure both contacts are listed when show_deleted is set
+func TestListDeletedContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	var created []*pb.Contact
+	for _, email := range []string{"elrond@rivendell.com", "galadriel@lorien.com"} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+			Payload: &pb.Contact{PrimaryEmail: email},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		created = append(created, res.GetResult())
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{
+		Id: created[0].GetId(),
+	}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	resList, err := client.List(DefaultContext(t), &pb.ListContactRequest{})
+	if err != nil {
+		t.Fatalf("unable to list contacts: %s", err)
+	}
+	if len(resList.GetResults()) != 1 {
+		t.Fatalf("unexpected number of contacts: have %d; expected %d",
+			len(resList.GetResults()), 1,
+		)
+	}
+	resList, err = client.List(DefaultContext(t), &pb.ListContactRequest{ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("unable to list contacts: %s", err)
+	}
+	if len(resList.GetResults()) != 2 {
+		t.Fatalf("unexpected number of contacts with deleted ones: have %d; expected %d",
+			len(resList.GetResults()), 2,
+		)
+	}
+}
+
+// TestRecreateDeletedContact verifies that the e-mail addresses of a deleted
+// contact can be used by a new contact
+// 1. Create a contact and delete it
+// 2. Create a contact with the same e-mail address
+// 3. Ensure undeleting the first contact conflicts with the new one
+// 4. Delete the new contact and ensure the first one can be undeleted
+func TestRecreateDeletedContact(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	payload := &pb.Contact{
+		FirstName:    "Bilbo",
+		PrimaryEmail: "bilbo@bag-end.com",
+		Emails:       []*pb.Email{{Address: "burglar@erebor.com"}},
+	}
+	resDeleted, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: payload})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{
+		Id: resDeleted.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	resCreate, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: payload})
+	if err != nil {
+		t.Fatalf("unable to re-create contact with the addresses of the deleted one: %s", err)
+	}
+	if _, err := client.Undelete(DefaultContext(t), &pb.UndeleteContactRequest{
+		Id: resDeleted.GetResult().GetId(),
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("unexpected error when undeleting contact with taken addresses: have %v; expected %s",
+			err, codes.AlreadyExists,
+		)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{
+		Id: resCreate.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	resUndelete, err := client.Undelete(DefaultContext(t), &pb.UndeleteContactRequest{
+		Id: resDeleted.GetResult().GetId(),
+	})
+	if err != nil {
+		t.Fatalf("unable to undelete contact: %s", err)
+	}
+	if resUndelete.GetResult().GetPrimaryEmail() != "bilbo@bag-end.com" {
+		t.Errorf("unexpected primary e-mail of undeleted contact: have %s; expected %s",
+			resUndelete.GetResult().GetPrimaryEmail(), "bilbo@bag-end.com",
+		)
+	}
+}
+
+// TestContactPhoneNumbers verifies that the primary phone number of a contact
+// is persisted alongside its other phone numbers
+// 1. Create a contact with a primary phone number and an additional number
+// 2. Read contact with the returned ID from the response
+// 3. Verify that both numbers are stored and the primary one is preserved
+func TestContactPhoneNumbers(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Peregrin",
+			LastName:     "Took",
+			PrimaryEmail: "pippin@shire.com",
+			PrimaryPhone: "+14155552671",
+			PhoneNumbers: []*pb.PhoneNumber{
+				{
+					Number: "+442071838750",
+					Type:   pb.PhoneNumber_WORK,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	resRead, err := client.Read(DefaultContext(t), &pb.ReadContactRequest{
+		Id: res.GetResult().GetId(),
+	})
+	if err != nil {
+		t.Fatalf("unable to read contact: %s", err)
+	}
+	if resRead.GetResult().GetPrimaryPhone() != "+14155552671" {
+		t.Fatalf("unexpected contact primary phone: have %s; expected %s",
+			resRead.GetResult().GetPrimaryPhone(), "+14155552671",
+		)
+	}
+	if len(resRead.GetResult().GetPhoneNumbers()) != 2 {
+		t.Fatalf("unexpected number of contact phone numbers: have %d; expected %d",
+			len(resRead.GetResult().GetPhoneNumbers()), 2,
+		)
+	}
+	if _, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Meriadoc",
+			LastName:     "Brandybuck",
+			PrimaryEmail: "merry@shire.com",
+			PrimaryPhone: "555-1234",
+		},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("unexpected error when creating contact with invalid phone: have %v; expected %s",
+			err, codes.InvalidArgument,
+		)
+	}
+}
+
+// TestListContactsByPrimaryPhone verifies that contacts can be filtered by
+// their primary phone number
+// 1. Create contacts with different primary phone numbers
+// 2. List contacts filtered by one of the numbers with and without page token
+// 3. Ensure only the contact with the number is returned
+func TestListContactsByPrimaryPhone(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	for _, c := range []*pb.Contact{
+		{FirstName: "Peregrin", PrimaryEmail: "pippin@shire.com", PrimaryPhone: "+14155552671"},
+		{FirstName: "Meriadoc", PrimaryEmail: "merry@shire.com", PrimaryPhone: "+442071838750"},
+	} {
+		if _, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c}); err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+	}
+	filter, err := query.ParseFiltering("primary_phone == '+442071838750'")
+	if err != nil {
+		t.Fatalf("unable to parse filter: %s", err)
+	}
+	for _, paging := range []*query.Pagination{nil, {PageToken: "null"}} {
+		res, err := client.List(DefaultContext(t), &pb.ListContactRequest{
+			Filter: filter,
+			Paging: paging,
+		})
+		if err != nil {
+			t.Fatalf("unable to list contacts: %s", err)
+		}
+		if len(res.GetResults()) != 1 || res.GetResults()[0].GetFirstName() != "Meriadoc" {
+			t.Errorf("unexpected contacts filtered by primary phone (paging %v): %v", paging, res.GetResults())
+		}
+	}
+}
+
+// TestSendSMS verifies that a message can be sent to an existing contact and
+// that sending a message to an unknown contact fails
+// 1. Create a contact
+// 2. Send a message to the contact and ensure a delivery id is returned
+// 3. Ensure a message to a non-existent contact is rejected
+func TestSendSMS(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Samwise",
+			LastName:     "Gamgee",
+			PrimaryEmail: "sam@shire.com",
+			PrimaryPhone: "+14155552671",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	id, err := strconv.ParseUint(res.GetResult().GetId().GetResourceId(), 10, 64)
+	if err != nil {
+		t.Fatalf("unable to parse contact id: %s", err)
+	}
+	resSMS, err := client.SendSMS(DefaultContext(t), &pb.SMSRequest{
+		Id:      id,
+		Message: "po-tay-toes",
+	})
+	if err != nil {
+		t.Fatalf("unable to send sms: %s", err)
+	}
+	if resSMS.GetDeliveryId() == "" {
+		t.Fatal("expected non-empty sms delivery id")
+	}
+	if resSMS.GetStatus() != svc.SMSStatusSent {
+		t.Fatalf("unexpected sms status: have %s; expected %s",
+			resSMS.GetStatus(), svc.SMSStatusSent,
+		)
+	}
+	if _, err := client.SendSMS(DefaultContext(t), &pb.SMSRequest{
+		Id:      id + 1,
+		Message: "po-tay-toes",
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unexpected error when sending sms to unknown contact: have %v; expected %s",
+			err, codes.NotFound,
+		)
+	}
+}
+
+// TestUpdateContact_outdatedETag verifies that an update based on an outdated
+// version of a contact is rejected
+// 1. Create a contact
+// 2. Update the contact with the entity tag returned by create
+// 3. Ensure updating with the same entity tag again fails with FailedPrecondition
+func TestUpdateContact_outdatedETag(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Meriadoc",
+			LastName:     "Brandybuck",
+			PrimaryEmail: "merry@buckland.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	created := res.GetResult()
+	if created.GetEtag() == "" {
+		t.Fatal("expected non-empty etag of created contact")
+	}
+	updated, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{
+		Payload: &pb.Contact{
+			Id:           created.GetId(),
+			FirstName:    "Merry",
+			LastName:     "Brandybuck",
+			PrimaryEmail: "merry@buckland.com",
+			Etag:         created.GetEtag(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	if updated.GetResult().GetEtag() == created.GetEtag() {
+		t.Fatalf("expected etag to change after update: have %s", updated.GetResult().GetEtag())
+	}
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{
+		Payload: &pb.Contact{
+			Id:           created.GetId(),
+			FirstName:    "Meriadoc",
+			LastName:     "Brandybuck",
+			PrimaryEmail: "merry@buckland.com",
+			Etag:         created.GetEtag(),
+		},
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("unexpected error when updating with outdated etag: have %v; expected %s",
+			err, codes.FailedPrecondition,
+		)
+	}
+}
+
+// TestUpdateContact_rollback verifies that a failed update doesn't leave a
+// contact partially updated
+// 1. Create a contact with an e-mail address
+// 2. Update the contact with the same new e-mail address twice, so the second
+// insert violates the uniqueness of e-mail addresses
+// 3. Ensure the contact keeps its e-mail address and entity tag
+func TestUpdateContact_rollback(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Peregrin",
+			LastName:     "Took",
+			PrimaryEmail: "pippin@tookland.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	created := res.GetResult()
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{
+		Payload: &pb.Contact{
+			Id:        created.GetId(),
+			FirstName: "Pippin",
+			LastName:  "Took",
+			Emails: []*pb.Email{
+				{Address: "pippin@minas-tirith.com"},
+				{Address: "pippin@minas-tirith.com"},
+			},
+		},
+	}); err == nil {
+		t.Fatal("expected the update with duplicated e-mail addresses to fail")
+	}
+	read, err := client.Read(DefaultContext(t), &pb.ReadContactRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("unable to read contact: %s", err)
+	}
+	if read.GetResult().GetFirstName() != created.GetFirstName() ||
+		read.GetResult().GetPrimaryEmail() != created.GetPrimaryEmail() ||
+		len(read.GetResult().GetEmails()) != 1 {
+		t.Errorf("the contact was partially updated: have %v; expected %v", read.GetResult(), created)
+	}
+	if read.GetResult().GetEtag() != created.GetEtag() {
+		t.Errorf("unexpected etag after the failed update: have %s; expected %s",
+			read.GetResult().GetEtag(), created.GetEtag(),
+		)
+	}
+}
+
+// TestContactTimestamps verifies that the server maintains the creation and
+// modification times of a contact
+// 1. Create a contact with a made up creation time
+// 2. Ensure the creation time was set by the server
+// 3. Update the contact and ensure the creation time is kept
+func TestContactTimestamps(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Peregrin",
+			LastName:     "Took",
+			PrimaryEmail: "pippin@tuckborough.com",
+			CreatedAt:    &timestamp.Timestamp{Seconds: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	created := res.GetResult()
+	if created.GetCreatedAt().GetSeconds() <= 1 {
+		t.Fatalf("unexpected creation time of contact: %v", created.GetCreatedAt())
+	}
+	updated, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{
+		Payload: &pb.Contact{
+			Id:           created.GetId(),
+			FirstName:    "Pippin",
+			LastName:     "Took",
+			PrimaryEmail: "pippin@tuckborough.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	if !proto.Equal(updated.GetResult().GetCreatedAt(), created.GetCreatedAt()) {
+		t.Fatalf("unexpected creation time after update: have %v; expected %v",
+			updated.GetResult().GetCreatedAt(), created.GetCreatedAt(),
+		)
+	}
+	if updated.GetResult().GetUpdatedAt().GetSeconds() < created.GetCreatedAt().GetSeconds() {
+		t.Fatalf("unexpected modification time after update: have %v; created at %v",
+			updated.GetResult().GetUpdatedAt(), created.GetCreatedAt(),
+		)
+	}
+}
+
+// TestSyncContacts verifies that Sync returns only the contacts changed since
+// the previous call
+// 1. Create two contacts and ensure the first Sync returns both of them
+// 2. Update one contact and delete the other one
+// 3. Ensure Sync with the returned token reports the update and the deletion
+// 4. Ensure Sync with the newest token returns nothing
+func TestSyncContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	var ids []*resource.Identifier
+	for _, name := range []string{"Elrond", "Arwen"} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+			Payload: &pb.Contact{FirstName: name, LastName: "Half-elven"},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId())
+	}
+	sync, err := client.Sync(DefaultContext(t), &pb.SyncContactsRequest{})
+	if err != nil {
+		t.Fatalf("unable to sync contacts: %s", err)
+	}
+	if len(sync.GetResults()) != 2 || len(sync.GetDeleted()) != 0 {
+		t.Fatalf("unexpected number of synced contacts: have %d results and %d deleted; expected 2 and 0",
+			len(sync.GetResults()), len(sync.GetDeleted()),
+		)
+	}
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{
+		Payload: &pb.Contact{Id: ids[0], FirstName: "Elrond", LastName: "Peredhel"},
+	}); err != nil {
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{Id: ids[1]}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	sync, err = client.Sync(DefaultContext(t), &pb.SyncContactsRequest{SyncToken: sync.GetSyncToken()})
+	if err != nil {
+		t.Fatalf("unable to sync contacts: %s", err)
+	}
+	if len(sync.GetResults()) != 1 || sync.GetResults()[0].GetLastName() != "Peredhel" {
+		t.Fatalf("unexpected synced contacts: have %v; expected the updated contact", sync.GetResults())
+	}
+	if len(sync.GetDeleted()) != 1 || sync.GetDeleted()[0].GetResourceId() != ids[1].GetResourceId() {
+		t.Fatalf("unexpected deleted contacts: have %v; expected %v", sync.GetDeleted(), ids[1])
+	}
+	sync, err = client.Sync(DefaultContext(t), &pb.SyncContactsRequest{SyncToken: sync.GetSyncToken()})
+	if err != nil {
+		t.Fatalf("unable to sync contacts: %s", err)
+	}
+	if len(sync.GetResults()) != 0 || len(sync.GetDeleted()) != 0 {
+		t.Fatalf("unexpected changes after the last sync: have %v and %v", sync.GetResults(), sync.GetDeleted())
+	}
+}
+
+// TestSyncContacts_lateCommit verifies that a change committed after a newer
+// one is synced as well
+// 1. Sync all the contacts
+// 2. Change a contact in a transaction which is kept open
+// 3. Update another contact and sync
+// 4. Commit the transaction and ensure the next sync returns its change
+func TestSyncContacts_lateCommit(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, close := newContactsClient(t)
+	defer close()
+	var contacts []*pb.Contact
+	for _, name := range []string{"Beren", "Luthien"} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+			Payload: &pb.Contact{FirstName: name},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		contacts = append(contacts, res.GetResult())
+	}
+	sync, err := client.Sync(DefaultContext(t), &pb.SyncContactsRequest{})
+	if err != nil {
+		t.Fatalf("unable to sync contacts: %s", err)
+	}
+
+	tx := db.Begin()
+	if err := tx.Exec("UPDATE contacts SET last_name = ?, updated_at = now() WHERE id = ?",
+		"Erchamion", contacts[0].GetId().GetResourceId()).Error; err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to update contact: %v", err)
+	}
+	contacts[1].LastName = "Tinuviel"
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: contacts[1]}); err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	token := sync.GetSyncToken()
+	sync, err = client.Sync(DefaultContext(t), &pb.SyncContactsRequest{SyncToken: token})
+	if err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to sync contacts: %s", err)
+	}
+	if len(sync.GetResults()) != 0 {
+		t.Errorf("unexpected contacts synced while an older transaction is running: %v", sync.GetResults())
+	}
+	token = sync.GetSyncToken()
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("unable to commit contact update: %v", err)
+	}
+
+	sync, err = client.Sync(DefaultContext(t), &pb.SyncContactsRequest{SyncToken: token})
+	if err != nil {
+		t.Fatalf("unable to sync contacts: %s", err)
+	}
+	lastNames := map[string]bool{}
+	for _, c := range sync.GetResults() {
+		lastNames[c.GetLastName()] = true
+	}
+	if len(sync.GetResults()) != 2 || !lastNames["Erchamion"] || !lastNames["Tinuviel"] {
+		t.Errorf("unexpected synced contacts after the commit: have %v; expected both updated contacts", sync.GetResults())
+	}
+}
+
+// TestSyncContacts_expiredToken verifies that a sync token older than the
+// retention period of deleted contacts requires a full resync
+// 1. Sync with a token issued long ago
+// 2. Ensure it is rejected with FailedPrecondition
+func TestSyncContacts_expiredToken(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	// changed_at:id:issued_at of a token issued at the epoch
+	token := base64.RawURLEncoding.EncodeToString([]byte("0:0:0"))
+	if _, err := client.Sync(DefaultContext(t), &pb.SyncContactsRequest{SyncToken: token}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("unexpected error when syncing with an expired token: have %v; expected %s",
+			err, codes.FailedPrecondition,
+		)
+	}
+}
+
+// TestWatchContacts verifies that watchers receive the changes of contacts
+// within their account
+// 1. Start watching contacts
+// 2. Create a contact and ensure the CREATED event is received
+// 3. Delete the contact and ensure the DELETED event is received
+// 4. Resume watching from the first event and ensure the DELETED event is received again
+func TestWatchContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	ctx, cancel := context.WithTimeout(DefaultContext(t), 10*time.Second)
+	defer cancel()
+	stream, err := client.Watch(ctx, &pb.WatchContactsRequest{})
+	if err != nil {
+		t.Fatalf("unable to watch contacts: %s", err)
+	}
+	// the watch is established once the server has read the current events
+	time.Sleep(500 * time.Millisecond)
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Galadriel", PrimaryEmail: "galadriel@lorien.com"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	created, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("unable to receive contact event: %s", err)
+	}
+	if created.GetType() != pb.ContactEvent_CREATED || created.GetContact().GetFirstName() != "Galadriel" {
+		t.Fatalf("unexpected contact event: have %v; expected CREATED event of the new contact", created)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{Id: res.GetResult().GetId()}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	deleted, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("unable to receive contact event: %s", err)
+	}
+	if deleted.GetType() != pb.ContactEvent_DELETED ||
+		deleted.GetContact().GetId().GetResourceId() != res.GetResult().GetId().GetResourceId() {
+		t.Fatalf("unexpected contact event: have %v; expected DELETED event of the contact", deleted)
+	}
+	resumed, err := client.Watch(ctx, &pb.WatchContactsRequest{LastEventId: created.GetId()})
+	if err != nil {
+		t.Fatalf("unable to resume watching contacts: %s", err)
+	}
+	event, err := resumed.Recv()
+	if err != nil {
+		t.Fatalf("unable to receive contact event: %s", err)
+	}
+	if event.GetId() != deleted.GetId() {
+		t.Fatalf("unexpected contact event after resume: have %v; expected %v", event, deleted)
+	}
+}
+
+// TestWatchContacts_lateCommit verifies that a change committed after a newer
+// one is streamed to watchers as well
+// 1. Start watching contacts
+// 2. Change a contact in a transaction which is kept open
+// 3. Update another contact and ensure no event is received
+// 4. Commit the transaction and ensure both events are received in the order of the transactions
+func TestWatchContacts_lateCommit(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, close := newContactsClient(t)
+	defer close()
+	var contacts []*pb.Contact
+	for _, name := range []string{"Beren", "Luthien"} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+			Payload: &pb.Contact{FirstName: name},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		contacts = append(contacts, res.GetResult())
+	}
+	ctx, cancel := context.WithTimeout(DefaultContext(t), 10*time.Second)
+	defer cancel()
+	stream, err := client.Watch(ctx, &pb.WatchContactsRequest{})
+	if err != nil {
+		t.Fatalf("unable to watch contacts: %s", err)
+	}
+	// the watch is established once the server has read the current events
+	time.Sleep(500 * time.Millisecond)
+
+	tx := db.Begin()
+	if err := tx.Exec("UPDATE contacts SET last_name = ?, updated_at = now() WHERE id = ?",
+		"Erchamion", contacts[0].GetId().GetResourceId()).Error; err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to update contact: %v", err)
+	}
+	contacts[1].LastName = "Tinuviel"
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: contacts[1]}); err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	received := make(chan *pb.ContactEvent, 2)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			received <- event
+		}
+	}()
+	select {
+	case event := <-received:
+		tx.Rollback()
+		t.Fatalf("unexpected contact event while an older transaction is running: %v", event)
+	case <-time.After(500 * time.Millisecond):
+	}
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("unable to commit contact update: %v", err)
+	}
+
+	for _, lastName := range []string{"Erchamion", "Tinuviel"} {
+		var event *pb.ContactEvent
+		select {
+		case event = <-received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("unable to receive contact event with last name %q", lastName)
+		}
+		if event.GetType() != pb.ContactEvent_UPDATED || event.GetContact().GetLastName() != lastName {
+			t.Errorf("unexpected contact event: have %v; expected UPDATED event of %q", event, lastName)
+		}
+	}
+}
+
+// TestBatchCreateContacts verifies that the items of a batch are applied
+// according to the batch mode
+// 1. Create a batch of contacts in the best effort mode where one item is
+// invalid and another one uses the e-mail address of the first one
+// 2. Ensure only the first contact is created and the other items are
+// reported by their indexes
+// 3. Create a batch with a conflicting item in the atomic mode
+// 4. Ensure the batch fails and none of its contacts is created
+func TestBatchCreateContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+
+	res, err := client.BatchCreate(DefaultContext(t), &pb.BatchCreateContactsRequest{
+		Mode: pb.BatchMode_BEST_EFFORT,
+		Payload: []*pb.Contact{
+			{FirstName: "Aragorn", PrimaryEmail: "strider@gondor.com"},
+			{FirstName: "Boromir", PrimaryEmail: "not an e-mail address"},
+			{FirstName: "Faramir", PrimaryEmail: "strider@gondor.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create batch of contacts: %s", err)
+	}
+	if len(res.GetResults()) != 3 {
+		t.Fatalf("unexpected number of batch results: have %d; expected 3", len(res.GetResults()))
+	}
+	if res.GetResults()[0].GetId() == nil || res.GetResults()[1].GetId() != nil || res.GetResults()[2].GetId() != nil {
+		t.Errorf("unexpected batch results: %v", res.GetResults())
+	}
+	expected := map[string]codes.Code{"1": codes.InvalidArgument, "2": codes.AlreadyExists}
+	if len(res.GetErrors()) != len(expected) {
+		t.Fatalf("unexpected number of batch errors: have %d; expected %d", len(res.GetErrors()), len(expected))
+	}
+	for _, e := range res.GetErrors() {
+		if code, ok := expected[e.GetTarget()]; !ok || codes.Code(e.GetCode()) != code {
+			t.Errorf("unexpected batch error: have %v; expected %v", e, expected)
+		}
+	}
+
+	if _, err := client.BatchCreate(DefaultContext(t), &pb.BatchCreateContactsRequest{
+		Mode: pb.BatchMode_ATOMIC,
+		Payload: []*pb.Contact{
+			{FirstName: "Gandalf", PrimaryEmail: "mithrandir@valinor.com"},
+			{FirstName: "Saruman", PrimaryEmail: "strider@gondor.com"},
+		},
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("unexpected error of atomic batch: have %v; expected %s", err, codes.AlreadyExists)
+	}
+
+	list, err := client.List(DefaultContext(t), &pb.ListContactRequest{})
+	if err != nil {
+		t.Fatalf("unable to list contacts: %s", err)
+	}
+	if len(list.GetResults()) != 1 || list.GetResults()[0].GetFirstName() != "Aragorn" {
+		t.Errorf("unexpected contacts after the batches: %v", list.GetResults())
+	}
+}
+
+// TestSearchContacts verifies that Search finds the contacts by the words of
+// their fields and ranks the matches of names first
+// 1. Create three contacts, one of them mentions the other in its notes
+// 2. Search for a name prefix and ensure the named contact is the first result
+// 3. Ensure the snippet highlights the matched word
+// 4. Ensure a search by an e-mail address and a deleted contact are handled
+func TestSearchContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	var ids []*resource.Identifier
+	for _, c := range []*pb.Contact{
+		{FirstName: "Samwise", LastName: "Gamgee", PrimaryEmail: "sam@bagshot.row"},
+		{FirstName: "Frodo", LastName: "Baggins", Notes: "travels with Samwise"},
+		{FirstName: "Rosie", LastName: "Cotton"},
+	} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId())
+	}
+
+	res, err := client.Search(DefaultContext(t), &pb.SearchContactsRequest{Q: "samw"})
+	if err != nil {
+		t.Fatalf("unable to search contacts: %s", err)
+	}
+	if len(res.GetResults()) != 2 {
+		t.Fatalf("unexpected number of found contacts: have %d; expected 2", len(res.GetResults()))
+	}
+	if first := res.GetResults()[0]; first.GetContact().GetLastName() != "Gamgee" ||
+		first.GetRank() < res.GetResults()[1].GetRank() {
+		t.Errorf("unexpected first result: have %v; expected Samwise Gamgee", first)
+	}
+	if snippet := res.GetResults()[1].GetSnippet(); !strings.Contains(snippet, "<b>Samwise</b>") {
+		t.Errorf("unexpected snippet: have %q; expected the highlighted name", snippet)
+	}
+
+	res, err = client.Search(DefaultContext(t), &pb.SearchContactsRequest{
+		Q:      "sam@bagshot.row",
+		Paging: &query.Pagination{Limit: 10},
+	})
+	if err != nil {
+		t.Fatalf("unable to search contacts: %s", err)
+	}
+	if len(res.GetResults()) != 1 || res.GetResults()[0].GetContact().GetFirstName() != "Samwise" {
+		t.Errorf("unexpected contacts found by e-mail address: %v", res.GetResults())
+	}
+
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{Id: ids[0]}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	res, err = client.Search(DefaultContext(t), &pb.SearchContactsRequest{Q: "Samwise"})
+	if err != nil {
+		t.Fatalf("unable to search contacts: %s", err)
+	}
+	if len(res.GetResults()) != 1 || res.GetResults()[0].GetContact().GetFirstName() != "Frodo" {
+		t.Errorf("unexpected contacts found after the deletion: %v", res.GetResults())
+	}
+}
+
+// TestSuggestContacts verifies that Suggest finds the contacts by prefixes
+// and misspellings of their names and primary e-mail addresses
+// 1. Create contacts with similar names
+// 2. Ensure a prefix of the name suggests the contact with the name
+// 3. Ensure a misspelled name and a prefix of an e-mail address suggest the contact
+// 4. Ensure the number of suggestions is limited
+func TestSuggestContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	for _, c := range []*pb.Contact{
+		{FirstName: "Peregrin", LastName: "Took", PrimaryEmail: "pippin@tuckborough.me"},
+		{FirstName: "Meriadoc", LastName: "Brandybuck"},
+		{FirstName: "Paladin", LastName: "Took"},
+	} {
+		if _, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c}); err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+	}
+
+	for _, tc := range []struct {
+		q      string
+		expect string
+	}{
+		{q: "peregr", expect: "Peregrin"},
+		{q: "Meriadok", expect: "Meriadoc"},
+		{q: "pippin@tuck", expect: "Peregrin"},
+	} {
+		res, err := client.Suggest(DefaultContext(t), &pb.SuggestContactsRequest{Q: tc.q})
+		if err != nil {
+			t.Fatalf("unable to suggest contacts: %s", err)
+		}
+		if len(res.GetResults()) == 0 || res.GetResults()[0].GetContact().GetFirstName() != tc.expect {
+			t.Errorf("unexpected suggestions for %q: have %v; expected %s first", tc.q, res.GetResults(), tc.expect)
+		}
+	}
+
+	res, err := client.Suggest(DefaultContext(t), &pb.SuggestContactsRequest{Q: "took", Limit: 1})
+	if err != nil {
+		t.Fatalf("unable to suggest contacts: %s", err)
+	}
+	if len(res.GetResults()) != 1 {
+		t.Errorf("unexpected number of suggestions: have %d; expected 1", len(res.GetResults()))
+	}
+}
+
+// TestFindDuplicatesAndMerge verifies that the contacts of the same person
+// are found and merged into one
+// 1. Create two contacts with the same name and e-mail addresses differing in case
+// 2. Ensure FindDuplicates groups them and not the third contact
+// 3. Merge the second contact into the first one
+// 4. Ensure the survivor has both e-mail addresses and the other one is deleted
+// 5. Ensure the audit log has the merge events of both contacts
+func TestFindDuplicatesAndMerge(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	auditLog, closeAuditLog := newAuditLogClient(t)
+	defer closeAuditLog()
+	contacts := []*pb.Contact{}
+	for _, c := range []*pb.Contact{
+		{FirstName: "Samwise", LastName: "Gamgee", PrimaryEmail: "sam@bagend.me", Notes: "gardener"},
+		{FirstName: "Samwise", LastName: "Gamgee", PrimaryEmail: "Sam@BagEnd.me", PrimaryPhone: "+1 555 0100"},
+		{FirstName: "Rosie", LastName: "Cotton", PrimaryEmail: "rosie@bywater.me"},
+	} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		contacts = append(contacts, res.GetResult())
+	}
+
+	dups, err := client.FindDuplicates(DefaultContext(t), &pb.FindDuplicatesRequest{MinConfidence: 0.5})
+	if err != nil {
+		t.Fatalf("unable to find duplicates: %s", err)
+	}
+	if len(dups.GetResults()) != 1 || len(dups.GetResults()[0].GetContacts()) != 2 {
+		t.Fatalf("unexpected duplicates: have %v; expected one group of 2 contacts", dups.GetResults())
+	}
+	if reasons := dups.GetResults()[0].GetReasons(); strings.Join(reasons, ",") != "email,name" {
+		t.Errorf("unexpected reasons: have %v; expected [email name]", reasons)
+	}
+
+	res, err := client.Merge(DefaultContext(t), &pb.MergeContactsRequest{
+		Id:        contacts[0].GetId(),
+		MergedIds: []*resource.Identifier{contacts[1].GetId()},
+	})
+	if err != nil {
+		t.Fatalf("unable to merge contacts: %s", err)
+	}
+	if len(res.GetResult().GetEmails()) != 2 || res.GetResult().GetPrimaryPhone() != "+1 555 0100" {
+		t.Errorf("unexpected merged contact: %v", res.GetResult())
+	}
+	if _, err := client.Read(DefaultContext(t), &pb.ReadContactRequest{Id: contacts[1].GetId()}); status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error reading the merged contact: have %v; expected %s", err, codes.NotFound)
+	}
+
+	events, err := auditLog.List(DefaultContext(t), &pb.ListAuditEventRequest{})
+	if err != nil {
+		t.Fatalf("unable to list audit events: %s", err)
+	}
+	merges := 0
+	for _, e := range events.GetResults() {
+		if e.GetMethod() == "/api.contacts.Contacts/Merge" {
+			merges++
+		}
+	}
+	if merges != 2 {
+		t.Errorf("unexpected number of merge audit events: have %d; expected 2", merges)
+	}
+}
+
+// TestMergeContacts_undelete verifies that a merged contact keeps its e-mail
+// addresses and phone numbers
+// 1. Merge a contact into another one
+// 2. Ensure the merged contact can't be undeleted while the survivor has its address
+// 3. Remove the address from the survivor
+// 4. Ensure the merged contact is undeleted with its address and phone number
+func TestMergeContacts_undelete(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	contacts := []*pb.Contact{}
+	for _, c := range []*pb.Contact{
+		{FirstName: "Meriadoc", LastName: "Brandybuck", PrimaryEmail: "merry@bucklebury.me"},
+		{FirstName: "Merry", PrimaryEmail: "merry@buckland.me", PrimaryPhone: "+1 555 0101"},
+	} {
+		res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		contacts = append(contacts, res.GetResult())
+	}
+	merged, err := client.Merge(DefaultContext(t), &pb.MergeContactsRequest{
+		Id:        contacts[0].GetId(),
+		MergedIds: []*resource.Identifier{contacts[1].GetId()},
+	})
+	if err != nil {
+		t.Fatalf("unable to merge contacts: %s", err)
+	}
+
+	_, err = client.Undelete(DefaultContext(t), &pb.UndeleteContactRequest{Id: contacts[1].GetId()})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("unexpected error undeleting the merged contact: have %v; expected %s", err, codes.AlreadyExists)
+	}
+
+	survivor := merged.GetResult()
+	survivor.Emails = []*pb.Email{{Address: "merry@bucklebury.me"}}
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: survivor}); err != nil {
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	res, err := client.Undelete(DefaultContext(t), &pb.UndeleteContactRequest{Id: contacts[1].GetId()})
+	if err != nil {
+		t.Fatalf("unable to undelete the merged contact: %s", err)
+	}
+	if res.GetResult().GetPrimaryEmail() != "merry@buckland.me" || res.GetResult().GetPrimaryPhone() != "+1 555 0101" {
+		t.Errorf("unexpected undeleted contact: have %v; expected its own e-mail address and phone number", res.GetResult())
+	}
+}
@@ -0,0 +1,119 @@
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"testing"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// importFile uploads the file to the Import endpoint in two chunks
+func importFile(t *testing.T, client pb.ContactsClient, opts *pb.ImportOptions, data string) *pb.ImportContactsResponse {
+	stream, err := client.Import(DefaultContext(t))
+	if err != nil {
+		t.Fatalf("unable to start import: %v", err)
+	}
+	half := len(data) / 2
+	if err := stream.Send(&pb.ImportContactsRequest{Options: opts, Data: []byte(data[:half])}); err != nil {
+		t.Fatalf("unable to send file: %v", err)
+	}
+	if err := stream.Send(&pb.ImportContactsRequest{Data: []byte(data[half:])}); err != nil {
+		t.Fatalf("unable to send file: %v", err)
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("unable to import contacts: %v", err)
+	}
+	return res
+}
+
+// exportCSV downloads the contacts from the Export endpoint
+func exportCSV(t *testing.T, client pb.ContactsClient, in *pb.ExportContactsRequest) [][]string {
+	stream, err := client.Export(DefaultContext(t), in)
+	if err != nil {
+		t.Fatalf("unable to start export: %v", err)
+	}
+	var data bytes.Buffer
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unable to export contacts: %v", err)
+		}
+		data.Write(chunk.GetData())
+	}
+	records, err := csv.NewReader(&data).ReadAll()
+	if err != nil {
+		t.Fatalf("unable to parse exported file %q: %v", data.String(), err)
+	}
+	return records
+}
+
+// TestImportExportContacts verifies that contacts can be imported from and
+// exported to CSV files
+// 1. Import a file with a mapped column and an invalid row as a dry run
+// 2. Ensure that the invalid row is reported and nothing is created
+// 3. Import the file and ensure that the valid rows are created
+// 4. Export the contacts and ensure that the file has the imported values
+func TestImportExportContacts(t *testing.T) {
+	dbTest.Reset(t)
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+
+	file := "first_name,last_name,E-mail,home_address.city,nicknames,unknown\n" +
+		"Frodo,Baggins,frodo@shire.me,Hobbiton,Mr. Underhill;Ring-bearer,x\n" +
+		"Samwise,Gamgee,not an e-mail,Hobbiton,Sam,y\n" +
+		"Bilbo,Baggins,bilbo@shire.me,Rivendell,,z\n"
+	mapping := map[string]string{"E-mail": "primary_email"}
+
+	dryRun := importFile(t, client, &pb.ImportOptions{DryRun: true, Mapping: mapping}, file)
+	if dryRun.GetCreated() != 2 {
+		t.Errorf("unexpected number of created contacts on a dry run: %d - expected: 2", dryRun.GetCreated())
+	}
+	if len(dryRun.GetErrors()) != 1 || dryRun.GetErrors()[0].GetTarget() != "3" {
+		t.Errorf("unexpected errors: %v - expected an error of row 3", dryRun.GetErrors())
+	}
+	list, err := client.List(DefaultContext(t), &pb.ListContactRequest{})
+	if err != nil {
+		t.Fatalf("unable to list contacts: %v", err)
+	}
+	if len(list.GetResults()) != 0 {
+		t.Fatalf("a dry run created %d contacts", len(list.GetResults()))
+	}
+
+	res := importFile(t, client, &pb.ImportOptions{Mapping: mapping}, file)
+	if res.GetCreated() != 2 {
+		t.Errorf("unexpected number of created contacts: %d - expected: 2", res.GetCreated())
+	}
+
+	records := exportCSV(t, client, &pb.ExportContactsRequest{})
+	if len(records) != 3 {
+		t.Fatalf("unexpected number of exported rows: %d - expected: 3", len(records))
+	}
+	header := map[string]int{}
+	for i, name := range records[0] {
+		header[name] = i
+	}
+	frodo := records[1]
+	for column, expected := range map[string]string{
+		"first_name":        "Frodo",
+		"primary_email":     "frodo@shire.me",
+		"home_address.city": "Hobbiton",
+		"nicknames":         "Mr. Underhill;Ring-bearer",
+	} {
+		i, ok := header[column]
+		if !ok {
+			t.Errorf("exported file has no %q column", column)
+			continue
+		}
+		if frodo[i] != expected {
+			t.Errorf("unexpected %q: %q - expected: %q", column, frodo[i], expected)
+		}
+	}
+}
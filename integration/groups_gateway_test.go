@@ -0,0 +1,45 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"testing"
+)
+
+// TestGroupMembers_REST verifies that the members of a group can be changed
+// and listed page by page using the REST gateway
+// 1. Create a group and five contacts
+// 2. Add the contacts to the group
+// 3. Ensure the members are listed on three pages of two members
+// 4. Remove a contact and ensure the other four are listed
+func TestGroupMembers_REST(t *testing.T) {
+	dbTest.Reset(t)
+	group := requestJSON(t, http.MethodPost, "groups", map[string]string{"name": "Fellowship"})
+	groupPath := "groups/" + path.Base(group.GetPath("result", "id").MustString())
+	var ids []string
+	for _, name := range []string{"Frodo", "Samwise", "Meriadoc", "Peregrin", "Boromir"} {
+		res := requestJSON(t, http.MethodPost, "contacts", map[string]string{"first_name": name})
+		ids = append(ids, res.GetPath("result", "id").MustString())
+	}
+	requestJSON(t, http.MethodPost, groupPath+"/contacts:add", map[string][]string{"contact_ids": ids})
+
+	pages := listPages(t, groupPath+"/contacts", url.Values{"_limit": {"2"}, "_order_by": {"id"}})
+	var members []string
+	for _, page := range pages {
+		for _, res := range page {
+			members = append(members, fmt.Sprint(res.(map[string]interface{})["id"]))
+		}
+	}
+	if len(pages) != 3 || fmt.Sprint(members) != fmt.Sprint(ids) {
+		t.Errorf("unexpected members: have %v on %d pages; expected %v on 3 pages", members, len(pages), ids)
+	}
+
+	requestJSON(t, http.MethodPost, groupPath+"/contacts:remove", map[string][]string{"contact_ids": ids[4:]})
+	if have := listIDs(t, groupPath+"/contacts", url.Values{"_order_by": {"id"}}); fmt.Sprint(have) != fmt.Sprint(ids[:4]) {
+		t.Errorf("unexpected members after removal: have %v; expected %v", have, ids[:4])
+	}
+}
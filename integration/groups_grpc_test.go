@@ -0,0 +1,340 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGroupMembers verifies that the members of a group can be managed
+// without updating the whole group
+// 1. Create a group and three contacts
+// 2. Add the contacts to the group, twice for one of them
+// 3. Ensure the group lists its members page by page
+// 4. Remove a contact and ensure it is no longer listed
+// 5. Ensure a contact of another account can't be added
+func TestGroupMembers(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	groups, closeGroups := newGroupsClient(t)
+	defer closeGroups()
+	group, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Fellowship"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	groupID := group.GetResult().GetId()
+	ids := []*resource.Identifier{}
+	for _, name := range []string{"Frodo", "Samwise", "Gandalf"} {
+		res, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+			Payload: &pb.Contact{FirstName: name},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId())
+	}
+
+	if _, err := groups.AddContacts(DefaultContext(t), &pb.AddGroupContactsRequest{
+		Id: groupID, ContactIds: ids[:2],
+	}); err != nil {
+		t.Fatalf("unable to add contacts: %s", err)
+	}
+	if _, err := groups.AddContacts(DefaultContext(t), &pb.AddGroupContactsRequest{
+		Id: groupID, ContactIds: ids[1:],
+	}); err != nil {
+		t.Fatalf("unable to add contacts again: %s", err)
+	}
+	page, err := groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{
+		Id: groupID, Paging: &query.Pagination{Limit: 2},
+	})
+	if err != nil {
+		t.Fatalf("unable to list members: %s", err)
+	}
+	if len(page.GetResults()) != 2 || page.GetResults()[0].GetFirstName() != "Frodo" {
+		t.Errorf("unexpected first page of members: %v", page.GetResults())
+	}
+	page, err = groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{
+		Id: groupID, Paging: &query.Pagination{Limit: 2, Offset: 2},
+	})
+	if err != nil {
+		t.Fatalf("unable to list members: %s", err)
+	}
+	if len(page.GetResults()) != 1 || page.GetResults()[0].GetFirstName() != "Gandalf" {
+		t.Errorf("unexpected second page of members: %v", page.GetResults())
+	}
+
+	if _, err := groups.RemoveContacts(DefaultContext(t), &pb.RemoveGroupContactsRequest{
+		Id: groupID, ContactIds: ids[2:],
+	}); err != nil {
+		t.Fatalf("unable to remove contacts: %s", err)
+	}
+	members, err := groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{Id: groupID})
+	if err != nil {
+		t.Fatalf("unable to list members: %s", err)
+	}
+	if len(members.GetResults()) != 2 {
+		t.Errorf("unexpected number of members: have %d; expected 2", len(members.GetResults()))
+	}
+
+	other, err := contacts.Create(OtherAccountContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Saruman"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	_, err = groups.AddContacts(DefaultContext(t), &pb.AddGroupContactsRequest{
+		Id: groupID, ContactIds: []*resource.Identifier{other.GetResult().GetId()},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error adding a contact of another account: have %v; expected %s", err, codes.NotFound)
+	}
+}
+
+// TestGroupMembers_primaryEmail verifies that the members of a group can be
+// filtered by the synthetic primary_email field
+// 1. Create a group of two contacts and a contact outside of it
+// 2. Ensure the members are filtered by their primary e-mail address
+func TestGroupMembers_primaryEmail(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	groups, closeGroups := newGroupsClient(t)
+	defer closeGroups()
+	group, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Company"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	groupID := group.GetResult().GetId()
+	ids := []*resource.Identifier{}
+	for _, email := range []string{"thorin@erebor.me", "balin@erebor.me", "bilbo@bagend.me"} {
+		res, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+			Payload: &pb.Contact{FirstName: email[:5], PrimaryEmail: email},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId())
+	}
+	if _, err := groups.AddContacts(DefaultContext(t), &pb.AddGroupContactsRequest{
+		Id: groupID, ContactIds: ids[:2],
+	}); err != nil {
+		t.Fatalf("unable to add contacts: %s", err)
+	}
+
+	for email, expected := range map[string]int{"balin@erebor.me": 1, "bilbo@bagend.me": 0} {
+		filter, err := query.ParseFiltering(fmt.Sprintf("primary_email == '%s'", email))
+		if err != nil {
+			t.Fatalf("unable to parse filter: %s", err)
+		}
+		members, err := groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{
+			Id:     groupID,
+			Filter: filter,
+		})
+		if err != nil {
+			t.Fatalf("unable to list members by primary e-mail address %s: %s", email, err)
+		}
+		if len(members.GetResults()) != expected {
+			t.Errorf("unexpected members with primary e-mail address %s: have %v; expected %d",
+				email, members.GetResults(), expected,
+			)
+		}
+	}
+}
+
+// TestSmartGroup verifies that the members of a smart group are the contacts
+// matching its member filter
+// 1. Create three contacts and a smart group of the Tooks
+// 2. Ensure the group lists and reads the matching contacts only
+// 3. Update the notes of the read group and ensure its members are kept
+// 4. Ensure the members can be filtered further
+// 5. Ensure a filter of the synthetic primary_email field is supported
+// 6. Ensure the members of the smart group can't be added
+// 7. Ensure groups with a malformed filter or a filter by an unknown field can't be created
+func TestSmartGroup(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	groups, closeGroups := newGroupsClient(t)
+	defer closeGroups()
+	ids := []*resource.Identifier{}
+	for _, c := range []*pb.Contact{
+		{FirstName: "Peregrin", LastName: "Took", PrimaryEmail: "pippin@tuckborough.me"},
+		{FirstName: "Paladin", LastName: "Took"},
+		{FirstName: "Meriadoc", LastName: "Brandybuck"},
+	} {
+		res, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId())
+	}
+	group, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Tooks", MemberFilter: "last_name == 'Took'"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	groupID := group.GetResult().GetId()
+
+	members, err := groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{Id: groupID})
+	if err != nil {
+		t.Fatalf("unable to list members: %s", err)
+	}
+	if len(members.GetResults()) != 2 {
+		t.Errorf("unexpected number of members: have %d; expected 2", len(members.GetResults()))
+	}
+	read, err := groups.Read(DefaultContext(t), &pb.ReadGroupRequest{Id: groupID})
+	if err != nil {
+		t.Fatalf("unable to read group: %s", err)
+	}
+	if len(read.GetResult().GetContacts()) != 2 {
+		t.Errorf("unexpected number of contacts of the group: have %d; expected 2", len(read.GetResult().GetContacts()))
+	}
+
+	edited := read.GetResult()
+	edited.Notes = "of Tuckborough"
+	updated, err := groups.Update(DefaultContext(t), &pb.UpdateGroupRequest{Payload: edited})
+	if err != nil {
+		t.Fatalf("unable to update the read group: %s", err)
+	}
+	if updated.GetResult().GetNotes() != edited.GetNotes() {
+		t.Errorf("unexpected notes of the updated group: have %q; expected %q", updated.GetResult().GetNotes(), edited.GetNotes())
+	}
+	read, err = groups.Read(DefaultContext(t), &pb.ReadGroupRequest{Id: groupID})
+	if err != nil {
+		t.Fatalf("unable to read group: %s", err)
+	}
+	if len(read.GetResult().GetContacts()) != 2 {
+		t.Errorf("unexpected number of contacts of the updated group: have %d; expected 2", len(read.GetResult().GetContacts()))
+	}
+
+	filter, err := query.ParseFiltering("first_name == 'Paladin'")
+	if err != nil {
+		t.Fatalf("unable to parse filter: %s", err)
+	}
+	filtered, err := groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{
+		Id:     groupID,
+		Filter: filter,
+	})
+	if err != nil {
+		t.Fatalf("unable to list members: %s", err)
+	}
+	if len(filtered.GetResults()) != 1 || filtered.GetResults()[0].GetFirstName() != "Paladin" {
+		t.Errorf("unexpected filtered members: %v", filtered.GetResults())
+	}
+	email, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Pippin", MemberFilter: "primary_email == 'pippin@tuckborough.me'"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	members, err = groups.ListMembers(DefaultContext(t), &pb.ListGroupMembersRequest{Id: email.GetResult().GetId()})
+	if err != nil {
+		t.Fatalf("unable to list members: %s", err)
+	}
+	if len(members.GetResults()) != 1 || members.GetResults()[0].GetFirstName() != "Peregrin" {
+		t.Errorf("unexpected members: %v", members.GetResults())
+	}
+
+	_, err = groups.AddContacts(DefaultContext(t), &pb.AddGroupContactsRequest{
+		Id: groupID, ContactIds: ids[2:],
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("unexpected error adding a contact to a smart group: have %v; expected %s", err, codes.FailedPrecondition)
+	}
+	for _, filter := range []string{"last_name ==", "shoe_size == 42"} {
+		_, err = groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+			Payload: &pb.Group{Name: "Invalid", MemberFilter: filter},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("unexpected error creating a group with the filter %q: have %v; expected %s", filter, err, codes.InvalidArgument)
+		}
+	}
+}
+
+// TestSmartGroup_contactGroups verifies that a contact can't be made a member
+// of a smart group by its groups
+// 1. Create a smart group, a manual group and two contacts
+// 2. Ensure creating and updating a contact with the smart group fails
+// 3. Ensure a batch update with the smart group fails
+// 4. Store a membership of the smart group directly in the database
+// 5. Merge the contacts and ensure the survivor gets the manual group only
+func TestSmartGroup_contactGroups(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	groups, closeGroups := newGroupsClient(t)
+	defer closeGroups()
+	smart, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Tooks", MemberFilter: "last_name == 'Took'"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	manual, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Travellers"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	smartGroup := &pb.Group{Id: smart.GetResult().GetId()}
+	manualGroup := &pb.Group{Id: manual.GetResult().GetId()}
+
+	_, err = contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Meriadoc", Groups: []*pb.Group{smartGroup}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("unexpected error creating a contact of a smart group: have %v; expected %s", err, codes.FailedPrecondition)
+	}
+	var ids []*resource.Identifier
+	for _, c := range []*pb.Contact{
+		{FirstName: "Peregrin", LastName: "Took"},
+		{FirstName: "Pippin", LastName: "Took", Groups: []*pb.Group{manualGroup}},
+	} {
+		res, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId())
+	}
+	update := &pb.Contact{Id: ids[0], FirstName: "Peregrin", LastName: "Took", Groups: []*pb.Group{smartGroup}}
+	_, err = contacts.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: update})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("unexpected error updating a contact with a smart group: have %v; expected %s", err, codes.FailedPrecondition)
+	}
+	_, err = contacts.BatchUpdate(DefaultContext(t), &pb.BatchUpdateContactsRequest{
+		Mode:    pb.BatchMode_ATOMIC,
+		Payload: []*pb.Contact{update},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("unexpected error updating a batch with a smart group: have %v; expected %s", err, codes.FailedPrecondition)
+	}
+
+	// a membership stored before smart groups were rejected
+	if err := db.Exec("INSERT INTO group_contacts (group_id, contact_id) VALUES (?, ?)",
+		smart.GetResult().GetId().GetResourceId(), ids[1].GetResourceId()).Error; err != nil {
+		t.Fatalf("unable to add contact to smart group: %v", err)
+	}
+	merged, err := contacts.Merge(DefaultContext(t), &pb.MergeContactsRequest{Id: ids[0], MergedIds: ids[1:]})
+	if err != nil {
+		t.Fatalf("unable to merge contacts: %s", err)
+	}
+	resultGroups := merged.GetResult().GetGroups()
+	if len(resultGroups) != 1 || resultGroups[0].GetId().GetResourceId() != manualGroup.GetId().GetResourceId() {
+		t.Errorf("unexpected groups of the merged contact: have %v; expected the manual group only", resultGroups)
+	}
+}
@@ -21,6 +21,8 @@ const (
 var (
 	// DefaultClaims is the standard payload inside a test JWT
 	DefaultClaims = jwt.MapClaims{"AccountID": "TestAccount"}
+	// OtherAccountClaims is the payload of a test JWT for a second tenant
+	OtherAccountClaims = jwt.MapClaims{"AccountID": "OtherTestAccount"}
 )
 
 // DefaultContext returns a context that has a jwt for basic testing purposes
@@ -33,6 +35,17 @@ func DefaultContext(t *testing.T) context.Context {
 	return ContextWithToken(ctx, token)
 }
 
+// OtherAccountContext returns a context that has a jwt for an account other
+// than the one used by DefaultContext
+func OtherAccountContext(t *testing.T) context.Context {
+	token, err := MakeToken(OtherAccountClaims)
+	if err != nil {
+		t.Fatalf("unable to create other account context: %v", err)
+	}
+	ctx := context.Background()
+	return ContextWithToken(ctx, token)
+}
+
 // ContextWithToken creates a context with a jwt
 func ContextWithToken(ctx context.Context, token string) context.Context {
 	c := metadata.AppendToOutgoingContext(
@@ -4,6 +4,8 @@ package integration
 
 import (
 	"log"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -16,6 +18,8 @@ const (
 
 var (
 	dbTest PostgresDBConfig
+	// outboxLog is the file the server writes the outbox events to
+	outboxLog = filepath.Join(os.TempDir(), "contacts-application-test-outbox.log")
 )
 
 // TestMain launches a gRPC server, REST gateway, and Postgres database
@@ -45,7 +49,15 @@ func TestMain(m *testing.M) {
 
 	// start the gRPC server; stop processes when finished
 	log.Printf("running the server binary")
-	closeServer, err := RunBinary("server", "-db", dbTest.GetDSN())
+	os.Remove(outboxLog)
+	defer os.Remove(outboxLog)
+	closeServer, err := RunBinary("server", "-db", dbTest.GetDSN(),
+		// deliver and retry webhooks without waiting long in tests
+		"-webhook-interval", "100ms", "-webhook-backoff", "100ms",
+		// the webhook receivers of the tests listen on the loopback interface
+		"-webhook-allow-private",
+		"-outbox-interval", "100ms", "-outbox-log", outboxLog,
+	)
 	if err != nil {
 		log.Fatalf("failed to run the server: %v", err)
 	}
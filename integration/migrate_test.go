@@ -0,0 +1,66 @@
+// +build integration
+
+package integration
+
+import (
+	"database/sql"
+	"testing"
+
+	migrate "github.com/infobloxopen/atlas-contacts-app/db"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// TestMigrateDB_emailsUnique verifies that MigrateDB replaces the unique
+// constraints of e-mail addresses created by former versions, so the
+// addresses of deleted contacts can be reused
+// 1. Replace the partial unique index with the global and the account constraints of former versions
+// 2. Create a contact and delete it
+// 3. Run MigrateDB twice and ensure only the partial unique index is left
+// 4. Ensure a new contact can use the address of the deleted one
+func TestMigrateDB_emailsUnique(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	for _, stmt := range []string{
+		"DROP INDEX emails_account_id_address_key",
+		"ALTER TABLE emails ADD CONSTRAINT emails_address_key UNIQUE (address)",
+		"ALTER TABLE emails ADD CONSTRAINT emails_account_id_address_key UNIQUE (account_id, address)",
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatalf("unable to restore the former schema with %q: %v", stmt, err)
+		}
+	}
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+	payload := &pb.Contact{FirstName: "Boromir", PrimaryEmail: "boromir@gondor.gov"}
+	created, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: payload})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{Id: created.GetResult().GetId()}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+
+	sqlDB, err := sql.Open("postgres", dbTest.GetDSN())
+	if err != nil {
+		t.Fatalf("unable to connect to %s database: %v", dbTest.DBName, err)
+	}
+	defer sqlDB.Close()
+	for i := 0; i < 2; i++ {
+		if err := migrate.MigrateDB(*sqlDB); err != nil {
+			t.Fatalf("unable to migrate database: %v", err)
+		}
+	}
+	if n := countRows(t, db, "pg_constraint", "conname IN (?)",
+		[]string{"emails_address_key", "emails_account_id_address_key"}); n != 0 {
+		t.Errorf("unexpected number of unique constraints of e-mail addresses: %d - expected: 0", n)
+	}
+	if n := countRows(t, db, "pg_indexes", "indexname = ? AND indexdef LIKE ?",
+		"emails_account_id_address_key", "%WHERE%"); n != 1 {
+		t.Errorf("the unique index of e-mail addresses within an account isn't partial")
+	}
+
+	if _, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: payload}); err != nil {
+		t.Errorf("unable to create contact with the address of a deleted one: %s", err)
+	}
+}
@@ -0,0 +1,171 @@
+// +build integration
+
+package integration
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// outboxLogEvent is an event written to the outbox log by the server
+type outboxLogEvent struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		ID json.RawMessage `json:"id"`
+	} `json:"data"`
+}
+
+// readOutboxLog returns the events written to the outbox log after offset
+func readOutboxLog(t *testing.T, offset int64) []outboxLogEvent {
+	f, err := os.Open(outboxLog)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("unable to open outbox log: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, 0); err != nil {
+		t.Fatalf("unable to read outbox log: %v", err)
+	}
+	events := []outboxLogEvent{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e outboxLogEvent
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("unable to decode outbox event %q: %v", scanner.Text(), err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// outboxLogSize returns the current size of the outbox log
+func outboxLogSize(t *testing.T) int64 {
+	info, err := os.Stat(outboxLog)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	if err != nil {
+		t.Fatalf("unable to read outbox log: %v", err)
+	}
+	return info.Size()
+}
+
+// TestOutboxEvents verifies that the changes of a contact are published from
+// the outbox in the order they were made
+// 1. Create, update and delete a contact
+// 2. Ensure that the outbox relay publishes the three events in order
+// 3. Ensure that a rejected update doesn't publish any event
+func TestOutboxEvents(t *testing.T) {
+	dbTest.Reset(t)
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+	offset := outboxLogSize(t)
+
+	created, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Frodo", LastName: "Baggins"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	contact := created.GetResult()
+	contact.MiddleName = "of the Shire"
+	updated, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: contact})
+	if err != nil {
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	// the entity tag of contact is outdated after the update
+	if updated.GetResult().GetEtag() == contact.GetEtag() {
+		t.Fatalf("the entity tag wasn't changed by the update")
+	}
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: contact}); err == nil {
+		t.Fatalf("the update with an outdated entity tag succeeded")
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{Id: contact.GetId()}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+
+	expected := []string{"contact.created", "contact.updated", "contact.deleted"}
+	var events []outboxLogEvent
+	for deadline := time.Now().Add(5 * time.Second); len(events) < len(expected); {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected number of outbox events: have %d; expected %d", len(events), len(expected))
+		}
+		time.Sleep(100 * time.Millisecond)
+		events = readOutboxLog(t, offset)
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("unexpected number of outbox events: have %d; expected %d", len(events), len(expected))
+	}
+	for i, e := range events {
+		if e.Type != expected[i] {
+			t.Errorf("unexpected type of outbox event %d: have %q; expected %q", i, e.Type, expected[i])
+		}
+		if len(e.Data.ID) == 0 || string(e.Data.ID) != string(events[0].Data.ID) {
+			t.Errorf("unexpected contact of outbox event %d: have %s; expected %s", i, e.Data.ID, events[0].Data.ID)
+		}
+		if i > 0 && e.ID <= events[i-1].ID {
+			t.Errorf("outbox event %d was published out of order", i)
+		}
+	}
+}
+
+// TestOutboxEvents_lateCommit verifies that an event committed after a newer
+// one is published before it, in the order of the transactions
+// 1. Store an event in a transaction which is kept open
+// 2. Create a contact and ensure no event is published
+// 3. Commit the transaction and ensure both events are published in the order of the transactions
+func TestOutboxEvents_lateCommit(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+	offset := outboxLogSize(t)
+
+	tx := db.Begin()
+	if err := tx.Exec(`INSERT INTO outbox (account_id, type, payload, txid, created_at)
+		VALUES ('AccountID', 'contact.updated', '{"id": "late"}', txid_current(), now())`).Error; err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to store outbox event: %v", err)
+	}
+	if _, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Samwise", LastName: "Gamgee"},
+	}); err != nil {
+		tx.Rollback()
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	time.Sleep(time.Second)
+	if events := readOutboxLog(t, offset); len(events) != 0 {
+		tx.Rollback()
+		t.Fatalf("unexpected outbox events while an older transaction is running: %v", events)
+	}
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("unable to commit outbox event: %v", err)
+	}
+
+	expected := []string{"contact.updated", "contact.created"}
+	var events []outboxLogEvent
+	for deadline := time.Now().Add(5 * time.Second); len(events) < len(expected); {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected number of outbox events: have %d; expected %d", len(events), len(expected))
+		}
+		time.Sleep(100 * time.Millisecond)
+		events = readOutboxLog(t, offset)
+	}
+	for i, e := range events {
+		if e.Type != expected[i] {
+			t.Errorf("unexpected type of outbox event %d: have %q; expected %q", i, e.Type, expected[i])
+		}
+	}
+	if string(events[0].Data.ID) != `"late"` {
+		t.Errorf("unexpected data of outbox event 0: have %s; expected %q", events[0].Data.ID, "late")
+	}
+}
@@ -0,0 +1,101 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// maxPages is the number of pages after which listPages gives up on a list
+// whose page token never reaches the end
+const maxPages = 20
+
+// listPages lists the resources using the REST gateway page by page with the
+// page token and returns the results of every page which isn't empty
+func listPages(t *testing.T, path string, query url.Values) [][]interface{} {
+	var pages [][]interface{}
+	query.Set("_page_token", "null")
+	for len(pages) < maxPages {
+		res := requestJSON(t, http.MethodGet, path+"?"+query.Encode(), nil)
+		token, err := res.GetPath("success", "_page_token").String()
+		if err != nil {
+			t.Fatalf("unable to get page token from response json: %v", err)
+		}
+		results := res.Get("results").MustArray()
+		if len(results) > 0 {
+			pages = append(pages, results)
+		}
+		if token == "null" {
+			return pages
+		}
+		query.Set("_page_token", token)
+	}
+	t.Fatalf("the list of %s has more than %d pages", path, maxPages)
+	return nil
+}
+
+// TestSearchContacts_REST_pageToken verifies that the search results can be
+// walked through with the page token
+// 1. Create seven matching contacts and one which doesn't match
+// 2. Search page by page with two results per page
+// 3. Ensure there are four pages and every matching contact is found once
+func TestSearchContacts_REST_pageToken(t *testing.T) {
+	dbTest.Reset(t)
+	for _, name := range []string{"Bandobras", "Belladonna", "Peregrin", "Paladin", "Isengrim", "Gerontius", "Fortinbras"} {
+		requestJSON(t, http.MethodPost, "contacts", map[string]string{"first_name": name, "last_name": "Took"})
+	}
+	requestJSON(t, http.MethodPost, "contacts", map[string]string{"first_name": "Meriadoc", "last_name": "Brandybuck"})
+
+	pages := listPages(t, "contacts:search", url.Values{"q": {"took"}, "_limit": {"2"}})
+	if len(pages) != 4 {
+		t.Fatalf("unexpected number of search pages: have %d; expected 4", len(pages))
+	}
+	found := map[string]bool{}
+	for _, page := range pages {
+		for _, res := range page {
+			contact := res.(map[string]interface{})["contact"].(map[string]interface{})
+			id := fmt.Sprint(contact["id"])
+			if found[id] {
+				t.Errorf("contact %s found on more than one page", id)
+			}
+			found[id] = true
+			if contact["last_name"] != "Took" {
+				t.Errorf("unexpected contact found: have %v; expected a Took", contact)
+			}
+		}
+	}
+	if len(found) != 7 {
+		t.Errorf("unexpected number of contacts found: have %d; expected 7", len(found))
+	}
+}
+
+// TestListContacts_REST_pageToken verifies that the contacts can be walked
+// through with the page token, the token keeps the offset and the limit of
+// the next page in this order
+// 1. Create seven contacts
+// 2. List them page by page with two contacts per page
+// 3. Ensure there are four pages in order and every contact is listed once
+func TestListContacts_REST_pageToken(t *testing.T) {
+	dbTest.Reset(t)
+	names := []string{"Bandobras", "Belladonna", "Fortinbras", "Gerontius", "Isengrim", "Paladin", "Peregrin"}
+	for _, name := range names {
+		requestJSON(t, http.MethodPost, "contacts", map[string]string{"first_name": name, "last_name": "Took"})
+	}
+
+	pages := listPages(t, "contacts", url.Values{"_limit": {"2"}, "_order_by": {"first_name"}})
+	if len(pages) != 4 {
+		t.Fatalf("unexpected number of pages: have %d; expected 4", len(pages))
+	}
+	listed := []string{}
+	for _, page := range pages {
+		for _, res := range page {
+			listed = append(listed, fmt.Sprint(res.(map[string]interface{})["first_name"]))
+		}
+	}
+	if fmt.Sprint(listed) != fmt.Sprint(names) {
+		t.Errorf("unexpected contacts listed page by page: have %v; expected %v", listed, names)
+	}
+}
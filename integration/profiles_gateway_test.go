@@ -5,8 +5,11 @@ package integration
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
 	"strings"
 	"testing"
+	"time"
 
 	simplejson "github.com/bitly/go-simplejson"
 	"github.com/infobloxopen/atlas-contacts-app/cmd"
@@ -331,3 +334,119 @@ func TestListProfiles_gateway(t *testing.T) {
 		})
 	}
 }
+
+// TestProfileContactsAndGroups_gateway uses the REST gateway to create and
+// list the contacts and the groups of a profile
+// 1. Create two profiles, a contact of the first one with a POST request to /profiles/{id}/contacts and a group of it
+// 2. Ensure the contact and the group are listed with GET requests to /profiles/{id}/contacts and /profiles/{id}/groups
+// 3. Ensure the lists of the other profile are empty
+// 4. Ensure the creation of the contact is recorded in the audit log
+func TestProfileContactsAndGroups_gateway(t *testing.T) {
+	dbTest.Reset(t)
+	work := requestJSON(t, http.MethodPost, "profiles", map[string]string{"name": "work"})
+	workID := work.GetPath("result", "id").MustString()
+	home := requestJSON(t, http.MethodPost, "profiles", map[string]string{"name": "home"})
+	workPath := "profiles/" + path.Base(workID)
+	homePath := "profiles/" + path.Base(home.GetPath("result", "id").MustString())
+
+	created := requestJSON(t, http.MethodPost, workPath+"/contacts", map[string]string{"first_name": "Radagast"})
+	ValidateJSONSchema(t, created.GetPath("success"), `{"code":"OK","status":200}`)
+	contactID := created.GetPath("result", "id").MustString()
+	if profileID := created.GetPath("result", "profile_id").MustString(); profileID != workID {
+		t.Errorf("unexpected profile of the created contact: have %q; expected %q", profileID, workID)
+	}
+	group := requestJSON(t, http.MethodPost, "groups", map[string]string{"name": "wizards", "profile_id": workID})
+	groupID := group.GetPath("result", "id").MustString()
+
+	if have := listIDs(t, workPath+"/contacts", url.Values{}); fmt.Sprint(have) != fmt.Sprint([]string{contactID}) {
+		t.Errorf("unexpected contacts of the profile: have %v; expected %v", have, []string{contactID})
+	}
+	if have := listIDs(t, workPath+"/groups", url.Values{}); fmt.Sprint(have) != fmt.Sprint([]string{groupID}) {
+		t.Errorf("unexpected groups of the profile: have %v; expected %v", have, []string{groupID})
+	}
+	for _, list := range []string{homePath + "/contacts", homePath + "/groups"} {
+		if have := listIDs(t, list, url.Values{}); len(have) != 0 {
+			t.Errorf("unexpected results of %s: have %v; expected none", list, have)
+		}
+	}
+
+	auditLog, closeAuditLog := newAuditLogClient(t)
+	defer closeAuditLog()
+	resList, err := auditLog.List(DefaultContext(t), &pb.ListAuditEventRequest{})
+	if err != nil {
+		t.Fatalf("unable to list audit events: %s", err)
+	}
+	found := false
+	for _, event := range resList.GetResults() {
+		if event.GetMethod() == "/api.contacts.Profiles/CreateContact" && event.GetResourceId() == "contacts/1" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("no audit event of the contact created within the profile: have %v", resList.GetResults())
+	}
+}
+
+// TestCreateProfileContact_gateway_serverFields verifies that the timestamps
+// and the entity tag of a contact created within a profile are set by the
+// server and the values sent by the client are ignored
+// 1. Create a profile
+// 2. Create a contact of it with a made-up created_at, updated_at and etag
+// 3. Ensure the contact has recent timestamps and the entity tag of a new contact
+func TestCreateProfileContact_gateway_serverFields(t *testing.T) {
+	dbTest.Reset(t)
+	profile := requestJSON(t, http.MethodPost, "profiles", map[string]string{"name": "work"})
+	profilePath := "profiles/" + path.Base(profile.GetPath("result", "id").MustString())
+
+	before := time.Now().Add(-time.Minute)
+	created := requestJSON(t, http.MethodPost, profilePath+"/contacts", map[string]string{
+		"first_name": "Radagast",
+		"created_at": "2001-01-01T00:00:00Z",
+		"updated_at": "2001-01-01T00:00:00Z",
+		"etag":       "42",
+	})
+	for _, field := range []string{"created_at", "updated_at"} {
+		value := created.GetPath("result", field).MustString()
+		if ts, err := time.Parse(time.RFC3339Nano, value); err != nil || ts.Before(before) {
+			t.Errorf("unexpected %s of the created contact: have %q; expected the time of the request", field, value)
+		}
+	}
+	if etag := created.GetPath("result", "etag").MustString(); etag == "42" {
+		t.Errorf("unexpected etag of the created contact: have %q; expected the one of a new contact", etag)
+	}
+}
+
+// TestProfileContacts_gateway_collectionOperators verifies that the nested
+// lists of a profile support the synthetic fields and the page token of the
+// lists of contacts
+// 1. Create a profile with two contacts and two groups
+// 2. Ensure its contacts can be filtered by primary_email
+// 3. Ensure its contacts and groups can be listed page by page with the page token
+func TestProfileContacts_gateway_collectionOperators(t *testing.T) {
+	dbTest.Reset(t)
+	profile := requestJSON(t, http.MethodPost, "profiles", map[string]string{"name": "work"})
+	profileID := profile.GetPath("result", "id").MustString()
+	profilePath := "profiles/" + path.Base(profileID)
+	var contactIDs []string
+	for _, c := range []map[string]string{
+		{"first_name": "Bilbo", "primary_email": "bilbo@shire.me"},
+		{"first_name": "Frodo", "primary_email": "frodo@shire.me"},
+	} {
+		created := requestJSON(t, http.MethodPost, profilePath+"/contacts", c)
+		contactIDs = append(contactIDs, created.GetPath("result", "id").MustString())
+	}
+	for _, name := range []string{"hobbits", "ring-bearers"} {
+		requestJSON(t, http.MethodPost, "groups", map[string]string{"name": name, "profile_id": profileID})
+	}
+
+	have := listIDs(t, profilePath+"/contacts", url.Values{"_filter": {`primary_email=="frodo@shire.me"`}})
+	if fmt.Sprint(have) != fmt.Sprint(contactIDs[1:]) {
+		t.Errorf("unexpected contacts filtered by primary_email: have %v; expected %v", have, contactIDs[1:])
+	}
+	for _, list := range []string{profilePath + "/contacts", profilePath + "/groups"} {
+		pages := listPages(t, list, url.Values{"_limit": {"1"}})
+		if len(pages) != 2 {
+			t.Errorf("unexpected number of pages of %s: have %d; expected 2", list, len(pages))
+		}
+	}
+}
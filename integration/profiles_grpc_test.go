@@ -0,0 +1,90 @@
+// +build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestProfileContactsAndGroups verifies the nested calls of a profile
+// 1. Create a profile, a group of the profile and a contact of no profile
+// 2. Create a contact of the profile with the nested call
+// 3. Ensure the profile lists only its own contact and group
+// 4. Ensure the nested calls return NotFound for a profile of another account
+func TestProfileContactsAndGroups(t *testing.T) {
+	dbTest.Reset(t)
+	profiles, closeProfiles := newProfilesClient(t)
+	defer closeProfiles()
+	groups, closeGroups := newGroupsClient(t)
+	defer closeGroups()
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	profile, err := profiles.Create(DefaultContext(t), &pb.CreateProfileRequest{
+		Payload: &pb.Profile{Name: "Rivendell"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create profile: %s", err)
+	}
+	profileID := profile.GetResult().GetId()
+	if _, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Council", ProfileId: profileID},
+	}); err != nil {
+		t.Fatalf("unable to create group: %s", err)
+	}
+	if _, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Bill"},
+	}); err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+
+	created, err := profiles.CreateContact(DefaultContext(t), &pb.CreateProfileContactRequest{
+		Id:      profileID,
+		Payload: &pb.Contact{FirstName: "Elrond"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create contact of profile: %s", err)
+	}
+	if created.GetResult().GetProfileId().GetResourceId() != profileID.GetResourceId() {
+		t.Errorf("unexpected profile of the created contact: have %v; expected %v",
+			created.GetResult().GetProfileId(), profileID,
+		)
+	}
+
+	resContacts, err := profiles.ListContacts(DefaultContext(t), &pb.ListProfileContactsRequest{Id: profileID})
+	if err != nil {
+		t.Fatalf("unable to list contacts of profile: %s", err)
+	}
+	if len(resContacts.GetResults()) != 1 || resContacts.GetResults()[0].GetFirstName() != "Elrond" {
+		t.Errorf("unexpected contacts of profile: %v", resContacts.GetResults())
+	}
+	resGroups, err := profiles.ListGroups(DefaultContext(t), &pb.ListProfileGroupsRequest{Id: profileID})
+	if err != nil {
+		t.Fatalf("unable to list groups of profile: %s", err)
+	}
+	if len(resGroups.GetResults()) != 1 || resGroups.GetResults()[0].GetName() != "Council" {
+		t.Errorf("unexpected groups of profile: %v", resGroups.GetResults())
+	}
+
+	_, err = profiles.ListContacts(OtherAccountContext(t), &pb.ListProfileContactsRequest{Id: profileID})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error listing contacts of another account: have %v; expected %s", err, codes.NotFound)
+	}
+	_, err = profiles.CreateContact(OtherAccountContext(t), &pb.CreateProfileContactRequest{
+		Id:      profileID,
+		Payload: &pb.Contact{FirstName: "Saruman"},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error creating contact of another account: have %v; expected %s", err, codes.NotFound)
+	}
+	_, err = profiles.ListGroups(DefaultContext(t), &pb.ListProfileGroupsRequest{
+		Id: &resource.Identifier{ResourceId: "100"},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error listing groups of a missing profile: have %v; expected %s", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,309 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/purge"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/sirupsen/logrus"
+)
+
+// purgeRetention is the retention period used by the purge tests, the
+// expired records are moved twice as far into the past
+const purgeRetention = 24 * time.Hour
+
+// openTestDB connects to the test database
+func openTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open("postgres", dbTest.GetDSN())
+	if err != nil {
+		t.Fatalf("unable to connect to %s database: %v", dbTest.DBName, err)
+	}
+	return db
+}
+
+// countRows returns the number of rows of the table which match the condition
+func countRows(t *testing.T, db *gorm.DB, table, condition string, args ...interface{}) int {
+	var count int
+	if err := db.Table(table).Where(condition, args...).Count(&count).Error; err != nil {
+		t.Fatalf("unable to count rows of %s: %v", table, err)
+	}
+	return count
+}
+
+// createDeletedContacts creates n contacts in the account of ctx and deletes
+// them, the ids of the contacts are returned
+func createDeletedContacts(ctx context.Context, t *testing.T, client pb.ContactsClient, n int) []string {
+	var ids []string
+	for i := 0; i < n; i++ {
+		res, err := client.Create(ctx, &pb.CreateContactRequest{
+			Payload: &pb.Contact{FirstName: "Smeagol"},
+		})
+		if err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+		if _, err := client.Delete(ctx, &pb.DeleteContactRequest{Id: res.GetResult().GetId()}); err != nil {
+			t.Fatalf("unable to delete contact: %s", err)
+		}
+		ids = append(ids, res.GetResult().GetId().GetResourceId())
+	}
+	return ids
+}
+
+// expire moves the deletion time of the deleted rows of the table which match
+// the condition beyond the retention period
+func expire(t *testing.T, db *gorm.DB, table, condition string, args ...interface{}) {
+	if err := db.Table(table).Where("deleted_at IS NOT NULL").Where(condition, args...).
+		UpdateColumn("deleted_at", time.Now().Add(-2*purgeRetention)).Error; err != nil {
+		t.Fatalf("unable to expire deleted rows of %s: %v", table, err)
+	}
+}
+
+// newTestPurger returns a purger of the test database with the test retention
+func newTestPurger(db *gorm.DB, batchSize int) *purge.Purger {
+	return purge.NewPurger(db, purgeRetention, batchSize, logrus.New())
+}
+
+// TestPurgeRetention verifies that only the records deleted longer than the
+// retention period ago are purged
+// 1. Create contacts and a group, delete all but one contact
+// 2. Move the deletion time of one contact and the group beyond the retention
+// 3. Ensure only the expired contact and group are purged
+func TestPurgeRetention(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	groups, closeGroups := newGroupsClient(t)
+	defer closeGroups()
+
+	ids := createDeletedContacts(DefaultContext(t), t, contacts, 2)
+	if _, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Deagol"},
+	}); err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	group, err := groups.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "Stoors"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new group: %s", err)
+	}
+	if _, err := groups.Delete(DefaultContext(t), &pb.DeleteGroupRequest{Id: group.GetResult().GetId()}); err != nil {
+		t.Fatalf("unable to delete group: %s", err)
+	}
+	expire(t, db, "contacts", "id = ?", ids[0])
+	expire(t, db, "groups", "true")
+
+	purged, err := newTestPurger(db, 0).Purge(context.Background())
+	if err != nil {
+		t.Fatalf("unable to purge deleted records: %v", err)
+	}
+	if purged["contacts"] != 1 || purged["groups"] != 1 || purged["profiles"] != 0 {
+		t.Errorf("unexpected number of purged records: %v", purged)
+	}
+	if n := countRows(t, db, "contacts", "id = ?", ids[0]); n != 0 {
+		t.Errorf("expired contact %s is not purged", ids[0])
+	}
+	if n := countRows(t, db, "contacts", "true"); n != 2 {
+		t.Errorf("unexpected number of remaining contacts: have %d; expected %d", n, 2)
+	}
+	if n := countRows(t, db, "groups", "true"); n != 0 {
+		t.Errorf("unexpected number of remaining groups: have %d; expected %d", n, 0)
+	}
+}
+
+// TestPurgeBatches verifies that the records of every account are purged if
+// there are more of them than the batch size
+// 1. Create and delete contacts in two accounts, twice the batch size in one
+// 2. Expire the deleted contacts
+// 3. Ensure all of them are purged using a small batch size
+func TestPurgeBatches(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+
+	createDeletedContacts(DefaultContext(t), t, client, 4)
+	createDeletedContacts(OtherAccountContext(t), t, client, 3)
+	expire(t, db, "contacts", "true")
+
+	purged, err := newTestPurger(db, 2).Purge(context.Background())
+	if err != nil {
+		t.Fatalf("unable to purge deleted records: %v", err)
+	}
+	if purged["contacts"] != 7 {
+		t.Errorf("unexpected number of purged contacts: have %d; expected %d", purged["contacts"], 7)
+	}
+	if n := countRows(t, db, "contacts", "true"); n != 0 {
+		t.Errorf("unexpected number of remaining contacts: have %d; expected %d", n, 0)
+	}
+}
+
+// TestPurgeLock verifies that only one purger removes records at a time
+// 1. Create, delete and expire contacts
+// 2. Hold the advisory lock and ensure nothing is purged
+// 3. Release the lock, purge concurrently and ensure each contact is purged once
+func TestPurgeLock(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+
+	createDeletedContacts(DefaultContext(t), t, client, 5)
+	expire(t, db, "contacts", "true")
+
+	ctx := context.Background()
+	conn, err := db.DB().Conn(ctx)
+	if err != nil {
+		t.Fatalf("unable to connect to %s database: %v", dbTest.DBName, err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", purge.LockKey); err != nil {
+		t.Fatalf("unable to acquire purge lock: %v", err)
+	}
+	purged, err := newTestPurger(db, 0).Purge(ctx)
+	if err != nil {
+		t.Fatalf("unable to purge deleted records: %v", err)
+	}
+	if purged != nil {
+		t.Errorf("unexpected records purged while another purger holds the lock: %v", purged)
+	}
+	if n := countRows(t, db, "contacts", "true"); n != 5 {
+		t.Errorf("unexpected number of remaining contacts: have %d; expected %d", n, 5)
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", purge.LockKey); err != nil {
+		t.Fatalf("unable to release purge lock: %v", err)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		total int64
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			purged, err := newTestPurger(db, 1).Purge(ctx)
+			if err != nil {
+				t.Errorf("unable to purge deleted records: %v", err)
+				return
+			}
+			mu.Lock()
+			total += purged["contacts"]
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if total != 5 {
+		t.Errorf("unexpected number of purged contacts: have %d; expected %d", total, 5)
+	}
+	if n := countRows(t, db, "contacts", "true"); n != 0 {
+		t.Errorf("unexpected number of remaining contacts: have %d; expected %d", n, 0)
+	}
+}
+
+// TestPurgeEvents verifies that the contact events and the published outbox
+// events are purged together with the deleted contacts
+// 1. Create, update and delete a contact and wait until its events are published
+// 2. Expire the contact and its events
+// 3. Create another contact
+// 4. Ensure the expired contact and events are purged and the new ones are kept
+func TestPurgeEvents(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+
+	created, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Smeagol"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	contact := created.GetResult()
+	contact.LastName = "Gollum"
+	if _, err := client.Update(DefaultContext(t), &pb.UpdateContactRequest{Payload: contact}); err != nil {
+		t.Fatalf("unable to update contact: %s", err)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteContactRequest{Id: contact.GetId()}); err != nil {
+		t.Fatalf("unable to delete contact: %s", err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); countRows(t, db, "outbox", "published_at IS NULL") > 0; {
+		if time.Now().After(deadline) {
+			t.Fatal("outbox events are not published")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	expired := time.Now().Add(-2 * purgeRetention)
+	expire(t, db, "contacts", "true")
+	if err := db.Table(purge.EventsTable).UpdateColumn("created_at", expired).Error; err != nil {
+		t.Fatalf("unable to expire contact events: %v", err)
+	}
+	if err := db.Table(purge.OutboxTable).UpdateColumn("published_at", expired).Error; err != nil {
+		t.Fatalf("unable to expire outbox events: %v", err)
+	}
+	if _, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Deagol"},
+	}); err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+
+	purged, err := newTestPurger(db, 0).Purge(context.Background())
+	if err != nil {
+		t.Fatalf("unable to purge deleted records: %v", err)
+	}
+	if purged["contacts"] != 1 || purged[purge.EventsTable] != 3 || purged[purge.OutboxTable] != 3 {
+		t.Errorf("unexpected number of purged records: %v", purged)
+	}
+	if n := countRows(t, db, purge.EventsTable, "contact_id = ?", contact.GetId().GetResourceId()); n != 0 {
+		t.Errorf("unexpected number of remaining events of the purged contact: have %d; expected %d", n, 0)
+	}
+	if n := countRows(t, db, purge.EventsTable, "true"); n != 1 {
+		t.Errorf("unexpected number of remaining contact events: have %d; expected %d", n, 1)
+	}
+	if n := countRows(t, db, purge.OutboxTable, "true"); n != 1 {
+		t.Errorf("unexpected number of remaining outbox events: have %d; expected %d", n, 1)
+	}
+}
+
+// TestPurgeCommand verifies that the purge subcommand of the server purges
+// the expired records once and exits
+// 1. Create, delete and expire a contact
+// 2. Run the purge subcommand
+// 3. Ensure the contact is purged
+func TestPurgeCommand(t *testing.T) {
+	dbTest.Reset(t)
+	db := openTestDB(t)
+	defer db.Close()
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+
+	createDeletedContacts(DefaultContext(t), t, client, 2)
+	expire(t, db, "contacts", "true")
+
+	server, err := filepath.Abs("server")
+	if err != nil {
+		t.Fatalf("unable to find the server binary: %v", err)
+	}
+	cmd := exec.Command(server, "-db", dbTest.GetDSN(), "-purge-retention", purgeRetention.String(), "purge")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("unable to run the purge command: %v (%s)", err, out)
+	}
+	if n := countRows(t, db, "contacts", "true"); n != 0 {
+		t.Errorf("unexpected number of remaining contacts: have %d; expected %d", n, 0)
+	}
+}
@@ -0,0 +1,46 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"testing"
+)
+
+// TestRunSavedSearch_REST_pageToken verifies that a saved search can be
+// created and run page by page using the REST gateway
+// 1. Create five matching contacts and one which doesn't match
+// 2. Save a search which filters and sorts the contacts
+// 3. Run the search with two results per page and the page token
+// 4. Ensure the matching contacts are returned in order on three pages
+func TestRunSavedSearch_REST_pageToken(t *testing.T) {
+	dbTest.Reset(t)
+	for _, name := range []string{"Peregrin", "Belladonna", "Paladin", "Fortinbras", "Isengrim"} {
+		requestJSON(t, http.MethodPost, "contacts", map[string]string{"first_name": name, "last_name": "Took"})
+	}
+	requestJSON(t, http.MethodPost, "contacts", map[string]string{"first_name": "Meriadoc", "last_name": "Brandybuck"})
+	search := requestJSON(t, http.MethodPost, "saved_searches", map[string]string{
+		"name":     "Tooks",
+		"filter":   "last_name == 'Took'",
+		"order_by": "first_name",
+	})
+	searchPath := "saved_searches/" + path.Base(search.GetPath("result", "id").MustString())
+	if name := requestJSON(t, http.MethodGet, searchPath, nil).GetPath("result", "name").MustString(); name != "Tooks" {
+		t.Errorf("unexpected name of the saved search: have %q; expected %q", name, "Tooks")
+	}
+
+	pages := listPages(t, searchPath+":run", url.Values{"_limit": {"2"}})
+	var names []string
+	for _, page := range pages {
+		for _, res := range page {
+			names = append(names, fmt.Sprint(res.(map[string]interface{})["first_name"]))
+		}
+	}
+	expected := []string{"Belladonna", "Fortinbras", "Isengrim", "Paladin", "Peregrin"}
+	if len(pages) != 3 || fmt.Sprint(names) != fmt.Sprint(expected) {
+		t.Errorf("unexpected results of the saved search: have %v on %d pages; expected %v on 3 pages", names, len(pages), expected)
+	}
+}
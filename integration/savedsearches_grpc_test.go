@@ -0,0 +1,98 @@
+// +build integration
+
+package integration
+
+import (
+	"testing"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-contacts-app/cmd"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newSavedSearchesClient(t *testing.T) (pb.SavedSearchesClient, func()) {
+	conn, err := grpc.Dial(cmd.ServerAddress, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to connect to server: %v", err)
+	}
+	return pb.NewSavedSearchesClient(conn), func() {
+		if err := conn.Close(); err != nil {
+			t.Fatalf("unable to close client: %v", err)
+		}
+	}
+}
+
+// TestRunSavedSearch verifies that the contacts are listed with the stored
+// collection operators of a saved search
+// 1. Create three contacts and a saved search of the Tooks
+// 2. Run the saved search and ensure the order and the fields of the results
+// 3. Run the saved search with paging and ensure the page
+// 4. Ensure saved searches with invalid operators can't be created
+// 5. Ensure the saved search can't be run by another account
+func TestRunSavedSearch(t *testing.T) {
+	dbTest.Reset(t)
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+	searches, closeSearches := newSavedSearchesClient(t)
+	defer closeSearches()
+	for _, c := range []*pb.Contact{
+		{FirstName: "Paladin", LastName: "Took", Notes: "Thain"},
+		{FirstName: "Meriadoc", LastName: "Brandybuck"},
+		{FirstName: "Peregrin", LastName: "Took", Notes: "Guard of the Citadel"},
+	} {
+		if _, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{Payload: c}); err != nil {
+			t.Fatalf("unable to create new contact: %s", err)
+		}
+	}
+	search, err := searches.Create(DefaultContext(t), &pb.CreateSavedSearchRequest{
+		Payload: &pb.SavedSearch{
+			Name:    "Tooks",
+			Filter:  "last_name == 'Took'",
+			OrderBy: "first_name desc",
+			Fields:  "first_name,last_name",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create saved search: %s", err)
+	}
+	searchID := search.GetResult().GetId()
+
+	res, err := contacts.RunSavedSearch(DefaultContext(t), &pb.RunSavedSearchRequest{Id: searchID})
+	if err != nil {
+		t.Fatalf("unable to run saved search: %s", err)
+	}
+	if len(res.GetResults()) != 2 {
+		t.Fatalf("unexpected number of results: have %d; expected 2", len(res.GetResults()))
+	}
+	if res.GetResults()[0].GetFirstName() != "Peregrin" || res.GetResults()[1].GetFirstName() != "Paladin" {
+		t.Errorf("unexpected order of results: %v", res.GetResults())
+	}
+	if res.GetResults()[0].GetNotes() != "" {
+		t.Errorf("unexpected notes of a result without the field: %q", res.GetResults()[0].GetNotes())
+	}
+
+	res, err = contacts.RunSavedSearch(DefaultContext(t), &pb.RunSavedSearchRequest{
+		Id:     searchID,
+		Paging: &query.Pagination{Offset: 1, Limit: 1},
+	})
+	if err != nil {
+		t.Fatalf("unable to run saved search: %s", err)
+	}
+	if len(res.GetResults()) != 1 || res.GetResults()[0].GetFirstName() != "Paladin" {
+		t.Errorf("unexpected page of results: %v", res.GetResults())
+	}
+
+	_, err = searches.Create(DefaultContext(t), &pb.CreateSavedSearchRequest{
+		Payload: &pb.SavedSearch{Name: "Invalid", Filter: "last_name =="},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unexpected error creating an invalid saved search: have %v; expected %s", err, codes.InvalidArgument)
+	}
+	_, err = contacts.RunSavedSearch(OtherAccountContext(t), &pb.RunSavedSearchRequest{Id: searchID})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error running a saved search of another account: have %v; expected %s", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,179 @@
+// +build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/infobloxopen/atlas-contacts-app/cmd"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newProfilesClient(t *testing.T) (pb.ProfilesClient, func()) {
+	conn, err := grpc.Dial(cmd.ServerAddress, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to connect to server: %v", err)
+	}
+	return pb.NewProfilesClient(conn), func() {
+		if err := conn.Close(); err != nil {
+			t.Fatalf("unable to close client: %v", err)
+		}
+	}
+}
+
+func newGroupsClient(t *testing.T) (pb.GroupsClient, func()) {
+	conn, err := grpc.Dial(cmd.ServerAddress, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to connect to server: %v", err)
+	}
+	return pb.NewGroupsClient(conn), func() {
+		if err := conn.Close(); err != nil {
+			t.Fatalf("unable to close client: %v", err)
+		}
+	}
+}
+
+// TestDeleteContact_otherAccount verifies that a contact cannot be removed
+// by a tenant that does not own it
+// 1. Create a contact in the default account
+// 2. Try to delete the contact from another account and ensure NotFound
+// 3. Ensure the contact still exists in the default account
+func TestDeleteContact_otherAccount(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Bilbo",
+			LastName:     "Baggins",
+			PrimaryEmail: "bilbo@shire.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	if _, err := client.Delete(OtherAccountContext(t), &pb.DeleteContactRequest{
+		Id: res.GetResult().GetId(),
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unexpected error when deleting contact of another account: have %v; expected %s",
+			err, codes.NotFound,
+		)
+	}
+	if _, err := client.Read(DefaultContext(t), &pb.ReadContactRequest{
+		Id: res.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to read contact after foreign delete: %s", err)
+	}
+}
+
+// TestDeleteGroup_otherAccount verifies that a group cannot be removed by a
+// tenant that does not own it
+// 1. Create a group in the default account
+// 2. Try to delete the group from another account and ensure NotFound
+// 3. Ensure the group still exists in the default account
+func TestDeleteGroup_otherAccount(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newGroupsClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateGroupRequest{
+		Payload: &pb.Group{Name: "fellowship"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new group: %s", err)
+	}
+	if _, err := client.Delete(OtherAccountContext(t), &pb.DeleteGroupRequest{
+		Id: res.GetResult().GetId(),
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unexpected error when deleting group of another account: have %v; expected %s",
+			err, codes.NotFound,
+		)
+	}
+	if _, err := client.Read(DefaultContext(t), &pb.ReadGroupRequest{
+		Id: res.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to read group after foreign delete: %s", err)
+	}
+}
+
+// TestDeleteProfile_otherAccount verifies that a profile cannot be removed by
+// a tenant that does not own it
+// 1. Create a profile in the default account
+// 2. Try to delete the profile from another account and ensure NotFound
+// 3. Ensure the profile still exists in the default account
+// 4. Ensure deleting the profile twice from the default account returns NotFound
+func TestDeleteProfile_otherAccount(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newProfilesClient(t)
+	defer close()
+	res, err := client.Create(DefaultContext(t), &pb.CreateProfileRequest{
+		Payload: &pb.Profile{Name: "adventures"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create new profile: %s", err)
+	}
+	if _, err := client.Delete(OtherAccountContext(t), &pb.DeleteProfileRequest{
+		Id: res.GetResult().GetId(),
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unexpected error when deleting profile of another account: have %v; expected %s",
+			err, codes.NotFound,
+		)
+	}
+	if _, err := client.Read(DefaultContext(t), &pb.ReadProfileRequest{
+		Id: res.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to read profile after foreign delete: %s", err)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteProfileRequest{
+		Id: res.GetResult().GetId(),
+	}); err != nil {
+		t.Fatalf("unable to delete profile: %s", err)
+	}
+	if _, err := client.Delete(DefaultContext(t), &pb.DeleteProfileRequest{
+		Id: res.GetResult().GetId(),
+	}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unexpected error when deleting profile twice: have %v; expected %s",
+			err, codes.NotFound,
+		)
+	}
+}
+
+// TestCreateContact_sameEmailOtherAccount verifies that e-mail addresses are
+// unique only within an account
+// 1. Create a contact in the default account
+// 2. Create a contact with the same e-mail address in another account
+// 3. Ensure a second contact with the same address in the default account fails
+// with the AlreadyExists error of the e-mail addresses within an account
+func TestCreateContact_sameEmailOtherAccount(t *testing.T) {
+	dbTest.Reset(t)
+	client, close := newContactsClient(t)
+	defer close()
+	payload := &pb.CreateContactRequest{
+		Payload: &pb.Contact{
+			FirstName:    "Radagast",
+			LastName:     "The Brown",
+			PrimaryEmail: "radagast@rhosgobel.net",
+		},
+	}
+	if _, err := client.Create(DefaultContext(t), payload); err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+	if _, err := client.Create(OtherAccountContext(t), payload); err != nil {
+		t.Fatalf("unable to create contact with the same email in another account: %s", err)
+	}
+	_, err := client.Create(DefaultContext(t), payload)
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("unexpected error when creating contact with duplicate email: have %v; expected %s",
+			err, codes.AlreadyExists,
+		)
+	}
+	// the conflict is reported by the mapping of the tenant-local unique index
+	if msg := status.Convert(err).Message(); !strings.Contains(msg, "Email Address") {
+		t.Errorf("unexpected message of the duplicate email error: have %q; expected the conflict of %q",
+			msg, "Email Address",
+		)
+	}
+}
@@ -0,0 +1,101 @@
+// +build integration
+
+package integration
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	simplejson "github.com/bitly/go-simplejson"
+)
+
+// timestampedResources are the resources with creation and modification times
+// and the payloads of their names
+var timestampedResources = []struct {
+	path    string
+	payload func(name string) map[string]string
+}{
+	{"profiles", func(name string) map[string]string { return map[string]string{"name": name} }},
+	{"groups", func(name string) map[string]string { return map[string]string{"name": name} }},
+	{"contacts", func(name string) map[string]string { return map[string]string{"first_name": name} }},
+}
+
+// requestJSON issues a request using the REST gateway and returns the
+// unmarshaled response
+func requestJSON(t *testing.T, method, path string, payload interface{}) *simplejson.Json {
+	res, err := MakeRequestWithDefaults(method, "http://localhost:8080/v1/"+path, payload)
+	if err != nil {
+		t.Fatalf("unable to %s %s: %v", method, path, err)
+	}
+	ValidateResponseCode(t, res, http.StatusOK)
+	resJSON, err := simplejson.NewFromReader(res.Body)
+	if err != nil {
+		t.Fatalf("unable to unmarshal json response: %v", err)
+	}
+	return resJSON
+}
+
+// listIDs lists the resources using the REST gateway with the given
+// collection operators and returns the ids of the results in order
+func listIDs(t *testing.T, path string, query url.Values) []string {
+	results, err := requestJSON(t, http.MethodGet, path+"?"+query.Encode(), nil).Get("results").Array()
+	if err != nil {
+		t.Fatalf("unable to get results from response json: %v", err)
+	}
+	ids := []string{}
+	for _, res := range results {
+		ids = append(ids, fmt.Sprint(res.(map[string]interface{})["id"]))
+	}
+	return ids
+}
+
+// TestListByTimestamps_REST verifies that profiles, groups and contacts can
+// be filtered and sorted by their creation and modification times
+// 1. Create three resources one after another
+// 2. Ensure filtering and sorting by created_at returns the last two, newest first
+// 3. Update the first resource
+// 4. Ensure filtering by updated_at returns the updated resource only
+// 5. Ensure sorting by updated_at returns the updated resource first
+func TestListByTimestamps_REST(t *testing.T) {
+	for _, resource := range timestampedResources {
+		t.Run(resource.path, func(t *testing.T) {
+			dbTest.Reset(t)
+			var ids, created []string
+			for _, name := range []string{"Gorbadoc", "Rorimac", "Saradoc"} {
+				res := requestJSON(t, http.MethodPost, resource.path, resource.payload(name))
+				ids = append(ids, res.GetPath("result", "id").MustString())
+				created = append(created, res.GetPath("result", "created_at").MustString())
+				// the resources are created by separate transactions at distinct times
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			have := listIDs(t, resource.path, url.Values{
+				"_filter":   {fmt.Sprintf("created_at >= '%s'", created[1])},
+				"_order_by": {"created_at desc"},
+			})
+			if expected := []string{ids[2], ids[1]}; fmt.Sprint(have) != fmt.Sprint(expected) {
+				t.Errorf("unexpected %s filtered by created_at: have %v; expected %v", resource.path, have, expected)
+			}
+
+			res := requestJSON(t, http.MethodPut, fmt.Sprintf("%s/%s", resource.path, path.Base(ids[0])), resource.payload("Marmadas"))
+			updated := res.GetPath("result", "updated_at").MustString()
+
+			have = listIDs(t, resource.path, url.Values{
+				"_filter": {fmt.Sprintf("updated_at >= '%s'", updated)},
+			})
+			if expected := []string{ids[0]}; fmt.Sprint(have) != fmt.Sprint(expected) {
+				t.Errorf("unexpected %s filtered by updated_at: have %v; expected %v", resource.path, have, expected)
+			}
+			have = listIDs(t, resource.path, url.Values{
+				"_order_by": {"updated_at desc"},
+			})
+			if len(have) != 3 || have[0] != ids[0] {
+				t.Errorf("unexpected %s sorted by updated_at: have %v; expected %s first", resource.path, have, ids[0])
+			}
+		})
+	}
+}
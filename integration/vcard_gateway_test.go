@@ -0,0 +1,101 @@
+// +build integration
+
+package integration
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+
+	simplejson "github.com/bitly/go-simplejson"
+)
+
+// requestFile issues a request to the REST gateway whose body is a file of the
+// content type and returns the status, the headers and the body of the response
+func requestFile(t *testing.T, method, path, contentType, body string) (int, http.Header, string) {
+	req, err := http.NewRequest(method, "http://localhost:8080/v1/"+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unable to create request: %v", err)
+	}
+	AddDefaultTokenToRequest(req)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unable to %s %s: %v", method, path, err)
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("unable to read response: %v", err)
+	}
+	return res.StatusCode, res.Header, string(data)
+}
+
+// TestImportExportVCards_gateway verifies that the vCard routes are served by
+// the REST gateway, GET /contacts/{id}.vcf relies on the handler of vCards
+// being registered before the one of GET /contacts/{id}
+// 1. Import a vCard file with two cards with a POST request to /contacts:import
+// 2. Ensure both contacts are created
+// 3. Read one of them with a GET request to /contacts/{id}.vcf and ensure the vCard
+// 4. Ensure a GET request to /contacts/{id} still returns the contact as JSON
+// 5. Export the contacts with a GET request to /contacts:export?format=vcard and ensure both cards
+func TestImportExportVCards_gateway(t *testing.T) {
+	dbTest.Reset(t)
+	file := "BEGIN:VCARD\r\nVERSION:3.0\r\nN:Baggins;Frodo;;;\r\nFN:Frodo Baggins\r\n" +
+		"EMAIL;TYPE=INTERNET:frodo@shire.me\r\nEND:VCARD\r\n" +
+		"BEGIN:VCARD\r\nVERSION:3.0\r\nN:Gamgee;Samwise;;;\r\nFN:Samwise Gamgee\r\nEND:VCARD\r\n"
+
+	code, _, body := requestFile(t, http.MethodPost, "contacts:import", "text/vcard", file)
+	if code != http.StatusOK {
+		t.Fatalf("unexpected status of import: %d - expected: %d: %s", code, http.StatusOK, body)
+	}
+	imported, err := simplejson.NewJson([]byte(body))
+	if err != nil {
+		t.Fatalf("unable to unmarshal json response: %v", err)
+	}
+	if created := imported.Get("created").MustInt(); created != 2 {
+		t.Errorf("unexpected number of created contacts: %d - expected: 2: %s", created, body)
+	}
+
+	ids := listIDs(t, "contacts", url.Values{"_filter": {"first_name=='Frodo'"}})
+	if len(ids) != 1 {
+		t.Fatalf("unexpected number of imported contacts named Frodo: %d - expected: 1", len(ids))
+	}
+	id := path.Base(ids[0])
+
+	code, header, body := requestFile(t, http.MethodGet, "contacts/"+id+".vcf", "", "")
+	if code != http.StatusOK {
+		t.Fatalf("unexpected status of GET %s.vcf: %d - expected: %d: %s", id, code, http.StatusOK, body)
+	}
+	if contentType := header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/vcard") {
+		t.Errorf("unexpected content type: %q - expected: %q", contentType, "text/vcard")
+	}
+	if !strings.HasPrefix(body, "BEGIN:VCARD\r\n") || !strings.Contains(body, "FN:Frodo Baggins\r\n") {
+		t.Errorf("unexpected vCard: %s", body)
+	}
+	read := requestJSON(t, http.MethodGet, "contacts/"+id, nil)
+	if firstName := read.GetPath("result", "first_name").MustString(); firstName != "Frodo" {
+		t.Errorf("unexpected first name of the read contact: %q - expected: %q", firstName, "Frodo")
+	}
+
+	code, header, body = requestFile(t, http.MethodGet, "contacts:export?format=vcard", "", "")
+	if code != http.StatusOK {
+		t.Fatalf("unexpected status of export: %d - expected: %d: %s", code, http.StatusOK, body)
+	}
+	if contentType := header.Get("Content-Type"); !strings.HasPrefix(contentType, "text/vcard") {
+		t.Errorf("unexpected content type: %q - expected: %q", contentType, "text/vcard")
+	}
+	if n := strings.Count(body, "BEGIN:VCARD\r\n"); n != 2 {
+		t.Errorf("unexpected number of exported vCards: %d - expected: 2", n)
+	}
+	for _, line := range []string{"FN:Frodo Baggins\r\n", "FN:Samwise Gamgee\r\n"} {
+		if !strings.Contains(body, line) {
+			t.Errorf("exported vCards don't contain %q: %s", line, body)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// +build integration
+
+package integration
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// TestImportExportVCards verifies that contacts can be imported from and
+// exported to vCard files
+// 1. Import a vCard 3.0 file with two cards, one of them invalid
+// 2. Ensure that the valid card is created with its primary e-mail and address
+// 3. Read the contact as a vCard 4.0 and export all the contacts as vCards
+func TestImportExportVCards(t *testing.T) {
+	dbTest.Reset(t)
+	client, closeClient := newContactsClient(t)
+	defer closeClient()
+
+	file := "BEGIN:VCARD\r\nVERSION:3.0\r\nN:Baggins;Frodo;;;\r\nFN:Frodo Baggins\r\n" +
+		"EMAIL;TYPE=INTERNET:ring@shire.me\r\nEMAIL;TYPE=INTERNET,PREF:frodo@shire.me\r\n" +
+		"ADR;TYPE=HOME:;;Bag End;Hobbiton;;;Middle-earth\r\nNOTE:Ring-bearer\\, hobbit\r\nEND:VCARD\r\n" +
+		"BEGIN:VCARD\r\nVERSION:3.0\r\nN:Gamgee;Samwise;;;\r\nEMAIL:not an e-mail\r\nEND:VCARD\r\n"
+
+	res := importFile(t, client, nil, file)
+	if res.GetCreated() != 1 {
+		t.Errorf("unexpected number of created contacts: %d - expected: 1", res.GetCreated())
+	}
+	if len(res.GetErrors()) != 1 || res.GetErrors()[0].GetTarget() != "2" {
+		t.Errorf("unexpected errors: %v - expected an error of card 2", res.GetErrors())
+	}
+
+	list, err := client.List(DefaultContext(t), &pb.ListContactRequest{})
+	if err != nil {
+		t.Fatalf("unable to list contacts: %v", err)
+	}
+	if len(list.GetResults()) != 1 {
+		t.Fatalf("unexpected number of contacts: %d - expected: 1", len(list.GetResults()))
+	}
+	contact := list.GetResults()[0]
+	if contact.GetPrimaryEmail() != "frodo@shire.me" {
+		t.Errorf("unexpected primary e-mail: %q - expected: %q", contact.GetPrimaryEmail(), "frodo@shire.me")
+	}
+	if contact.GetHomeAddress().GetCity() != "Hobbiton" {
+		t.Errorf("unexpected home address: %v", contact.GetHomeAddress())
+	}
+	if contact.GetNotes() != "Ring-bearer, hobbit" {
+		t.Errorf("unexpected notes: %q - expected: %q", contact.GetNotes(), "Ring-bearer, hobbit")
+	}
+
+	card, err := client.ReadVCard(DefaultContext(t), &pb.ReadVCardRequest{Id: contact.GetId()})
+	if err != nil {
+		t.Fatalf("unable to read vCard: %v", err)
+	}
+	for _, line := range []string{"VERSION:4.0", "N:Baggins;Frodo;;;", "EMAIL;PREF=1:frodo@shire.me"} {
+		if !strings.Contains(string(card.GetData()), line+"\r\n") {
+			t.Errorf("vCard %q doesn't contain %q", card.GetData(), line)
+		}
+	}
+
+	stream, err := client.Export(DefaultContext(t), &pb.ExportContactsRequest{Format: "vcard", VcardVersion: "3.0"})
+	if err != nil {
+		t.Fatalf("unable to start export: %v", err)
+	}
+	var exported []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unable to export contacts: %v", err)
+		}
+		exported = append(exported, chunk.GetData()...)
+	}
+	if n := strings.Count(string(exported), "BEGIN:VCARD"); n != 1 {
+		t.Errorf("unexpected number of exported vCards: %d - expected: 1", n)
+	}
+	if !strings.Contains(string(exported), "VERSION:3.0\r\n") {
+		t.Errorf("exported vCard %q is not of version 3.0", exported)
+	}
+}
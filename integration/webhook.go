@@ -0,0 +1,72 @@
+package integration
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/webhook"
+)
+
+// WebhookRequest is a webhook delivery received by WebhookReceiver
+type WebhookRequest struct {
+	Event webhook.Event
+	// DeliveryID is the value of the delivery header
+	DeliveryID string
+	// ValidSignature is true if the body is signed with the receiver's secret
+	ValidSignature bool
+	// StatusCode is the status the receiver responded with
+	StatusCode int
+}
+
+// WebhookReceiver is a local HTTP server which receives webhook deliveries
+// and verifies their signatures. It responds with an error to the given
+// number of first requests to make the sender retry.
+type WebhookReceiver struct {
+	*httptest.Server
+	// Requests receives every request made to the receiver
+	Requests chan WebhookRequest
+
+	secret   string
+	mu       sync.Mutex
+	failures int
+}
+
+// NewWebhookReceiver starts a webhook receiver which fails the first failures
+// requests, it must be closed after use
+func NewWebhookReceiver(secret string, failures int) *WebhookReceiver {
+	r := &WebhookReceiver{
+		Requests: make(chan WebhookRequest, 100),
+		secret:   secret,
+		failures: failures,
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.handle))
+	return r
+}
+
+func (r *WebhookReceiver) handle(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	received := WebhookRequest{
+		DeliveryID:     req.Header.Get(webhook.DeliveryHeader),
+		ValidSignature: req.Header.Get(webhook.SignatureHeader) == webhook.Sign(r.secret, body),
+		StatusCode:     http.StatusOK,
+	}
+	json.Unmarshal(body, &received.Event)
+
+	r.mu.Lock()
+	if r.failures > 0 {
+		r.failures--
+		received.StatusCode = http.StatusServiceUnavailable
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(received.StatusCode)
+	r.Requests <- received
+}
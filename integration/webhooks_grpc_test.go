@@ -0,0 +1,180 @@
+// +build integration
+
+package integration
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/cmd"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/webhook"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newWebhooksClient(t *testing.T) (pb.WebhooksClient, func()) {
+	conn, err := grpc.Dial(cmd.ServerAddress, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to connect to server: %v", err)
+	}
+	return pb.NewWebhooksClient(conn), func() {
+		if err := conn.Close(); err != nil {
+			t.Fatalf("unable to close client: %v", err)
+		}
+	}
+}
+
+// TestWebhookDelivery verifies that the events of changed contacts are
+// delivered to the subscribers with valid signatures and are retried
+// 1. Start a webhook receiver which fails the first request
+// 2. Subscribe the receiver to the events of created contacts
+// 3. Create a contact and ensure the event is delivered at the second attempt
+// 4. Ensure the delivery is recorded as succeeded after two attempts
+func TestWebhookDelivery(t *testing.T) {
+	dbTest.Reset(t)
+	receiver := NewWebhookReceiver("mellon", 1)
+	defer receiver.Close()
+	webhooks, closeWebhooks := newWebhooksClient(t)
+	defer closeWebhooks()
+	contacts, closeContacts := newContactsClient(t)
+	defer closeContacts()
+
+	sub, err := webhooks.Create(DefaultContext(t), &pb.CreateWebhookSubscriptionRequest{
+		Payload: &pb.WebhookSubscription{
+			Url:        receiver.URL,
+			EventTypes: []string{"contact.created"},
+			Secret:     "mellon",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create webhook subscription: %s", err)
+	}
+	if _, err := contacts.Create(DefaultContext(t), &pb.CreateContactRequest{
+		Payload: &pb.Contact{FirstName: "Gimli", LastName: "Son of Gloin"},
+	}); err != nil {
+		t.Fatalf("unable to create new contact: %s", err)
+	}
+
+	var requests []WebhookRequest
+	for len(requests) < 2 {
+		select {
+		case req := <-receiver.Requests:
+			requests = append(requests, req)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("unexpected number of webhook requests: have %d; expected 2", len(requests))
+		}
+	}
+	for i, req := range requests {
+		if !req.ValidSignature {
+			t.Errorf("invalid signature of webhook request %d", i)
+		}
+		if req.Event.Type != "contact.created" {
+			t.Errorf("unexpected event type of webhook request %d: have %q; expected %q",
+				i, req.Event.Type, "contact.created",
+			)
+		}
+	}
+	if requests[0].StatusCode != http.StatusServiceUnavailable || requests[1].StatusCode != http.StatusOK {
+		t.Errorf("expected a failed attempt followed by a successful one: have %d and %d",
+			requests[0].StatusCode, requests[1].StatusCode,
+		)
+	}
+	if requests[0].DeliveryID != requests[1].DeliveryID {
+		t.Errorf("expected the same delivery id for retries: have %q and %q",
+			requests[0].DeliveryID, requests[1].DeliveryID,
+		)
+	}
+
+	// the delivery is recorded right after the response
+	time.Sleep(500 * time.Millisecond)
+	deliveries, err := webhooks.ListDeliveries(DefaultContext(t), &pb.ListWebhookDeliveryRequest{})
+	if err != nil {
+		t.Fatalf("unable to list webhook deliveries: %s", err)
+	}
+	if len(deliveries.GetResults()) != 1 {
+		t.Fatalf("unexpected number of webhook deliveries: have %d; expected 1", len(deliveries.GetResults()))
+	}
+	delivery := deliveries.GetResults()[0]
+	if delivery.GetStatus() != pb.WebhookDelivery_SUCCEEDED || delivery.GetAttempts() != 2 {
+		t.Errorf("unexpected webhook delivery: have status %s after %d attempts; expected %s after 2 attempts",
+			delivery.GetStatus(), delivery.GetAttempts(), pb.WebhookDelivery_SUCCEEDED,
+		)
+	}
+	if delivery.GetSubscriptionId().GetResourceId() != sub.GetResult().GetId().GetResourceId() {
+		t.Errorf("unexpected subscription of webhook delivery: have %v; expected %v",
+			delivery.GetSubscriptionId(), sub.GetResult().GetId(),
+		)
+	}
+}
+
+// TestCreateWebhookSubscription_invalidURL verifies that only absolute http
+// and https urls can be subscribed
+// 1. Subscribe urls with other schemes and without a host
+// 2. Ensure every subscription is rejected with InvalidArgument
+// 3. Ensure updating a valid subscription to such an url is rejected as well
+func TestCreateWebhookSubscription_invalidURL(t *testing.T) {
+	dbTest.Reset(t)
+	webhooks, closeWebhooks := newWebhooksClient(t)
+	defer closeWebhooks()
+
+	invalid := []string{"", "crm.example.com/hooks", "ftp://crm.example.com/hooks", "file:///etc/passwd", "http:///hooks"}
+	for _, raw := range invalid {
+		_, err := webhooks.Create(DefaultContext(t), &pb.CreateWebhookSubscriptionRequest{
+			Payload: &pb.WebhookSubscription{Url: raw},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("unexpected error when subscribing %q: have %v; expected %s", raw, err, codes.InvalidArgument)
+		}
+	}
+
+	sub, err := webhooks.Create(DefaultContext(t), &pb.CreateWebhookSubscriptionRequest{
+		Payload: &pb.WebhookSubscription{Url: "https://crm.example.com/hooks"},
+	})
+	if err != nil {
+		t.Fatalf("unable to create webhook subscription: %s", err)
+	}
+	payload := sub.GetResult()
+	payload.Url = "gopher://crm.example.com/hooks"
+	_, err = webhooks.Update(DefaultContext(t), &pb.UpdateWebhookSubscriptionRequest{Payload: payload})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unexpected error when updating the url to %q: have %v; expected %s", payload.Url, err, codes.InvalidArgument)
+	}
+}
+
+// TestWebhookClient_privateAddress verifies that the deliveries can't reach
+// the internal network
+// 1. Post to a receiver on the loopback interface by its address and by the localhost name
+// 2. Ensure both requests fail and the receiver gets none of them
+// 3. Ensure the client which allows private addresses reaches the receiver
+func TestWebhookClient_privateAddress(t *testing.T) {
+	receiver := NewWebhookReceiver("mellon", 0)
+	defer receiver.Close()
+	u, err := url.Parse(receiver.URL)
+	if err != nil {
+		t.Fatalf("unable to parse receiver url: %v", err)
+	}
+	client := webhook.NewClient(time.Second, false)
+	for _, host := range []string{u.Host, "localhost:" + u.Port()} {
+		res, err := client.Post("http://"+host, "application/json", strings.NewReader("{}"))
+		if err == nil {
+			res.Body.Close()
+			t.Errorf("unexpected response from the receiver at %s: have %d; expected an error", host, res.StatusCode)
+		}
+	}
+	select {
+	case req := <-receiver.Requests:
+		t.Errorf("unexpected webhook request received: %v", req)
+	default:
+	}
+
+	res, err := webhook.NewClient(time.Second, true).Post(receiver.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("unable to post to the receiver with private addresses allowed: %v", err)
+	}
+	res.Body.Close()
+}
@@ -0,0 +1,365 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	tkgorm "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	"github.com/infobloxopen/atlas-app-toolkit/requestid"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/protoc-gen-gorm/types"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc"
+)
+
+// SubjectField is the JWT claim which identifies the author of a change
+const SubjectField = "sub"
+
+type readFunc func(ctx context.Context, db *gorm.DB, id *resource.Identifier) (proto.Message, error)
+
+// readers read the current state of the resources of the audited services
+var readers = map[string]readFunc{
+	"api.contacts.Profiles": func(ctx context.Context, db *gorm.DB, id *resource.Identifier) (proto.Message, error) {
+		return pb.DefaultReadProfile(ctx, &pb.Profile{Id: id}, db)
+	},
+	"api.contacts.Groups": func(ctx context.Context, db *gorm.DB, id *resource.Identifier) (proto.Message, error) {
+		return pb.DefaultReadGroup(ctx, &pb.Group{Id: id}, db)
+	},
+	"api.contacts.Contacts": func(ctx context.Context, db *gorm.DB, id *resource.Identifier) (proto.Message, error) {
+		return pb.DefaultReadContact(ctx, &pb.Contact{Id: id}, db)
+	},
+}
+
+// audited lists the methods of the audited services which change resources
+var audited = map[string]bool{
+	"Create":   true,
+	"Update":   true,
+	"Delete":   true,
+	"Undelete": true,
+	"Merge":    true,
+	// the contacts created within a profile
+	"CreateContact": true,
+	// the group memberships
+	"AddContacts":    true,
+	"RemoveContacts": true,
+}
+
+// creating lists the audited methods which create a resource, the id of
+// their request identifies the parent of the new resource if any
+var creating = map[string]bool{
+	"Create":        true,
+	"CreateContact": true,
+}
+
+// batched lists the batch methods of the audited services, an event is
+// recorded for every item of a batch which is applied
+var batched = map[string]bool{
+	"BatchCreate": true,
+	"BatchUpdate": true,
+	"BatchDelete": true,
+}
+
+// UnaryServerInterceptor returns an interceptor which records an audit event
+// for every successful call that changes a profile, a group or a contact.
+// The event stores the difference between the state of the resource before
+// and after the call. It must run inside the transaction interceptor, the
+// states are read and the event is stored in the transaction of the request,
+// so the event is committed together with the change.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethodName(info.FullMethod)
+		read, ok := readers[service]
+		if !ok || !audited[method] && !batched[method] {
+			return handler(ctx, req)
+		}
+
+		txn, ok := tkgorm.FromContext(ctx)
+		if !ok {
+			return nil, errors.New("Database Transaction For Request Missing")
+		}
+		db := txn.Begin()
+		if db.Error != nil {
+			return nil, db.Error
+		}
+		if batched[method] {
+			return auditBatch(ctx, db, read, info.FullMethod, req, handler)
+		}
+
+		id := requestedID(req)
+
+		var before proto.Message
+		if id != nil && !creating[method] {
+			// missing resources are reported by the handler itself
+			if res, err := read(ctx, db, id); err == nil {
+				before = res
+			}
+		}
+
+		// the resources merged into the requested one are deleted by the call
+		merged := []proto.Message{}
+		for _, id := range mergedIDs(req) {
+			if res, err := read(ctx, db, id); err == nil {
+				merged = append(merged, res)
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		after := getMessage(resp, "GetResult")
+		if after == nil && id != nil && method != "Delete" {
+			// the response doesn't return the changed resource
+			if res, err := read(ctx, db, id); err == nil {
+				after = res
+			}
+		}
+		// the change is rolled back if its audit event can't be stored
+		if err := record(ctx, db, info.FullMethod, id, before, after); err != nil {
+			ctxlogrus.Extract(ctx).WithError(err).Error("unable to record audit event")
+			return nil, err
+		}
+		for _, res := range merged {
+			if err := record(ctx, db, info.FullMethod, nil, res, nil); err != nil {
+				ctxlogrus.Extract(ctx).WithError(err).Error("unable to record audit event")
+				return nil, err
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+// auditBatch calls the handler of a batch request and records an event for
+// every item of the batch which didn't fail, the items failed in the
+// best effort mode are reported by their indexes
+func auditBatch(ctx context.Context, db *gorm.DB, read readFunc, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	ids := batchIDs(req)
+	befores := make([]proto.Message, len(ids))
+	for i, id := range ids {
+		if id == nil {
+			continue
+		}
+		if res, err := read(ctx, db, id); err == nil {
+			befores[i] = res
+		}
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	failed := map[string]bool{}
+	if v, ok := resp.(interface {
+		GetErrors() []*errdetails.TargetInfo
+	}); ok {
+		for _, e := range v.GetErrors() {
+			failed[e.GetTarget()] = true
+		}
+	}
+	results := getMessages(resp, "GetResults")
+	for i := range ids {
+		if failed[strconv.Itoa(i)] {
+			continue
+		}
+		var after proto.Message
+		if i < len(results) {
+			after = results[i]
+		}
+		if err := record(ctx, db, method, ids[i], befores[i], after); err != nil {
+			ctxlogrus.Extract(ctx).WithError(err).Error("unable to record audit event")
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// Record stores an audit event of a change made by the method outside of the
+// interceptor, e.g. by a streaming call, in the transaction db
+func Record(ctx context.Context, db *gorm.DB, method string, before, after proto.Message) error {
+	return record(ctx, db, method, nil, before, after)
+}
+
+func record(ctx context.Context, db *gorm.DB, method string, id *resource.Identifier, before, after proto.Message) error {
+	// prefer the identifier of the stored resource as it is always complete
+	for _, res := range []proto.Message{after, before} {
+		if v, ok := res.(interface {
+			GetId() *resource.Identifier
+		}); ok && v.GetId() != nil {
+			id = v.GetId()
+			break
+		}
+	}
+
+	diff, err := Diff(before, after)
+	if err != nil {
+		return err
+	}
+
+	subject, _ := auth.GetJWTField(ctx, SubjectField, nil)
+	reqID, _ := requestid.FromContext(ctx)
+
+	_, err = pb.DefaultCreateAuditEvent(ctx, &pb.AuditEvent{
+		Subject:    subject,
+		RequestId:  reqID,
+		Method:     method,
+		ResourceId: formatID(id),
+		Diff:       &types.JSONValue{Value: string(diff)},
+		CreatedAt:  ptypes.TimestampNow(),
+	}, db)
+	return err
+}
+
+// Diff returns a JSON object which maps the names of the fields which differ
+// between before and after to their "before" and "after" values.
+// Either of the messages may be nil.
+func Diff(before, after proto.Message) ([]byte, error) {
+	b, err := toMap(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := map[string]map[string]interface{}{}
+	for k, v := range b {
+		if !reflect.DeepEqual(v, a[k]) {
+			diff[k] = map[string]interface{}{"before": v, "after": a[k]}
+		}
+	}
+	for k, v := range a {
+		if _, ok := b[k]; !ok {
+			diff[k] = map[string]interface{}{"before": nil, "after": v}
+		}
+	}
+
+	return json.Marshal(diff)
+}
+
+func toMap(msg proto.Message) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	if msg == nil || reflect.ValueOf(msg).IsNil() {
+		return m, nil
+	}
+	data, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// requestedID returns the identifier of the resource a request refers to
+func requestedID(req interface{}) *resource.Identifier {
+	if v, ok := req.(interface {
+		GetId() *resource.Identifier
+	}); ok {
+		return v.GetId()
+	}
+	if v, ok := getMessage(req, "GetPayload").(interface {
+		GetId() *resource.Identifier
+	}); ok {
+		return v.GetId()
+	}
+	return nil
+}
+
+// batchIDs returns the identifiers of the resources the items of a batch
+// request refer to by the item indexes, the ids of created items are nil
+func batchIDs(req interface{}) []*resource.Identifier {
+	if v, ok := req.(interface {
+		GetIds() []*resource.Identifier
+	}); ok {
+		return v.GetIds()
+	}
+	items := getMessages(req, "GetPayload")
+	ids := make([]*resource.Identifier, len(items))
+	for i, item := range items {
+		if v, ok := item.(interface {
+			GetId() *resource.Identifier
+		}); ok {
+			ids[i] = v.GetId()
+		}
+	}
+	return ids
+}
+
+// mergedIDs returns the identifiers of the resources a merge request merges
+// into the requested one
+func mergedIDs(req interface{}) []*resource.Identifier {
+	if v, ok := req.(interface {
+		GetMergedIds() []*resource.Identifier
+	}); ok {
+		return v.GetMergedIds()
+	}
+	return nil
+}
+
+// getMessage calls the getter of a message field by name, it returns nil if
+// there is no such getter or the field is not set
+func getMessage(msg interface{}, getter string) proto.Message {
+	m := reflect.ValueOf(msg).MethodByName(getter)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil
+	}
+	out := m.Call(nil)[0]
+	if out.Kind() == reflect.Ptr && out.IsNil() {
+		return nil
+	}
+	res, _ := out.Interface().(proto.Message)
+	return res
+}
+
+// getMessages calls the getter of a repeated message field by name, it
+// returns nil if there is no such getter
+func getMessages(msg interface{}, getter string) []proto.Message {
+	m := reflect.ValueOf(msg).MethodByName(getter)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil
+	}
+	out := m.Call(nil)[0]
+	if out.Kind() != reflect.Slice {
+		return nil
+	}
+	res := make([]proto.Message, out.Len())
+	for i := range res {
+		res[i], _ = out.Index(i).Interface().(proto.Message)
+	}
+	return res
+}
+
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
+
+func formatID(id *resource.Identifier) string {
+	if id == nil {
+		return ""
+	}
+	if id.GetResourceType() == "" {
+		return id.GetResourceId()
+	}
+	return fmt.Sprintf("%s/%s", id.GetResourceType(), id.GetResourceId())
+}
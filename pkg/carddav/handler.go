@@ -0,0 +1,380 @@
+// Package carddav serves the contacts to address-book clients over CardDAV
+// (RFC 6352). Every profile is an address book and every contact of the
+// profile is a vCard resource of the address book:
+//
+//	/carddav/                         the principal and its address book home
+//	/carddav/{profile id}/            an address book
+//	/carddav/{profile id}/{name}.vcf  a contact
+//
+// A contact created over CardDAV keeps the name of the resource the client
+// put it to, the resources of the other contacts are named by their ids.
+//
+// The handler is a client of the gRPC services, like the gateway, so the
+// requests are authorized, scoped by the account ID of the JWT and audited
+// like the ones of the REST API.
+package carddav
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBodySize is the maximum size of a request body
+	maxBodySize = 1 << 20
+	// vcardExtension is the extension of the names of contact resources
+	vcardExtension = ".vcf"
+	// syncTokenPrefix makes the sync tokens of the Contacts service URIs as
+	// WebDAV sync tokens must be
+	syncTokenPrefix = "urn:atlas-contacts-app:sync:"
+)
+
+// Handler serves CardDAV requests
+type Handler struct {
+	prefix   string
+	profiles pb.ProfilesClient
+	contacts pb.ContactsClient
+}
+
+// NewHandler returns the CardDAV handler of the paths under prefix, e.g.
+// "/carddav/"
+func NewHandler(prefix string, profiles pb.ProfilesClient, contacts pb.ContactsClient) *Handler {
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &Handler{prefix: prefix, profiles: profiles, contacts: contacts}
+}
+
+// target is the resource addressed by a request path
+type target struct {
+	// book is the profile id of an address book, empty for the home
+	book string
+	// contact is the name of a vCard resource, empty for collections
+	contact string
+}
+
+// ServeHTTP dispatches the request by its method
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("DAV", "1, 3, addressbook")
+	if req.Method == http.MethodOptions {
+		w.Header().Set("Allow", "OPTIONS, GET, HEAD, PUT, DELETE, PROPFIND, REPORT")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	t, ok := h.parsePath(req.URL.Path)
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	ctx, ok := authorize(req)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Basic realm="contacts"`)
+		http.Error(w, "authorization required", http.StatusUnauthorized)
+		return
+	}
+
+	var err error
+	switch req.Method {
+	case "PROPFIND":
+		err = h.propfind(ctx, w, req, t)
+	case "REPORT":
+		err = h.report(ctx, w, req, t)
+	case http.MethodGet, http.MethodHead:
+		err = h.get(ctx, w, req, t)
+	case http.MethodPut:
+		err = h.put(ctx, w, req, t)
+	case http.MethodDelete:
+		err = h.delete(ctx, w, req, t)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+// parsePath returns the target of the path, false if it's none
+func (h *Handler) parsePath(path string) (target, bool) {
+	if path+"/" == h.prefix {
+		return target{}, true
+	}
+	if !strings.HasPrefix(path, h.prefix) {
+		return target{}, false
+	}
+	parts := strings.Split(strings.TrimPrefix(path, h.prefix), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "":
+		return target{}, true
+	case len(parts) == 1 || len(parts) == 2 && parts[1] == "":
+		return target{book: parts[0]}, true
+	case len(parts) == 2 && strings.HasSuffix(parts[1], vcardExtension):
+		id := strings.TrimSuffix(parts[1], vcardExtension)
+		return target{book: parts[0], contact: id}, id != ""
+	}
+	return target{}, false
+}
+
+func (h *Handler) homeHref() string {
+	return h.prefix
+}
+
+func (h *Handler) bookHref(book string) string {
+	return h.prefix + book + "/"
+}
+
+func (h *Handler) contactHref(book string, c *pb.Contact) string {
+	if c.GetCarddavName() != "" {
+		return h.bookHref(book) + c.GetCarddavName() + vcardExtension
+	}
+	return h.bookHref(book) + c.GetId().GetResourceId() + vcardExtension
+}
+
+// authorize returns the context of the gRPC calls with the JWT of the request.
+// The JWT is the bearer token or, for clients which support only basic
+// authentication, the password.
+func authorize(req *http.Request) (context.Context, bool) {
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") && !strings.HasPrefix(auth, "token ") {
+		_, password, ok := req.BasicAuth()
+		if !ok || password == "" {
+			return nil, false
+		}
+		auth = "Bearer " + password
+	}
+	md := metadata.Pairs("authorization", auth)
+	return metadata.NewOutgoingContext(req.Context(), md), true
+}
+
+// get writes the vCard of a contact
+func (h *Handler) get(ctx context.Context, w http.ResponseWriter, req *http.Request, t target) error {
+	if t.contact == "" {
+		http.Error(w, "only contacts can be read", http.StatusMethodNotAllowed)
+		return nil
+	}
+	c, err := h.readContact(ctx, t)
+	if err != nil {
+		return err
+	}
+	version := vcard.Version3
+	if strings.Contains(req.Header.Get("Accept"), "version=4.0") {
+		version = vcard.Version4
+	}
+	var buf bytes.Buffer
+	if err := vcard.NewWriter(&buf).Write(vcard.FromContact(c, version), version); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", vcard.ContentType+"; charset=utf-8")
+	w.Header().Set("ETag", etag(c))
+	w.WriteHeader(http.StatusOK)
+	if req.Method != http.MethodHead {
+		w.Write(buf.Bytes())
+	}
+	return nil
+}
+
+// put creates or replaces a contact of the address book. A new contact is
+// named by the resource, so it stays at the path it was put to.
+func (h *Handler) put(ctx context.Context, w http.ResponseWriter, req *http.Request, t target) error {
+	if t.contact == "" {
+		http.Error(w, "only contacts can be written", http.StatusMethodNotAllowed)
+		return nil
+	}
+	body, err := readBody(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return nil
+	}
+	card, err := vcard.NewReader(bytes.NewReader(body)).Read()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid vCard: %v", err), http.StatusBadRequest)
+		return nil
+	}
+	c := vcard.ToContact(card)
+	c.ProfileId = &resource.Identifier{ResourceId: t.book}
+
+	existing, err := h.readContact(ctx, t)
+	if status.Code(err) == codes.NotFound {
+		existing, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing != nil && req.Header.Get("If-None-Match") == "*" {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return nil
+	}
+	if existing == nil && req.Header.Get("If-Match") != "" {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return nil
+	}
+
+	if existing == nil {
+		c.CarddavName = t.contact
+		res, err := h.contacts.Create(ctx, &pb.CreateContactRequest{Payload: c})
+		if err != nil {
+			return err
+		}
+		w.Header().Set("ETag", etag(res.GetResult()))
+		w.WriteHeader(http.StatusCreated)
+		return nil
+	}
+
+	c.Id = existing.GetId()
+	// the update is rejected if the contact changed since the client read it
+	c.Etag = strings.Trim(req.Header.Get("If-Match"), `"`)
+	// the properties which vCards don't have are kept
+	c.Groups = existing.GetGroups()
+	res, err := h.contacts.Update(ctx, &pb.UpdateContactRequest{Payload: c})
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", etag(res.GetResult()))
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// delete deletes a contact of the address book
+func (h *Handler) delete(ctx context.Context, w http.ResponseWriter, req *http.Request, t target) error {
+	if t.contact == "" {
+		http.Error(w, "only contacts can be deleted", http.StatusMethodNotAllowed)
+		return nil
+	}
+	c, err := h.readContact(ctx, t)
+	if err != nil {
+		return err
+	}
+	if match := req.Header.Get("If-Match"); match != "" && match != "*" && match != etag(c) {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return nil
+	}
+	if _, err := h.contacts.Delete(ctx, &pb.DeleteContactRequest{Id: c.GetId()}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// readContact returns the contact of the target, the contact named by the
+// resource or, if there is none, the contact with the id the resource is named
+// by. A contact of another address book is not found.
+func (h *Handler) readContact(ctx context.Context, t target) (*pb.Contact, error) {
+	filter := &query.Filtering{}
+	filter.SetRoot(&query.StringCondition{FieldPath: []string{"carddav_name"}, Value: t.contact, Type: query.StringCondition_EQ})
+	named, err := h.profiles.ListContacts(ctx, &pb.ListProfileContactsRequest{Id: &resource.Identifier{ResourceId: t.book}, Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	if len(named.GetResults()) > 0 {
+		return named.GetResults()[0], nil
+	}
+
+	notFound := status.Error(codes.NotFound, "the contact does not exist")
+	if _, err := strconv.ParseInt(t.contact, 10, 64); err != nil {
+		return nil, notFound
+	}
+	res, err := h.contacts.Read(ctx, &pb.ReadContactRequest{Id: &resource.Identifier{ResourceId: t.contact}})
+	if err != nil {
+		return nil, err
+	}
+	if res.GetResult().GetProfileId().GetResourceId() != t.book || res.GetResult().GetCarddavName() != "" {
+		return nil, notFound
+	}
+	return res.GetResult(), nil
+}
+
+// listContacts returns the contacts of the address book
+func (h *Handler) listContacts(ctx context.Context, book string) ([]*pb.Contact, error) {
+	res, err := h.profiles.ListContacts(ctx, &pb.ListProfileContactsRequest{Id: &resource.Identifier{ResourceId: book}})
+	if err != nil {
+		return nil, err
+	}
+	return res.GetResults(), nil
+}
+
+// deletedHrefs returns the hrefs of the deleted contacts in the address book.
+// The deleted contacts are listed to find the resource names of the ones
+// created over CardDAV, the contacts which are purged already are named by id.
+func (h *Handler) deletedHrefs(ctx context.Context, book string, deleted []*resource.Identifier) ([]string, error) {
+	var ids []int64
+	for _, id := range deleted {
+		if n, err := strconv.ParseInt(id.GetResourceId(), 10, 64); err == nil {
+			ids = append(ids, n)
+		}
+	}
+	names := map[string]string{}
+	if len(ids) > 0 {
+		filter := &query.Filtering{}
+		filter.SetRoot(idCondition(ids))
+		res, err := h.contacts.List(ctx, &pb.ListContactRequest{Filter: filter, ShowDeleted: true})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.GetResults() {
+			if c.GetCarddavName() != "" {
+				names[c.GetId().GetResourceId()] = c.GetCarddavName()
+			}
+		}
+	}
+
+	hrefs := make([]string, len(deleted))
+	for i, id := range deleted {
+		name, ok := names[id.GetResourceId()]
+		if !ok {
+			name = id.GetResourceId()
+		}
+		hrefs[i] = h.bookHref(book) + name + vcardExtension
+	}
+	return hrefs, nil
+}
+
+// idCondition returns the condition which matches the contacts with the ids,
+// the comparisons are combined into a balanced tree to keep the query shallow
+func idCondition(ids []int64) interface{} {
+	if len(ids) == 1 {
+		return &query.NumberCondition{FieldPath: []string{"id"}, Value: float64(ids[0]), Type: query.NumberCondition_EQ}
+	}
+	op := &query.LogicalOperator{Type: query.LogicalOperator_OR}
+	op.SetLeft(idCondition(ids[:len(ids)/2]))
+	op.SetRight(idCondition(ids[len(ids)/2:]))
+	return op
+}
+
+// etag returns the value of the ETag header of the contact
+func etag(c *pb.Contact) string {
+	return fmt.Sprintf("%q", c.GetEtag())
+}
+
+// writeError writes the HTTP status of the error of a gRPC call
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.FailedPrecondition:
+		code = http.StatusPreconditionFailed
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.AlreadyExists:
+		code = http.StatusConflict
+	case codes.Unauthenticated:
+		w.Header().Set("WWW-Authenticate", `Basic realm="contacts"`)
+		code = http.StatusUnauthorized
+	case codes.PermissionDenied:
+		code = http.StatusForbidden
+	}
+	http.Error(w, status.Convert(err).Message(), code)
+}
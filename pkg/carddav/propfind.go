@@ -0,0 +1,206 @@
+package carddav
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
+)
+
+// allProps are the properties returned for allprop requests, the ones which
+// are expensive to compute are returned only if they are requested
+var allProps = []xml.Name{propResourceType, propDisplayName, propGetETag, propGetContentType}
+
+// resourceProps returns the value of a property of a resource as raw XML,
+// false if the resource doesn't have the property
+type resourceProps func(name xml.Name) (string, bool, error)
+
+// propfind writes the properties of the target and, unless the Depth header
+// is 0, of its members
+func (h *Handler) propfind(ctx context.Context, w http.ResponseWriter, req *http.Request, t target) error {
+	body, err := readBody(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return nil
+	}
+	pf := propfindRequest{}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := xml.Unmarshal(body, &pf); err != nil {
+			http.Error(w, "invalid propfind request", http.StatusBadRequest)
+			return nil
+		}
+	}
+	names := pf.Prop.Names
+	all := pf.AllProp != nil || len(names) == 0 && pf.PropName == nil
+	if all {
+		names = allProps
+	}
+	depth := req.Header.Get("Depth") != "0"
+
+	ms := &multistatus{}
+	add := func(href string, props resourceProps) error {
+		r, err := propResponse(href, names, props, pf.PropName != nil)
+		if err != nil {
+			return err
+		}
+		if all && len(r.Propstats) > 0 && r.Propstats[len(r.Propstats)-1].Status == statusLine(http.StatusNotFound) {
+			// allprop returns only the properties the resource has
+			r.Propstats = r.Propstats[:len(r.Propstats)-1]
+		}
+		ms.Responses = append(ms.Responses, r)
+		return nil
+	}
+
+	switch {
+	case t.book == "":
+		if err := add(h.homeHref(), h.homeProps()); err != nil {
+			return err
+		}
+		if depth {
+			res, err := h.profiles.List(ctx, &pb.ListProfileRequest{})
+			if err != nil {
+				return err
+			}
+			for _, p := range res.GetResults() {
+				if err := add(h.bookHref(p.GetId().GetResourceId()), h.bookProps(ctx, p)); err != nil {
+					return err
+				}
+			}
+		}
+	case t.contact == "":
+		res, err := h.profiles.Read(ctx, &pb.ReadProfileRequest{Id: &resource.Identifier{ResourceId: t.book}})
+		if err != nil {
+			return err
+		}
+		if err := add(h.bookHref(t.book), h.bookProps(ctx, res.GetResult())); err != nil {
+			return err
+		}
+		if depth {
+			contacts, err := h.listContacts(ctx, t.book)
+			if err != nil {
+				return err
+			}
+			for _, c := range contacts {
+				if err := add(h.contactHref(t.book, c), contactProps(c, vcard.Version3)); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		c, err := h.readContact(ctx, t)
+		if err != nil {
+			return err
+		}
+		if err := add(h.contactHref(t.book, c), contactProps(c, vcard.Version3)); err != nil {
+			return err
+		}
+	}
+
+	writeMultistatus(w, ms)
+	return nil
+}
+
+// propResponse returns the response with the properties of a resource, the
+// properties it doesn't have are listed with the 404 status. Only the names
+// of the properties are returned if namesOnly is set.
+func propResponse(href string, names []xml.Name, props resourceProps, namesOnly bool) (response, error) {
+	found, missing := []property{}, []property{}
+	for _, name := range names {
+		value, ok, err := props(name)
+		if err != nil {
+			return response{}, err
+		}
+		if !ok {
+			missing = append(missing, property{XMLName: name})
+			continue
+		}
+		if namesOnly {
+			value = ""
+		}
+		found = append(found, property{XMLName: name, InnerXML: value})
+	}
+	r := response{Href: href}
+	if len(found) > 0 {
+		r.Propstats = append(r.Propstats, propstat{Props: found, Status: statusLine(http.StatusOK)})
+	}
+	if len(missing) > 0 {
+		r.Propstats = append(r.Propstats, propstat{Props: missing, Status: statusLine(http.StatusNotFound)})
+	}
+	return r, nil
+}
+
+// homeProps are the properties of the principal, which is the address book
+// home as well
+func (h *Handler) homeProps() resourceProps {
+	return func(name xml.Name) (string, bool, error) {
+		switch name {
+		case propResourceType:
+			return `<collection xmlns="DAV:"/><principal xmlns="DAV:"/>`, true, nil
+		case propDisplayName:
+			return "Contacts", true, nil
+		case propCurrentUserPrincipal, propPrincipalURL, propAddressbookHomeSet:
+			return hrefXML(h.homeHref()), true, nil
+		}
+		return "", false, nil
+	}
+}
+
+// bookProps are the properties of the address book of the profile
+func (h *Handler) bookProps(ctx context.Context, p *pb.Profile) resourceProps {
+	return func(name xml.Name) (string, bool, error) {
+		switch name {
+		case propResourceType:
+			return `<collection xmlns="DAV:"/><addressbook xmlns="` + nsCardDAV + `"/>`, true, nil
+		case propDisplayName:
+			return escape(p.GetName()), true, nil
+		case propAddressbookDesc:
+			return escape(p.GetNotes()), true, nil
+		case propCurrentUserPrincipal:
+			return hrefXML(h.homeHref()), true, nil
+		case propSupportedAddressData:
+			return `<address-data-type xmlns="` + nsCardDAV + `" content-type="text/vcard" version="3.0"/>` +
+				`<address-data-type xmlns="` + nsCardDAV + `" content-type="text/vcard" version="4.0"/>`, true, nil
+		case propSupportedReportSet:
+			return supportedReport(nsCardDAV, "addressbook-query") +
+				supportedReport(nsCardDAV, "addressbook-multiget") +
+				supportedReport(nsDAV, "sync-collection"), true, nil
+		case propSyncToken, propGetCTag:
+			token, err := h.currentSyncToken(ctx)
+			if err != nil {
+				return "", false, err
+			}
+			return escape(token), true, nil
+		}
+		return "", false, nil
+	}
+}
+
+func supportedReport(space, report string) string {
+	return `<supported-report xmlns="DAV:"><report><` + report + ` xmlns="` + space + `"/></report></supported-report>`
+}
+
+// contactProps are the properties of the contact, address-data is the vCard
+// of the version
+func contactProps(c *pb.Contact, version string) resourceProps {
+	return func(name xml.Name) (string, bool, error) {
+		switch name {
+		case propResourceType:
+			return "", true, nil
+		case propGetETag:
+			return escape(etag(c)), true, nil
+		case propGetContentType:
+			return vcard.ContentType + "; charset=utf-8", true, nil
+		case propAddressData:
+			var buf bytes.Buffer
+			if err := vcard.NewWriter(&buf).Write(vcard.FromContact(c, version), version); err != nil {
+				return "", false, err
+			}
+			return escape(buf.String()), true, nil
+		}
+		return "", false, nil
+	}
+}
@@ -0,0 +1,290 @@
+package carddav
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
+)
+
+// syncPageSize is the number of changes read from the Contacts service at once
+var syncPageSize int32 = 1000
+
+// report writes the result of an addressbook-query, an addressbook-multiget
+// or a sync-collection report of an address book
+func (h *Handler) report(ctx context.Context, w http.ResponseWriter, req *http.Request, t target) error {
+	if t.book == "" || t.contact != "" {
+		http.Error(w, "reports are supported by address books only", http.StatusForbidden)
+		return nil
+	}
+	body, err := readBody(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return nil
+	}
+	name, err := rootName(body)
+	if err != nil {
+		http.Error(w, "invalid report request", http.StatusBadRequest)
+		return nil
+	}
+
+	var ms *multistatus
+	switch name {
+	case xml.Name{Space: nsCardDAV, Local: "addressbook-multiget"}:
+		r := multigetRequest{}
+		if err = xml.Unmarshal(body, &r); err == nil {
+			ms, err = h.multiget(ctx, t.book, &r)
+		}
+	case xml.Name{Space: nsCardDAV, Local: "addressbook-query"}:
+		r := queryRequest{}
+		if err = xml.Unmarshal(body, &r); err == nil {
+			ms, err = h.query(ctx, t.book, &r)
+		}
+	case xml.Name{Space: nsDAV, Local: "sync-collection"}:
+		r := syncCollectionRequest{}
+		if err = xml.Unmarshal(body, &r); err == nil {
+			ms, err = h.syncCollection(ctx, t.book, &r)
+		}
+	default:
+		http.Error(w, "unsupported report", http.StatusForbidden)
+		return nil
+	}
+	if _, ok := err.(*xml.SyntaxError); ok {
+		http.Error(w, "invalid report request", http.StatusBadRequest)
+		return nil
+	}
+	if err == errInvalidSyncToken {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	writeMultistatus(w, ms)
+	return nil
+}
+
+// multiget returns the requested properties of the contacts by their hrefs
+func (h *Handler) multiget(ctx context.Context, book string, r *multigetRequest) (*multistatus, error) {
+	contacts, err := h.listContacts(ctx, book)
+	if err != nil {
+		return nil, err
+	}
+	byHref := map[string]*pb.Contact{}
+	for _, c := range contacts {
+		byHref[h.contactHref(book, c)] = c
+	}
+
+	ms := &multistatus{}
+	for _, href := range r.Hrefs {
+		if u, err := url.Parse(strings.TrimSpace(href)); err == nil {
+			href = u.Path
+		}
+		c, ok := byHref[href]
+		if !ok {
+			ms.Responses = append(ms.Responses, response{Href: href, Status: statusLine(http.StatusNotFound)})
+			continue
+		}
+		res, err := propResponse(href, r.Prop.Names, contactProps(c, addressDataVersion(r.Prop)), false)
+		if err != nil {
+			return nil, err
+		}
+		ms.Responses = append(ms.Responses, res)
+	}
+	return ms, nil
+}
+
+// query returns the requested properties of the contacts which match the
+// filter. If there are more of them than the limit the response is truncated
+// and the address book is reported with the 507 status.
+func (h *Handler) query(ctx context.Context, book string, r *queryRequest) (*multistatus, error) {
+	contacts, err := h.listContacts(ctx, book)
+	if err != nil {
+		return nil, err
+	}
+	ms := &multistatus{}
+	for _, c := range contacts {
+		if !r.Filter.match(vcard.FromContact(c, vcard.Version4)) {
+			continue
+		}
+		if r.Limit > 0 && len(ms.Responses) == r.Limit {
+			ms.Responses = append(ms.Responses, response{Href: h.bookHref(book), Status: statusLine(http.StatusInsufficientStorage)})
+			break
+		}
+		res, err := propResponse(h.contactHref(book, c), r.Prop.Names, contactProps(c, addressDataVersion(r.Prop)), false)
+		if err != nil {
+			return nil, err
+		}
+		ms.Responses = append(ms.Responses, res)
+	}
+	return ms, nil
+}
+
+// errInvalidSyncToken is returned for sync tokens not issued by the handler
+var errInvalidSyncToken = &syncTokenError{}
+
+type syncTokenError struct{}
+
+func (*syncTokenError) Error() string { return "invalid sync token" }
+
+// syncCollection returns the contacts of the address book which changed since
+// the sync token, the ones which were deleted or moved to another address
+// book are reported with the 404 status. If the client sets a limit and there
+// are more changes the address book is reported with the 507 status.
+func (h *Handler) syncCollection(ctx context.Context, book string, r *syncCollectionRequest) (*multistatus, error) {
+	token := ""
+	if r.SyncToken != "" {
+		if !strings.HasPrefix(r.SyncToken, syncTokenPrefix) {
+			return nil, errInvalidSyncToken
+		}
+		token = strings.TrimPrefix(r.SyncToken, syncTokenPrefix)
+	}
+	limit := syncPageSize
+	if r.Limit > 0 && int32(r.Limit) < limit {
+		limit = int32(r.Limit)
+	}
+
+	ms := &multistatus{}
+	for {
+		res, err := h.contacts.Sync(ctx, &pb.SyncContactsRequest{SyncToken: token, Limit: limit})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.GetResults() {
+			href := h.contactHref(book, c)
+			if c.GetProfileId().GetResourceId() != book {
+				// the contact might have been moved from this address book
+				if token != "" {
+					ms.Responses = append(ms.Responses, response{Href: href, Status: statusLine(http.StatusNotFound)})
+				}
+				continue
+			}
+			res, err := propResponse(href, r.Prop.Names, contactProps(c, addressDataVersion(r.Prop)), false)
+			if err != nil {
+				return nil, err
+			}
+			ms.Responses = append(ms.Responses, res)
+		}
+		hrefs, err := h.deletedHrefs(ctx, book, res.GetDeleted())
+		if err != nil {
+			return nil, err
+		}
+		for _, href := range hrefs {
+			ms.Responses = append(ms.Responses, response{Href: href, Status: statusLine(http.StatusNotFound)})
+		}
+		token = res.GetSyncToken()
+		if !res.GetHasMore() {
+			break
+		}
+		if r.Limit > 0 {
+			ms.Responses = append(ms.Responses, response{Href: h.bookHref(book), Status: statusLine(http.StatusInsufficientStorage)})
+			break
+		}
+	}
+	ms.SyncToken = syncTokenPrefix + token
+	return ms, nil
+}
+
+// currentSyncToken returns the sync token of the current state of the
+// contacts, it changes whenever a contact of the account changes
+func (h *Handler) currentSyncToken(ctx context.Context) (string, error) {
+	res, err := h.contacts.Sync(ctx, &pb.SyncContactsRequest{Current: true})
+	if err != nil {
+		return "", err
+	}
+	return syncTokenPrefix + res.GetSyncToken(), nil
+}
+
+// addressDataVersion returns the vCard version requested by the
+// address-data property, 3.0 by default
+func addressDataVersion(p propNames) string {
+	if p.Version == vcard.Version4 {
+		return vcard.Version4
+	}
+	return vcard.Version3
+}
+
+// match reports whether the card matches the filter, a filter without any
+// prop-filter matches all the cards
+func (f *queryFilter) match(card vcard.Card) bool {
+	if len(f.PropFilters) == 0 {
+		return true
+	}
+	return test(f.Test, len(f.PropFilters), func(i int) bool {
+		return f.PropFilters[i].match(card)
+	})
+}
+
+func (f *propFilter) match(card vcard.Card) bool {
+	props := card.All(strings.ToUpper(f.Name))
+	if f.IsNotDefined != nil {
+		return len(props) == 0
+	}
+	n := len(f.TextMatches) + len(f.ParamFilters)
+	for _, p := range props {
+		if n == 0 || test(f.Test, n, func(i int) bool {
+			if i < len(f.TextMatches) {
+				return f.TextMatches[i].match(vcard.Unescape(p.Value))
+			}
+			return f.ParamFilters[i-len(f.TextMatches)].match(p)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *paramFilter) match(p *vcard.Property) bool {
+	values := []string{}
+	for _, v := range p.Params[strings.ToUpper(f.Name)] {
+		values = append(values, strings.Split(v, ",")...)
+	}
+	if f.IsNotDefined != nil {
+		return len(values) == 0
+	}
+	if f.TextMatch == nil {
+		return len(values) > 0
+	}
+	for _, v := range values {
+		if f.TextMatch.match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// match compares the value with the text by the collation and the match type,
+// the i;unicode-casemap collation, which is the default, ignores the case
+func (m *textMatch) match(value string) bool {
+	text := m.Value
+	if m.Collation != "i;octet" {
+		value, text = strings.ToLower(value), strings.ToLower(text)
+	}
+	var ok bool
+	switch m.MatchType {
+	case "equals":
+		ok = value == text
+	case "starts-with":
+		ok = strings.HasPrefix(value, text)
+	case "ends-with":
+		ok = strings.HasSuffix(value, text)
+	default:
+		ok = strings.Contains(value, text)
+	}
+	return ok != (m.Negate == "yes")
+}
+
+// test combines n conditions, all of them must be true if the test is "allof"
+// and any of them otherwise
+func test(t string, n int, cond func(i int) bool) bool {
+	for i := 0; i < n; i++ {
+		if cond(i) != (t == "allof") {
+			return t != "allof"
+		}
+	}
+	return t == "allof"
+}
@@ -0,0 +1,199 @@
+package carddav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// nsDAV is the XML namespace of WebDAV
+	nsDAV = "DAV:"
+	// nsCardDAV is the XML namespace of CardDAV
+	nsCardDAV = "urn:ietf:params:xml:ns:carddav"
+	// nsCalendarServer is the XML namespace of the getctag extension used by
+	// older clients to find out whether an address book changed
+	nsCalendarServer = "http://calendarserver.org/ns/"
+)
+
+// property names
+var (
+	propResourceType         = xml.Name{Space: nsDAV, Local: "resourcetype"}
+	propDisplayName          = xml.Name{Space: nsDAV, Local: "displayname"}
+	propGetETag              = xml.Name{Space: nsDAV, Local: "getetag"}
+	propGetContentType       = xml.Name{Space: nsDAV, Local: "getcontenttype"}
+	propCurrentUserPrincipal = xml.Name{Space: nsDAV, Local: "current-user-principal"}
+	propPrincipalURL         = xml.Name{Space: nsDAV, Local: "principal-URL"}
+	propSupportedReportSet   = xml.Name{Space: nsDAV, Local: "supported-report-set"}
+	propSyncToken            = xml.Name{Space: nsDAV, Local: "sync-token"}
+	propAddressbookHomeSet   = xml.Name{Space: nsCardDAV, Local: "addressbook-home-set"}
+	propAddressbookDesc      = xml.Name{Space: nsCardDAV, Local: "addressbook-description"}
+	propSupportedAddressData = xml.Name{Space: nsCardDAV, Local: "supported-address-data"}
+	propAddressData          = xml.Name{Space: nsCardDAV, Local: "address-data"}
+	propGetCTag              = xml.Name{Space: nsCalendarServer, Local: "getctag"}
+)
+
+// multistatus is the body of a 207 Multi-Status response
+type multistatus struct {
+	XMLName   xml.Name   `xml:"DAV: multistatus"`
+	Responses []response `xml:"response"`
+	SyncToken string     `xml:"sync-token,omitempty"`
+}
+
+// response describes a resource, either by its properties or by a status
+type response struct {
+	Href      string     `xml:"href"`
+	Propstats []propstat `xml:"propstat,omitempty"`
+	Status    string     `xml:"status,omitempty"`
+}
+
+type propstat struct {
+	Props  []property `xml:"prop>any"`
+	Status string     `xml:"status"`
+}
+
+// property is a property with its value as raw XML
+type property struct {
+	XMLName  xml.Name
+	InnerXML string `xml:",innerxml"`
+}
+
+// propNames are the names of the child elements of a prop element
+type propNames struct {
+	Names []xml.Name
+	// Version is the vCard version requested by the address-data element
+	Version string
+}
+
+// UnmarshalXML collects the names of the child elements
+func (p *propNames) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			p.Names = append(p.Names, t.Name)
+			if t.Name == propAddressData {
+				for _, a := range t.Attr {
+					if a.Name.Local == "version" {
+						p.Version = a.Value
+					}
+				}
+			}
+			if err := d.Skip(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+type propfindRequest struct {
+	XMLName  xml.Name  `xml:"DAV: propfind"`
+	AllProp  *struct{} `xml:"DAV: allprop"`
+	PropName *struct{} `xml:"DAV: propname"`
+	Prop     propNames `xml:"DAV: prop"`
+}
+
+type multigetRequest struct {
+	XMLName xml.Name  `xml:"urn:ietf:params:xml:ns:carddav addressbook-multiget"`
+	Prop    propNames `xml:"DAV: prop"`
+	Hrefs   []string  `xml:"DAV: href"`
+}
+
+type queryRequest struct {
+	XMLName xml.Name    `xml:"urn:ietf:params:xml:ns:carddav addressbook-query"`
+	Prop    propNames   `xml:"DAV: prop"`
+	Filter  queryFilter `xml:"urn:ietf:params:xml:ns:carddav filter"`
+	Limit   int         `xml:"urn:ietf:params:xml:ns:carddav limit>nresults"`
+}
+
+type queryFilter struct {
+	Test        string       `xml:"test,attr"`
+	PropFilters []propFilter `xml:"urn:ietf:params:xml:ns:carddav prop-filter"`
+}
+
+type propFilter struct {
+	Name         string        `xml:"name,attr"`
+	Test         string        `xml:"test,attr"`
+	IsNotDefined *struct{}     `xml:"urn:ietf:params:xml:ns:carddav is-not-defined"`
+	TextMatches  []textMatch   `xml:"urn:ietf:params:xml:ns:carddav text-match"`
+	ParamFilters []paramFilter `xml:"urn:ietf:params:xml:ns:carddav param-filter"`
+}
+
+type paramFilter struct {
+	Name         string     `xml:"name,attr"`
+	IsNotDefined *struct{}  `xml:"urn:ietf:params:xml:ns:carddav is-not-defined"`
+	TextMatch    *textMatch `xml:"urn:ietf:params:xml:ns:carddav text-match"`
+}
+
+type textMatch struct {
+	Collation string `xml:"collation,attr"`
+	MatchType string `xml:"match-type,attr"`
+	Negate    string `xml:"negate-condition,attr"`
+	Value     string `xml:",chardata"`
+}
+
+type syncCollectionRequest struct {
+	XMLName   xml.Name  `xml:"DAV: sync-collection"`
+	SyncToken string    `xml:"DAV: sync-token"`
+	SyncLevel string    `xml:"DAV: sync-level"`
+	Limit     int       `xml:"DAV: limit>nresults"`
+	Prop      propNames `xml:"DAV: prop"`
+}
+
+// rootName returns the name of the root element of an XML document
+func rootName(data []byte) (xml.Name, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return xml.Name{}, err
+		}
+		if start, ok := t.(xml.StartElement); ok {
+			return start.Name, nil
+		}
+	}
+}
+
+// readBody reads the XML body of a request, at most maxBodySize bytes
+func readBody(req *http.Request) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, io.LimitReader(req.Body, maxBodySize+1)); err != nil {
+		return nil, err
+	}
+	if buf.Len() > maxBodySize {
+		return nil, fmt.Errorf("the request body is too large")
+	}
+	return buf.Bytes(), nil
+}
+
+// writeMultistatus writes the 207 Multi-Status response
+func writeMultistatus(w http.ResponseWriter, ms *multistatus) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	io.WriteString(w, xml.Header)
+	xml.NewEncoder(w).Encode(ms)
+}
+
+// escape returns the text escaped for XML
+func escape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// hrefXML returns a DAV:href element
+func hrefXML(href string) string {
+	return `<href xmlns="DAV:">` + escape(href) + `</href>`
+}
+
+// statusLine returns the status of a response or a propstat
+func statusLine(code int) string {
+	return fmt.Sprintf("HTTP/1.1 %d %s", code, http.StatusText(code))
+}
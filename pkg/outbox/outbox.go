@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+// Event is a change of a resource stored in the outbox by the transaction
+// which made the change
+type Event struct {
+	ID        int64 `gorm:"primary_key"`
+	AccountID string
+	// Type is the resource and the change, e.g. "contact.updated"
+	Type string
+	// Payload is the resource after the change, only its id is set if the
+	// resource was deleted
+	Payload *postgres.Jsonb `gorm:"type:jsonb"`
+	// TxID is the id of the transaction which stored the event
+	TxID        int64 `gorm:"column:txid"`
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+}
+
+// TableName returns the name of the outbox table
+func (Event) TableName() string {
+	return "outbox"
+}
+
+// Data returns the JSON payload of the event
+func (e *Event) Data() json.RawMessage {
+	if e.Payload == nil {
+		return nil
+	}
+	return e.Payload.RawMessage
+}
+
+// Add stores the event of a change within the caller's account. tx must be
+// the transaction which makes the change, so the event is published if and
+// only if the change is committed.
+func Add(ctx context.Context, tx *gorm.DB, eventType string, data proto.Message) error {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	raw, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(data)
+	if err != nil {
+		return err
+	}
+	// the relay publishes the events in the order of their transactions, so
+	// the events don't have to be committed in the order of their ids
+	var txID int64
+	if err := tx.Raw("SELECT txid_current()").Row().Scan(&txID); err != nil {
+		return err
+	}
+	return tx.Create(&Event{
+		AccountID: accountID,
+		Type:      eventType,
+		Payload:   &postgres.Jsonb{RawMessage: json.RawMessage(raw)},
+		TxID:      txID,
+		CreatedAt: time.Now().UTC(),
+	}).Error
+}
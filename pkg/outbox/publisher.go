@@ -0,0 +1,92 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// EventPublisher publishes the events drained from the outbox. The relay
+// retries an event until Publish succeeds, so an event might be published
+// more than once.
+type EventPublisher interface {
+	Publish(ctx context.Context, e *Event) error
+}
+
+// MemoryPublisher keeps the published events in memory
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewMemoryPublisher returns a publisher without any event
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish appends the event to the published events
+func (p *MemoryPublisher) Publish(ctx context.Context, e *Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, *e)
+	return nil
+}
+
+// Events returns the published events in the order they were published
+func (p *MemoryPublisher) Events() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Event(nil), p.events...)
+}
+
+// fileEvent is the JSON representation of an event written by the file publisher
+type fileEvent struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	AccountID string          `json:"account_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+type filePublisher struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewFilePublisher returns a publisher which writes every event to w as a
+// line of JSON
+func NewFilePublisher(w io.Writer) EventPublisher {
+	return &filePublisher{enc: json.NewEncoder(w)}
+}
+
+func (p *filePublisher) Publish(ctx context.Context, e *Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.enc.Encode(&fileEvent{
+		ID:        e.ID,
+		Type:      e.Type,
+		AccountID: e.AccountID,
+		CreatedAt: e.CreatedAt,
+		Data:      e.Data(),
+	})
+}
+
+type multiPublisher []EventPublisher
+
+// MultiPublisher returns a publisher which publishes every event with all the
+// given publishers in order. An event is published again by all of them if
+// any of them fails.
+func MultiPublisher(publishers ...EventPublisher) EventPublisher {
+	return multiPublisher(publishers)
+}
+
+func (m multiPublisher) Publish(ctx context.Context, e *Event) error {
+	for _, p := range m {
+		if err := p.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
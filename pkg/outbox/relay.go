@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/sirupsen/logrus"
+)
+
+// LockKey is the key of the Postgres advisory lock which guarantees that only
+// one replica relays the events at a time, so they are published in order
+const LockKey int64 = 0x6f7574626f782d72 // "outbox-r"
+
+// FinishedTransactions is the SQL condition which selects the rows whose txid
+// column is the id of a finished transaction. Every transaction which is still
+// running or starts later has a greater id, so a row committed later never
+// precedes the rows read before in the (txid, id) order. The outbox events and
+// the contact events are read in this order.
+const FinishedTransactions = "txid < txid_snapshot_xmin(txid_current_snapshot())"
+
+// DefaultBatchSize is the batch size used if a non-positive one is provided
+const DefaultBatchSize = 100
+
+var outboxEvents = expvar.NewMap("outbox_events")
+
+// Relay publishes the events stored in the outbox in the order of the
+// transactions which added them and marks them as published
+type Relay struct {
+	db        *gorm.DB
+	publisher EventPublisher
+	batchSize int
+	logger    logrus.FieldLogger
+}
+
+// NewRelay returns a relay which publishes at most batchSize events per
+// transaction with the publisher
+func NewRelay(db *gorm.DB, publisher EventPublisher, batchSize int, logger logrus.FieldLogger) *Relay {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &Relay{db: db, publisher: publisher, batchSize: batchSize, logger: logger}
+}
+
+// Run relays the events every interval until ctx is done
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := r.Relay(ctx); err != nil {
+			r.logger.Errorf("unable to relay outbox events: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay publishes all the unpublished events and returns their number. It
+// stops at the first event which can't be published, the event and the
+// following ones are published by the next call. Nothing is published if
+// another replica holds the advisory lock.
+func (r *Relay) Relay(ctx context.Context) (int, error) {
+	var total int
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		n, more, err := r.relayBatch(ctx)
+		total += n
+		if err != nil || !more {
+			return total, err
+		}
+	}
+}
+
+// relayBatch publishes a batch of events and reports whether there might be
+// more events to publish
+func (r *Relay) relayBatch(ctx context.Context) (int, bool, error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return 0, false, tx.Error
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", LockKey).Row().Scan(&locked); err != nil {
+		return 0, false, err
+	}
+	if !locked {
+		r.logger.Debug("outbox events are being relayed by another replica")
+		return 0, false, nil
+	}
+
+	events := []Event{}
+	if err := tx.Where("published_at IS NULL").Where(FinishedTransactions).
+		Order("txid").Order("id").Limit(r.batchSize).Find(&events).Error; err != nil {
+		return 0, false, err
+	}
+
+	ids := make([]int64, 0, len(events))
+	var perr error
+	for i := range events {
+		if perr = r.publisher.Publish(ctx, &events[i]); perr != nil {
+			outboxEvents.Add("failed", 1)
+			r.logger.WithFields(logrus.Fields{
+				"event": events[i].ID,
+				"type":  events[i].Type,
+			}).Warnf("unable to publish outbox event: %v", perr)
+			break
+		}
+		ids = append(ids, events[i].ID)
+	}
+	if len(ids) == 0 {
+		return 0, false, perr
+	}
+
+	if err := tx.Model(&Event{}).Where("id IN (?)", ids).
+		UpdateColumn("published_at", time.Now().UTC()).Error; err != nil {
+		return 0, false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return 0, false, err
+	}
+	outboxEvents.Add("published", int64(len(ids)))
+
+	return len(ids), perr == nil && len(events) == r.batchSize, perr
+}
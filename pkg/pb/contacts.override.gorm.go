@@ -1,8 +1,12 @@
 package pb
 
 import (
+	"strconv"
 	"strings"
 
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	tkgorm "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
 	"github.com/infobloxopen/atlas-app-toolkit/query"
 	"github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
 	"github.com/jinzhu/gorm"
@@ -11,9 +15,18 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// AfterToORM will add the primary e-mail to the list of e-mails if it isn't
-// present already
+// AfterToORM will add the primary e-mail and the primary phone number to the
+// lists of e-mails and phone numbers if they aren't present already and
+// restores the version from the entity tag
 func (m *Contact) AfterToORM(ctx context.Context, c *ContactORM) error {
+	c.Version = VersionFromETag(m.Etag)
+	if err := m.primaryEmailToORM(ctx, c); err != nil {
+		return err
+	}
+	return m.primaryPhoneToORM(ctx, c)
+}
+
+func (m *Contact) primaryEmailToORM(ctx context.Context, c *ContactORM) error {
 	if m.PrimaryEmail == "" {
 		return nil
 	}
@@ -50,11 +63,47 @@ func (m *Contact) AfterToORM(ctx context.Context, c *ContactORM) error {
 	return nil
 }
 
-// AfterToPB copies the primary e-mail address from the DB to the special PB field
-func (m *ContactORM) AfterToPB(ctx context.Context, c *Contact) error {
-	if len(m.Emails) == 0 {
+func (m *Contact) primaryPhoneToORM(ctx context.Context, c *ContactORM) error {
+	if m.PrimaryPhone == "" {
 		return nil
 	}
+
+	var primary *PhoneNumberORM
+
+	phones := []*PhoneNumberORM{}
+	for _, p := range c.PhoneNumbers {
+		if p.Number != m.PrimaryPhone {
+			p.IsPrimary = new(bool)
+			*p.IsPrimary = false
+			phones = append(phones, p)
+		} else {
+			p.IsPrimary = new(bool)
+			*p.IsPrimary = true
+			primary = p
+		}
+	}
+
+	if primary == nil {
+		if p, err := (&PhoneNumber{Number: m.PrimaryPhone}).ToORM(ctx); err != nil {
+			return err
+		} else {
+			primary = &p
+		}
+
+		primary.IsPrimary = new(bool)
+		*primary.IsPrimary = true
+	}
+
+	phones = append(phones, primary)
+	c.PhoneNumbers = phones
+
+	return nil
+}
+
+// AfterToPB copies the primary e-mail address and the primary phone number
+// from the DB to the special PB fields and sets the entity tag
+func (m *ContactORM) AfterToPB(ctx context.Context, c *Contact) error {
+	c.Etag = ETag(m.Version)
 	// find the primary e-mail in list of e-mails from DB
 	for _, addr := range m.Emails {
 		if addr != nil && addr.IsPrimary != nil && *addr.IsPrimary {
@@ -62,9 +111,181 @@ func (m *ContactORM) AfterToPB(ctx context.Context, c *Contact) error {
 			break
 		}
 	}
+	// find the primary phone number in list of phone numbers from DB
+	for _, phone := range m.PhoneNumbers {
+		if phone != nil && phone.IsPrimary != nil && *phone.IsPrimary {
+			c.PrimaryPhone = phone.Number
+			break
+		}
+	}
+	return nil
+}
+
+// ETag formats the version of a profile, a group or a contact as its entity tag
+func ETag(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
+
+// VersionFromETag returns the version encoded in an entity tag created by ETag.
+// Zero is returned for malformed tags.
+func VersionFromETag(etag string) int64 {
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// AfterToORM restores the version of the profile from the entity tag
+func (m *Profile) AfterToORM(ctx context.Context, p *ProfileORM) error {
+	p.Version = VersionFromETag(m.Etag)
+	return nil
+}
+
+// AfterToPB sets the entity tag of the profile
+func (m *ProfileORM) AfterToPB(ctx context.Context, p *Profile) error {
+	p.Etag = ETag(m.Version)
+	return nil
+}
+
+// AfterToORM restores the version of the group from the entity tag
+func (m *Group) AfterToORM(ctx context.Context, g *GroupORM) error {
+	g.Version = VersionFromETag(m.Etag)
+	return nil
+}
+
+// AfterToPB sets the entity tag of the group
+func (m *GroupORM) AfterToPB(ctx context.Context, g *Group) error {
+	g.Etag = ETag(m.Version)
+	return nil
+}
+
+// AfterToORM stores the event types of the subscription as a comma-separated list
+func (m *WebhookSubscription) AfterToORM(ctx context.Context, w *WebhookSubscriptionORM) error {
+	w.EventTypes = strings.Join(m.EventTypes, ",")
+	return nil
+}
+
+// AfterToPB restores the list of event types of the subscription. The secret
+// is never returned, it is known only to the creator of the subscription.
+func (m *WebhookSubscriptionORM) AfterToPB(ctx context.Context, w *WebhookSubscription) error {
+	if m.EventTypes != "" {
+		w.EventTypes = strings.Split(m.EventTypes, ",")
+	}
+	w.Secret = ""
+	return nil
+}
+
+// AfterToORM decodes the identifier of the subscription of the delivery
+func (m *WebhookDelivery) AfterToORM(ctx context.Context, d *WebhookDeliveryORM) error {
+	if m.SubscriptionId == nil {
+		return nil
+	}
+	id, err := resource.DecodeInt64(&WebhookSubscription{}, m.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	d.SubscriptionId = id
+	return nil
+}
+
+// AfterToPB encodes the identifier of the subscription of the delivery
+func (m *WebhookDeliveryORM) AfterToPB(ctx context.Context, d *WebhookDelivery) error {
+	id, err := resource.Encode(&WebhookSubscription{}, m.SubscriptionId)
+	if err != nil {
+		return err
+	}
+	d.SubscriptionId = id
+	return nil
+}
+
+// AfterToORM decodes the identifier of the profile of the saved search
+func (m *SavedSearch) AfterToORM(ctx context.Context, ss *SavedSearchORM) error {
+	if m.ProfileId == nil {
+		return nil
+	}
+	id, err := resource.DecodeInt64(&Profile{}, m.ProfileId)
+	if err != nil {
+		return err
+	}
+	ss.ProfileId = &id
 	return nil
 }
 
+// AfterToPB encodes the identifier of the profile of the saved search
+func (m *SavedSearchORM) AfterToPB(ctx context.Context, ss *SavedSearch) error {
+	if m.ProfileId == nil {
+		return nil
+	}
+	id, err := resource.Encode(&Profile{}, *m.ProfileId)
+	if err != nil {
+		return err
+	}
+	ss.ProfileId = id
+	return nil
+}
+
+// BeforeCreate drops the timestamps and the entity tag provided by the
+// client, they are set when the profile is stored
+func (m *CreateProfileRequest) BeforeCreate(ctx context.Context, in *CreateProfileRequest, db *gorm.DB) (context.Context, *gorm.DB, error) {
+	if payload := in.GetPayload(); payload != nil {
+		payload.CreatedAt, payload.UpdatedAt = nil, nil
+		payload.Etag = ""
+	}
+	return ctx, db, nil
+}
+
+// BeforeCreate drops the timestamps and the entity tag provided by the
+// client, they are set when the group is stored
+func (m *CreateGroupRequest) BeforeCreate(ctx context.Context, in *CreateGroupRequest, db *gorm.DB) (context.Context, *gorm.DB, error) {
+	if payload := in.GetPayload(); payload != nil {
+		payload.CreatedAt, payload.UpdatedAt = nil, nil
+		payload.Etag = ""
+	}
+	return ctx, db, nil
+}
+
+// BeforeCreate drops the timestamps and the entity tag provided by the
+// client, they are set when the contact is stored
+func (m *CreateContactRequest) BeforeCreate(ctx context.Context, in *CreateContactRequest, db *gorm.DB) (context.Context, *gorm.DB, error) {
+	in.GetPayload().ClearServerFields()
+	return ctx, db, nil
+}
+
+// ClearServerFields drops the timestamps and the entity tag of a contact
+// which is about to be created, they are set when the contact is stored
+func (m *Contact) ClearServerFields() {
+	if m == nil {
+		return
+	}
+	m.CreatedAt, m.UpdatedAt = nil, nil
+	m.Etag = ""
+}
+
+// BeforeList includes deleted profiles into the results if requested
+func (m *ListProfileRequest) BeforeList(ctx context.Context, in *ListProfileRequest, db *gorm.DB) (context.Context, *gorm.DB, error) {
+	if in.GetShowDeleted() {
+		db = db.Unscoped()
+	}
+	return ctx, db, nil
+}
+
+// BeforeList includes deleted groups into the results if requested
+func (m *ListGroupRequest) BeforeList(ctx context.Context, in *ListGroupRequest, db *gorm.DB) (context.Context, *gorm.DB, error) {
+	if in.GetShowDeleted() {
+		db = db.Unscoped()
+	}
+	return ctx, db, nil
+}
+
+// BeforeList includes deleted contacts into the results if requested
+func (m *ListContactRequest) BeforeList(ctx context.Context, in *ListContactRequest, db *gorm.DB) (context.Context, *gorm.DB, error) {
+	if in.GetShowDeleted() {
+		db = db.Unscoped()
+	}
+	return ctx, db, nil
+}
+
 // Overriding CRUD Methods:
 // For the example below we will be overriding the Read method.
 // To override a CRUD method we need to find the Custom method (CustomCreate, CustomRead, CustomUpdate, CustomDelete)
@@ -72,7 +293,11 @@ func (m *ContactORM) AfterToPB(ctx context.Context, c *Contact) error {
 
 // CustomRead method overrides the default Read function and adds custom errors with multiple details.
 func (m *ContactsDefaultServer) CustomRead(ctx context.Context, req *ReadContactRequest) (*ReadContactResponse, error) {
-	res, err := DefaultReadContact(ctx, &Contact{Id: req.GetId()}, m.DB)
+	db, err := RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := DefaultReadContact(ctx, &Contact{Id: req.GetId()}, db)
 	if err != nil {
 		code := codes.Internal
 		if err == gorm.ErrRecordNotFound {
@@ -86,6 +311,18 @@ func (m *ContactsDefaultServer) CustomRead(ctx context.Context, req *ReadContact
 	return &ReadContactResponse{Result: res}, nil
 }
 
+// RequestDB returns the transaction of the request like the default handlers
+// do. The transaction interceptor commits it if the call succeeds and rolls it
+// back otherwise.
+func RequestDB(ctx context.Context) (*gorm.DB, error) {
+	txn, ok := tkgorm.FromContext(ctx)
+	if !ok {
+		return nil, errors.NewContainer(codes.Internal, "Database transaction for the request is missing.")
+	}
+	db := txn.Begin()
+	return db, db.Error
+}
+
 type FilteringIteratorCallback func(path []string, f interface{}) (interface{}, string)
 
 // IterateFiltering call callback function for each condtion struct of *Filtering.
@@ -162,7 +399,20 @@ func IterateFiltering(f *query.Filtering, callback FilteringIteratorCallback) []
 
 // CustomList method overrides the default Read function and modify Filtering to support synthetic fields.
 func (m *ContactsDefaultServer) CustomList(ctx context.Context, in *ListContactRequest) (*ListContactsResponse, error) {
-	db := m.DB
+	db, err := RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ListContacts(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListContactsResponse{Results: res}, nil
+}
+
+// ListContacts is DefaultListContact which supports filtering by the
+// synthetic fields
+func ListContacts(ctx context.Context, db *gorm.DB, in *ListContactRequest) ([]*Contact, error) {
 	f := in.GetFilter()
 	if f != nil {
 		joins := IterateFiltering(f, supportSynteticFields())
@@ -170,16 +420,14 @@ func (m *ContactsDefaultServer) CustomList(ctx context.Context, in *ListContactR
 			db = db.Joins(join)
 		}
 	}
-	res, err := DefaultListContact(ctx, db, in)
-	if err != nil {
-		return nil, err
-	}
-	return &ListContactsResponse{Results: res}, nil
+	return DefaultListContact(ctx, db, in)
 }
 
-// callback function for IterateFiltering to support "primary_email" (synthetic field) filtering
+// callback function for IterateFiltering to support "primary_email" and
+// "primary_phone" (synthetic fields) filtering
 func supportSynteticFields() FilteringIteratorCallback {
-	syntheticFound := false
+	syntheticEmailFound := false
+	syntheticPhoneFound := false
 	return func(path []string, f interface{}) (interface{}, string) {
 		join := ""
 		switch strings.Join(path, ".") {
@@ -187,9 +435,19 @@ func supportSynteticFields() FilteringIteratorCallback {
 			sc, ok := f.(*query.StringCondition)
 			if ok {
 				sc.FieldPath = []string{"synthetic_emails", "address"}
-				if !syntheticFound {
+				if !syntheticEmailFound {
 					join = "join emails synthetic_emails on contacts.id = synthetic_emails.contact_id and synthetic_emails.is_primary = true"
-					syntheticFound = true
+					syntheticEmailFound = true
+				}
+				return sc, join
+			}
+		case "primary_phone":
+			sc, ok := f.(*query.StringCondition)
+			if ok {
+				sc.FieldPath = []string{"synthetic_phone_numbers", "number"}
+				if !syntheticPhoneFound {
+					join = "join phone_numbers synthetic_phone_numbers on contacts.id = synthetic_phone_numbers.contact_id and synthetic_phone_numbers.is_primary = true"
+					syntheticPhoneFound = true
 				}
 				return sc, join
 			}
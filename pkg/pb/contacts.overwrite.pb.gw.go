@@ -1,39 +1,306 @@
 package pb
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/infobloxopen/atlas-app-toolkit/gateway"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+// forwardResponseMessageWithETag is gateway.ForwardResponseMessage which
+// returns the entity tag of a resource as the standard ETag HTTP header
+var forwardResponseMessageWithETag = gateway.NewForwardResponseMessage(
+	etagOutgoingHeaderMatcher, gateway.ProtoMessageErrorHandler, gateway.ProtoStreamErrorHandler,
 )
 
+func etagOutgoingHeaderMatcher(key string) (string, bool) {
+	if key == "etag" {
+		return "ETag", true
+	}
+	return gateway.PrefixOutgoingHeaderMatcher(key)
+}
+
+// forwardResponseServerSentEvents forwards the messages of a server stream
+// as server-sent events. The id of an event is the id of the message if it
+// has one, so clients can resume the stream with the Last-Event-ID header.
+func forwardResponseServerSentEvents(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, recv func() (proto.Message, error), opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	f.Flush()
+
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			buf, merr := marshaler.Marshal(status.Convert(err).Proto())
+			if merr == nil {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", buf)
+				f.Flush()
+			}
+			return
+		}
+		buf, err := marshaler.Marshal(resp)
+		if err != nil {
+			return
+		}
+		if v, ok := resp.(interface {
+			GetId() int64
+		}); ok {
+			fmt.Fprintf(w, "id: %d\n", v.GetId())
+		}
+		fmt.Fprintf(w, "data: %s\n\n", buf)
+		f.Flush()
+	}
+}
+
+// fileChunkSize is the maximum size of the data of ImportContactsRequest
+// messages decoded by FileMarshaler
+const fileChunkSize = 32 << 10
+
+// FileMarshaler decodes the body of a file upload, e.g. a CSV file, as the
+// data of ImportContactsRequest messages. Other messages are decoded and the
+// responses are encoded as JSON.
+type FileMarshaler struct {
+	runtime.JSONPb
+}
+
+// NewDecoder returns a decoder which reads the next chunk of the file into
+// an ImportContactsRequest
+func (m *FileMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		req, ok := v.(*ImportContactsRequest)
+		if !ok {
+			return m.JSONPb.NewDecoder(r).Decode(v)
+		}
+		buf := make([]byte, fileChunkSize)
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			return io.EOF
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		req.Data = buf[:n]
+		return nil
+	})
+}
+
+// forwardResponseFile writes the data of a stream of FileChunk messages as
+// the response body, its content type is the one of the first chunk
+func forwardResponseFile(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, recv func() (proto.Message, error), opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
+	started := false
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			if !started {
+				runtime.HTTPError(ctx, mux, marshaler, w, req, err)
+			}
+			// the response is cut short, the client sees an incomplete body
+			return
+		}
+		chunk, ok := resp.(*FileChunk)
+		if !ok {
+			return
+		}
+		if !started {
+			if chunk.GetContentType() != "" {
+				w.Header().Set("Content-Type", chunk.GetContentType())
+			}
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return
+		}
+	}
+}
+
+// forwardResponseFileChunk writes the data of a FileChunk as the response
+// body with the content type of the chunk and the ETag header if the server
+// returned an entity tag
+func forwardResponseFileChunk(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, resp proto.Message, opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
+	chunk, ok := resp.(*FileChunk)
+	if !ok {
+		runtime.HTTPError(ctx, mux, marshaler, w, req, status.Errorf(codes.Internal, "unexpected response %T", resp))
+		return
+	}
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if vals := md.HeaderMD["etag"]; len(vals) > 0 {
+			w.Header().Set("ETag", vals[0])
+		}
+	}
+	w.Header().Set("Content-Type", chunk.GetContentType())
+	w.WriteHeader(http.StatusOK)
+	w.Write(chunk.GetData())
+}
+
+// vcardExtension is the extension of the paths of contacts read as vCards
+const vcardExtension = ".vcf"
+
+// RegisterContactsFilesHandlerFromEndpoint registers the http handlers of the
+// contact paths which can't be expressed by HTTP rules, namely GET
+// /contacts/{id}.vcf which is ReadVCard. It takes over GET /contacts/{id}, so
+// it must be registered before RegisterContactsHandlerFromEndpoint.
+func RegisterContactsFilesHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+		}
+	}()
+	client := NewContactsClient(conn)
+
+	mux.Handle("GET", pattern_Contacts_Read_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		request, forward := request_Contacts_Read_0, forward_Contacts_Read_0
+		if id := pathParams["id.resource_id"]; strings.HasSuffix(id, vcardExtension) {
+			pathParams["id.resource_id"] = strings.TrimSuffix(id, vcardExtension)
+			request, forward = request_Contacts_ReadVCard_0, forward_Contacts_ReadVCard_0
+		}
+		resp, md, err := request(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
 func init() {
 	forward_Profiles_Create_0 = gateway.ForwardResponseMessage
 
-	forward_Profiles_Read_0 = gateway.ForwardResponseMessage
+	forward_Profiles_Read_0 = forwardResponseMessageWithETag
 
-	forward_Profiles_Update_0 = gateway.ForwardResponseMessage
+	forward_Profiles_Update_0 = forwardResponseMessageWithETag
 
 	forward_Profiles_Delete_0 = gateway.ForwardResponseMessage
 
+	forward_Profiles_Undelete_0 = gateway.ForwardResponseMessage
+
 	forward_Profiles_List_0 = gateway.ForwardResponseMessage
 
+	forward_Profiles_ListContacts_0 = gateway.ForwardResponseMessage
+
+	forward_Profiles_ListGroups_0 = gateway.ForwardResponseMessage
+
+	forward_Profiles_CreateContact_0 = gateway.ForwardResponseMessage
+
 	forward_Groups_Create_0 = gateway.ForwardResponseMessage
 
-	forward_Groups_Read_0 = gateway.ForwardResponseMessage
+	forward_Groups_Read_0 = forwardResponseMessageWithETag
 
-	forward_Groups_Update_0 = gateway.ForwardResponseMessage
+	forward_Groups_Update_0 = forwardResponseMessageWithETag
 
 	forward_Groups_Delete_0 = gateway.ForwardResponseMessage
 
+	forward_Groups_Undelete_0 = gateway.ForwardResponseMessage
+
 	forward_Groups_List_0 = gateway.ForwardResponseMessage
 
+	forward_Groups_AddContacts_0 = gateway.ForwardResponseMessage
+
+	forward_Groups_RemoveContacts_0 = gateway.ForwardResponseMessage
+
+	forward_Groups_ListMembers_0 = gateway.ForwardResponseMessage
+
 	forward_Contacts_Create_0 = gateway.ForwardResponseMessage
 
-	forward_Contacts_Read_0 = gateway.ForwardResponseMessage
+	forward_Contacts_Read_0 = forwardResponseMessageWithETag
 
-	forward_Contacts_Update_0 = gateway.ForwardResponseMessage
+	forward_Contacts_Update_0 = forwardResponseMessageWithETag
 
 	forward_Contacts_Delete_0 = gateway.ForwardResponseMessage
 
+	forward_Contacts_Undelete_0 = gateway.ForwardResponseMessage
+
 	forward_Contacts_List_0 = gateway.ForwardResponseMessage
 
 	forward_Contacts_SendSMS_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_Sync_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_Search_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_Suggest_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_FindDuplicates_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_Merge_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_RunSavedSearch_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_Watch_0 = forwardResponseServerSentEvents
+
+	forward_Contacts_BatchCreate_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_BatchUpdate_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_BatchDelete_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_Export_0 = forwardResponseFile
+
+	forward_Contacts_Import_0 = gateway.ForwardResponseMessage
+
+	forward_Contacts_ReadVCard_0 = forwardResponseFileChunk
+
+	forward_AuditLog_List_0 = gateway.ForwardResponseMessage
+
+	forward_Webhooks_Create_0 = gateway.ForwardResponseMessage
+
+	forward_Webhooks_Read_0 = gateway.ForwardResponseMessage
+
+	forward_Webhooks_Update_0 = gateway.ForwardResponseMessage
+
+	forward_Webhooks_Delete_0 = gateway.ForwardResponseMessage
+
+	forward_Webhooks_List_0 = gateway.ForwardResponseMessage
+
+	forward_Webhooks_ListDeliveries_0 = gateway.ForwardResponseMessage
+
+	forward_SavedSearches_Create_0 = gateway.ForwardResponseMessage
+
+	forward_SavedSearches_Read_0 = gateway.ForwardResponseMessage
+
+	forward_SavedSearches_Update_0 = gateway.ForwardResponseMessage
+
+	forward_SavedSearches_Delete_0 = gateway.ForwardResponseMessage
+
+	forward_SavedSearches_List_0 = gateway.ForwardResponseMessage
 }
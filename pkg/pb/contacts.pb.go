@@ -18,8 +18,13 @@ It has these top-level messages:
 	UpdateProfileResponse
 	DeleteProfileRequest
 	DeleteProfileResponse
+	UndeleteProfileRequest
+	UndeleteProfileResponse
 	ListProfileRequest
 	ListProfilesResponse
+	ListProfileContactsRequest
+	ListProfileGroupsRequest
+	CreateProfileContactRequest
 	Group
 	CreateGroupRequest
 	CreateGroupResponse
@@ -29,10 +34,19 @@ It has these top-level messages:
 	UpdateGroupResponse
 	DeleteGroupRequest
 	DeleteGroupResponse
+	UndeleteGroupRequest
+	UndeleteGroupResponse
 	ListGroupRequest
 	ListGroupsResponse
+	AddGroupContactsRequest
+	AddGroupContactsResponse
+	RemoveGroupContactsRequest
+	RemoveGroupContactsResponse
+	ListGroupMembersRequest
+	ListGroupMembersResponse
 	Contact
 	Email
+	PhoneNumber
 	Address
 	CreateContactRequest
 	CreateContactResponse
@@ -42,10 +56,68 @@ It has these top-level messages:
 	UpdateContactResponse
 	DeleteContactRequest
 	DeleteContactResponse
+	UndeleteContactRequest
+	UndeleteContactResponse
 	ListContactsResponse
 	SMSRequest
 	SMSResponse
+	SyncContactsRequest
+	SyncContactsResponse
+	WatchContactsRequest
+	ContactEvent
+	SearchContactsRequest
+	ContactSearchResult
+	SearchContactsResponse
+	SuggestContactsRequest
+	ContactSuggestion
+	SuggestContactsResponse
+	FindDuplicatesRequest
+	DuplicateGroup
+	FindDuplicatesResponse
+	MergeContactsRequest
+	MergeContactsResponse
 	ListContactRequest
+	BatchCreateContactsRequest
+	BatchCreateContactsResponse
+	BatchUpdateContactsRequest
+	BatchUpdateContactsResponse
+	BatchDeleteContactsRequest
+	BatchDeleteContactsResponse
+	ExportContactsRequest
+	ReadVCardRequest
+	FileChunk
+	ImportOptions
+	ImportContactsRequest
+	ImportContactsResponse
+	AuditEvent
+	ListAuditEventRequest
+	ListAuditEventsResponse
+	WebhookSubscription
+	WebhookDelivery
+	CreateWebhookSubscriptionRequest
+	CreateWebhookSubscriptionResponse
+	ReadWebhookSubscriptionRequest
+	ReadWebhookSubscriptionResponse
+	UpdateWebhookSubscriptionRequest
+	UpdateWebhookSubscriptionResponse
+	DeleteWebhookSubscriptionRequest
+	DeleteWebhookSubscriptionResponse
+	ListWebhookSubscriptionRequest
+	ListWebhookSubscriptionsResponse
+	ListWebhookDeliveryRequest
+	ListWebhookDeliveriesResponse
+	SavedSearch
+	CreateSavedSearchRequest
+	CreateSavedSearchResponse
+	ReadSavedSearchRequest
+	ReadSavedSearchResponse
+	UpdateSavedSearchRequest
+	UpdateSavedSearchResponse
+	DeleteSavedSearchRequest
+	DeleteSavedSearchResponse
+	ListSavedSearchRequest
+	ListSavedSearchesResponse
+	RunSavedSearchRequest
 */
 package pb
 
@@ -53,6 +125,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import google_protobuf "google.golang.org/genproto/protobuf/field_mask"
+import google_protobuf1 "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 import _ "github.com/lyft/protoc-gen-validate/validate"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
@@ -60,6 +133,7 @@ import _ "github.com/infobloxopen/protoc-gen-gorm/options"
 import gorm_types "github.com/infobloxopen/protoc-gen-gorm/types"
 import infoblox_api "github.com/infobloxopen/atlas-app-toolkit/query"
 import atlas_rpc "github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+import atlas_rpc1 "github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
 
 import (
 	context "golang.org/x/net/context"
@@ -77,12 +151,115 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// BatchMode selects how the items of a batch are applied
+type BatchMode int32
+
+const (
+	// ATOMIC applies all the items in one transaction, the batch fails as a
+	// whole if any of the items fails
+	BatchMode_ATOMIC BatchMode = 0
+	// BEST_EFFORT applies every item independently, the failed items are
+	// reported in the errors of the response
+	BatchMode_BEST_EFFORT BatchMode = 1
+)
+
+var BatchMode_name = map[int32]string{
+	0: "ATOMIC",
+	1: "BEST_EFFORT",
+}
+var BatchMode_value = map[string]int32{
+	"ATOMIC":      0,
+	"BEST_EFFORT": 1,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+func (BatchMode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type PhoneNumber_Type int32
+
+const (
+	PhoneNumber_MOBILE PhoneNumber_Type = 0
+	PhoneNumber_HOME   PhoneNumber_Type = 1
+	PhoneNumber_WORK   PhoneNumber_Type = 2
+)
+
+var PhoneNumber_Type_name = map[int32]string{
+	0: "MOBILE",
+	1: "HOME",
+	2: "WORK",
+}
+var PhoneNumber_Type_value = map[string]int32{
+	"MOBILE": 0,
+	"HOME":   1,
+	"WORK":   2,
+}
+
+func (x PhoneNumber_Type) String() string {
+	return proto.EnumName(PhoneNumber_Type_name, int32(x))
+}
+func (PhoneNumber_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{37, 0} }
+
+type ContactEvent_Type int32
+
+const (
+	ContactEvent_CREATED ContactEvent_Type = 0
+	ContactEvent_UPDATED ContactEvent_Type = 1
+	ContactEvent_DELETED ContactEvent_Type = 2
+)
+
+var ContactEvent_Type_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+var ContactEvent_Type_value = map[string]int32{
+	"CREATED": 0,
+	"UPDATED": 1,
+	"DELETED": 2,
+}
+
+func (x ContactEvent_Type) String() string {
+	return proto.EnumName(ContactEvent_Type_name, int32(x))
+}
+func (ContactEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{55, 0} }
+
+type WebhookDelivery_Status int32
+
+const (
+	WebhookDelivery_PENDING   WebhookDelivery_Status = 0
+	WebhookDelivery_SUCCEEDED WebhookDelivery_Status = 1
+	WebhookDelivery_FAILED    WebhookDelivery_Status = 2
+)
+
+var WebhookDelivery_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var WebhookDelivery_Status_value = map[string]int32{
+	"PENDING":   0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x WebhookDelivery_Status) String() string {
+	return proto.EnumName(WebhookDelivery_Status_name, int32(x))
+}
+func (WebhookDelivery_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{84, 0} }
+
 type Profile struct {
 	Id       *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Name     string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	Notes    string                `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
 	Contacts []*Contact            `protobuf:"bytes,4,rep,name=contacts" json:"contacts,omitempty"`
 	Groups   []*Group              `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
+	// etag identifies the version of the profile, an Update with an outdated etag fails
+	Etag string `protobuf:"bytes,6,opt,name=etag" json:"etag,omitempty"`
+	// created_at and updated_at are maintained by the server, values set by clients are ignored
+	CreatedAt *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	UpdatedAt *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
 }
 
 func (m *Profile) Reset()                    { *m = Profile{} }
@@ -125,6 +302,27 @@ func (m *Profile) GetGroups() []*Group {
 	return nil
 }
 
+func (m *Profile) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *Profile) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Profile) GetUpdatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
 type CreateProfileRequest struct {
 	Payload *Profile `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
 }
@@ -245,17 +443,51 @@ func (m *DeleteProfileResponse) String() string            { return proto.Compac
 func (*DeleteProfileResponse) ProtoMessage()               {}
 func (*DeleteProfileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
+type UndeleteProfileRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *UndeleteProfileRequest) Reset()                    { *m = UndeleteProfileRequest{} }
+func (m *UndeleteProfileRequest) String() string            { return proto.CompactTextString(m) }
+func (*UndeleteProfileRequest) ProtoMessage()               {}
+func (*UndeleteProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *UndeleteProfileRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type UndeleteProfileResponse struct {
+	Result *Profile `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *UndeleteProfileResponse) Reset()                    { *m = UndeleteProfileResponse{} }
+func (m *UndeleteProfileResponse) String() string            { return proto.CompactTextString(m) }
+func (*UndeleteProfileResponse) ProtoMessage()               {}
+func (*UndeleteProfileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *UndeleteProfileResponse) GetResult() *Profile {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 type ListProfileRequest struct {
 	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
 	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
 	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
 	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+	// show_deleted includes deleted profiles which are not purged yet
+	ShowDeleted bool `protobuf:"varint,5,opt,name=show_deleted,json=showDeleted" json:"show_deleted,omitempty"`
 }
 
 func (m *ListProfileRequest) Reset()                    { *m = ListProfileRequest{} }
 func (m *ListProfileRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListProfileRequest) ProtoMessage()               {}
-func (*ListProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ListProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *ListProfileRequest) GetFilter() *infoblox_api.Filtering {
 	if m != nil {
@@ -285,6 +517,13 @@ func (m *ListProfileRequest) GetPaging() *infoblox_api.Pagination {
 	return nil
 }
 
+func (m *ListProfileRequest) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
+}
+
 type ListProfilesResponse struct {
 	Results []*Profile `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
 }
@@ -292,7 +531,7 @@ type ListProfilesResponse struct {
 func (m *ListProfilesResponse) Reset()                    { *m = ListProfilesResponse{} }
 func (m *ListProfilesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListProfilesResponse) ProtoMessage()               {}
-func (*ListProfilesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ListProfilesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *ListProfilesResponse) GetResults() []*Profile {
 	if m != nil {
@@ -301,18 +540,149 @@ func (m *ListProfilesResponse) GetResults() []*Profile {
 	return nil
 }
 
+type ListProfileContactsRequest struct {
+	Id      *atlas_rpc.Identifier        `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,3,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,4,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,5,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListProfileContactsRequest) Reset()                    { *m = ListProfileContactsRequest{} }
+func (m *ListProfileContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListProfileContactsRequest) ProtoMessage()               {}
+func (*ListProfileContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ListProfileContactsRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ListProfileContactsRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListProfileContactsRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListProfileContactsRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListProfileContactsRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListProfileGroupsRequest struct {
+	Id      *atlas_rpc.Identifier        `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,3,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,4,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,5,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListProfileGroupsRequest) Reset()                    { *m = ListProfileGroupsRequest{} }
+func (m *ListProfileGroupsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListProfileGroupsRequest) ProtoMessage()               {}
+func (*ListProfileGroupsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *ListProfileGroupsRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ListProfileGroupsRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListProfileGroupsRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListProfileGroupsRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListProfileGroupsRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type CreateProfileContactRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// payload is the new contact, its profile_id is set to the profile
+	Payload *Contact `protobuf:"bytes,2,opt,name=payload" json:"payload,omitempty"`
+}
+
+func (m *CreateProfileContactRequest) Reset()                    { *m = CreateProfileContactRequest{} }
+func (m *CreateProfileContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateProfileContactRequest) ProtoMessage()               {}
+func (*CreateProfileContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *CreateProfileContactRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *CreateProfileContactRequest) GetPayload() *Contact {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type Group struct {
 	Id        *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Name      string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	Notes     string                `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
 	ProfileId *atlas_rpc.Identifier `protobuf:"bytes,4,opt,name=profile_id,json=profileId" json:"profile_id,omitempty"`
 	Contacts  []*Contact            `protobuf:"bytes,5,rep,name=contacts" json:"contacts,omitempty"`
+	// etag identifies the version of the group, an Update with an outdated etag fails
+	Etag string `protobuf:"bytes,6,opt,name=etag" json:"etag,omitempty"`
+	// created_at and updated_at are maintained by the server, values set by clients are ignored
+	CreatedAt *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	UpdatedAt *google_protobuf1.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	// member_filter makes the group a smart group whose members are the
+	// contacts matching the filter, it has the syntax of the _filter parameter.
+	// The contacts of a smart group can't be changed, they are ignored by
+	// Update.
+	MemberFilter string `protobuf:"bytes,9,opt,name=member_filter,json=memberFilter" json:"member_filter,omitempty"`
 }
 
 func (m *Group) Reset()                    { *m = Group{} }
 func (m *Group) String() string            { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()               {}
-func (*Group) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*Group) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *Group) GetId() *atlas_rpc.Identifier {
 	if m != nil {
@@ -349,6 +719,34 @@ func (m *Group) GetContacts() []*Contact {
 	return nil
 }
 
+func (m *Group) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *Group) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Group) GetUpdatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *Group) GetMemberFilter() string {
+	if m != nil {
+		return m.MemberFilter
+	}
+	return ""
+}
+
 type CreateGroupRequest struct {
 	Payload *Group `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
 }
@@ -356,7 +754,7 @@ type CreateGroupRequest struct {
 func (m *CreateGroupRequest) Reset()                    { *m = CreateGroupRequest{} }
 func (m *CreateGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateGroupRequest) ProtoMessage()               {}
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *CreateGroupRequest) GetPayload() *Group {
 	if m != nil {
@@ -372,7 +770,7 @@ type CreateGroupResponse struct {
 func (m *CreateGroupResponse) Reset()                    { *m = CreateGroupResponse{} }
 func (m *CreateGroupResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateGroupResponse) ProtoMessage()               {}
-func (*CreateGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *CreateGroupResponse) GetResult() *Group {
 	if m != nil {
@@ -388,7 +786,7 @@ type ReadGroupRequest struct {
 func (m *ReadGroupRequest) Reset()                    { *m = ReadGroupRequest{} }
 func (m *ReadGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReadGroupRequest) ProtoMessage()               {}
-func (*ReadGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*ReadGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *ReadGroupRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
@@ -404,7 +802,7 @@ type ReadGroupResponse struct {
 func (m *ReadGroupResponse) Reset()                    { *m = ReadGroupResponse{} }
 func (m *ReadGroupResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadGroupResponse) ProtoMessage()               {}
-func (*ReadGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*ReadGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *ReadGroupResponse) GetResult() *Group {
 	if m != nil {
@@ -420,7 +818,7 @@ type UpdateGroupRequest struct {
 func (m *UpdateGroupRequest) Reset()                    { *m = UpdateGroupRequest{} }
 func (m *UpdateGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateGroupRequest) ProtoMessage()               {}
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *UpdateGroupRequest) GetPayload() *Group {
 	if m != nil {
@@ -436,7 +834,7 @@ type UpdateGroupResponse struct {
 func (m *UpdateGroupResponse) Reset()                    { *m = UpdateGroupResponse{} }
 func (m *UpdateGroupResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateGroupResponse) ProtoMessage()               {}
-func (*UpdateGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *UpdateGroupResponse) GetResult() *Group {
 	if m != nil {
@@ -452,7 +850,7 @@ type DeleteGroupRequest struct {
 func (m *DeleteGroupRequest) Reset()                    { *m = DeleteGroupRequest{} }
 func (m *DeleteGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteGroupRequest) ProtoMessage()               {}
-func (*DeleteGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *DeleteGroupRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
@@ -467,19 +865,53 @@ type DeleteGroupResponse struct {
 func (m *DeleteGroupResponse) Reset()                    { *m = DeleteGroupResponse{} }
 func (m *DeleteGroupResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteGroupResponse) ProtoMessage()               {}
-func (*DeleteGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+type UndeleteGroupRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *UndeleteGroupRequest) Reset()                    { *m = UndeleteGroupRequest{} }
+func (m *UndeleteGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*UndeleteGroupRequest) ProtoMessage()               {}
+func (*UndeleteGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *UndeleteGroupRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type UndeleteGroupResponse struct {
+	Result *Group `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *UndeleteGroupResponse) Reset()                    { *m = UndeleteGroupResponse{} }
+func (m *UndeleteGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*UndeleteGroupResponse) ProtoMessage()               {}
+func (*UndeleteGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *UndeleteGroupResponse) GetResult() *Group {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
 
 type ListGroupRequest struct {
 	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
 	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
 	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
 	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+	// show_deleted includes deleted groups which are not purged yet
+	ShowDeleted bool `protobuf:"varint,5,opt,name=show_deleted,json=showDeleted" json:"show_deleted,omitempty"`
 }
 
 func (m *ListGroupRequest) Reset()                    { *m = ListGroupRequest{} }
 func (m *ListGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListGroupRequest) ProtoMessage()               {}
-func (*ListGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ListGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *ListGroupRequest) GetFilter() *infoblox_api.Filtering {
 	if m != nil {
@@ -509,6 +941,13 @@ func (m *ListGroupRequest) GetPaging() *infoblox_api.Pagination {
 	return nil
 }
 
+func (m *ListGroupRequest) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
+}
+
 type ListGroupsResponse struct {
 	Results []*Group `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
 }
@@ -516,7 +955,7 @@ type ListGroupsResponse struct {
 func (m *ListGroupsResponse) Reset()                    { *m = ListGroupsResponse{} }
 func (m *ListGroupsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListGroupsResponse) ProtoMessage()               {}
-func (*ListGroupsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *ListGroupsResponse) GetResults() []*Group {
 	if m != nil {
@@ -525,86 +964,229 @@ func (m *ListGroupsResponse) GetResults() []*Group {
 	return nil
 }
 
-type Contact struct {
-	Id           *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	FirstName    string                `protobuf:"bytes,2,opt,name=first_name,json=firstName" json:"first_name,omitempty"`
-	MiddleName   string                `protobuf:"bytes,3,opt,name=middle_name,json=middleName" json:"middle_name,omitempty"`
-	LastName     string                `protobuf:"bytes,4,opt,name=last_name,json=lastName" json:"last_name,omitempty"`
-	PrimaryEmail string                `protobuf:"bytes,5,opt,name=primary_email,json=primaryEmail" json:"primary_email,omitempty"`
-	Notes        string                `protobuf:"bytes,6,opt,name=notes" json:"notes,omitempty"`
-	Emails       []*Email              `protobuf:"bytes,7,rep,name=emails" json:"emails,omitempty"`
-	HomeAddress  *Address              `protobuf:"bytes,8,opt,name=home_address,json=homeAddress" json:"home_address,omitempty"`
-	WorkAddress  *Address              `protobuf:"bytes,9,opt,name=work_address,json=workAddress" json:"work_address,omitempty"`
-	ProfileId    *atlas_rpc.Identifier `protobuf:"bytes,10,opt,name=profile_id,json=profileId" json:"profile_id,omitempty"`
-	Groups       []*Group              `protobuf:"bytes,11,rep,name=groups" json:"groups,omitempty"`
-	// nicknames is arbitrary json, but should be used for a list of strings
-	Nicknames *gorm_types.JSONValue `protobuf:"bytes,12,opt,name=nicknames" json:"nicknames,omitempty"`
+type AddGroupContactsRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// contact_ids identify the contacts added to the group, the ones which
+	// are members already are skipped
+	ContactIds []*atlas_rpc.Identifier `protobuf:"bytes,2,rep,name=contact_ids,json=contactIds" json:"contact_ids,omitempty"`
 }
 
-func (m *Contact) Reset()                    { *m = Contact{} }
-func (m *Contact) String() string            { return proto.CompactTextString(m) }
-func (*Contact) ProtoMessage()               {}
-func (*Contact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *AddGroupContactsRequest) Reset()                    { *m = AddGroupContactsRequest{} }
+func (m *AddGroupContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddGroupContactsRequest) ProtoMessage()               {}
+func (*AddGroupContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
-func (m *Contact) GetId() *atlas_rpc.Identifier {
+func (m *AddGroupContactsRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
 		return m.Id
 	}
 	return nil
 }
 
-func (m *Contact) GetFirstName() string {
+func (m *AddGroupContactsRequest) GetContactIds() []*atlas_rpc.Identifier {
 	if m != nil {
-		return m.FirstName
+		return m.ContactIds
 	}
-	return ""
+	return nil
 }
 
-func (m *Contact) GetMiddleName() string {
-	if m != nil {
-		return m.MiddleName
-	}
-	return ""
+type AddGroupContactsResponse struct {
 }
 
-func (m *Contact) GetLastName() string {
-	if m != nil {
-		return m.LastName
-	}
-	return ""
+func (m *AddGroupContactsResponse) Reset()                    { *m = AddGroupContactsResponse{} }
+func (m *AddGroupContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddGroupContactsResponse) ProtoMessage()               {}
+func (*AddGroupContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+type RemoveGroupContactsRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// contact_ids identify the contacts removed from the group, the ones
+	// which are not members are skipped
+	ContactIds []*atlas_rpc.Identifier `protobuf:"bytes,2,rep,name=contact_ids,json=contactIds" json:"contact_ids,omitempty"`
 }
 
-func (m *Contact) GetPrimaryEmail() string {
+func (m *RemoveGroupContactsRequest) Reset()                    { *m = RemoveGroupContactsRequest{} }
+func (m *RemoveGroupContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveGroupContactsRequest) ProtoMessage()               {}
+func (*RemoveGroupContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *RemoveGroupContactsRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
-		return m.PrimaryEmail
+		return m.Id
 	}
-	return ""
+	return nil
 }
 
-func (m *Contact) GetNotes() string {
+func (m *RemoveGroupContactsRequest) GetContactIds() []*atlas_rpc.Identifier {
 	if m != nil {
-		return m.Notes
+		return m.ContactIds
 	}
-	return ""
+	return nil
 }
 
-func (m *Contact) GetEmails() []*Email {
+type RemoveGroupContactsResponse struct {
+}
+
+func (m *RemoveGroupContactsResponse) Reset()                    { *m = RemoveGroupContactsResponse{} }
+func (m *RemoveGroupContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*RemoveGroupContactsResponse) ProtoMessage()               {}
+func (*RemoveGroupContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+type ListGroupMembersRequest struct {
+	Id      *atlas_rpc.Identifier        `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,3,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,4,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,5,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListGroupMembersRequest) Reset()                    { *m = ListGroupMembersRequest{} }
+func (m *ListGroupMembersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListGroupMembersRequest) ProtoMessage()               {}
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ListGroupMembersRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
-		return m.Emails
+		return m.Id
 	}
 	return nil
 }
 
-func (m *Contact) GetHomeAddress() *Address {
+func (m *ListGroupMembersRequest) GetFilter() *infoblox_api.Filtering {
 	if m != nil {
-		return m.HomeAddress
+		return m.Filter
 	}
 	return nil
 }
 
-func (m *Contact) GetWorkAddress() *Address {
+func (m *ListGroupMembersRequest) GetOrderBy() *infoblox_api.Sorting {
 	if m != nil {
-		return m.WorkAddress
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListGroupMembersRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListGroupMembersRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListGroupMembersResponse struct {
+	Results []*Contact `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ListGroupMembersResponse) Reset()                    { *m = ListGroupMembersResponse{} }
+func (m *ListGroupMembersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListGroupMembersResponse) ProtoMessage()               {}
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ListGroupMembersResponse) GetResults() []*Contact {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type Contact struct {
+	Id           *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	FirstName    string                `protobuf:"bytes,2,opt,name=first_name,json=firstName" json:"first_name,omitempty"`
+	MiddleName   string                `protobuf:"bytes,3,opt,name=middle_name,json=middleName" json:"middle_name,omitempty"`
+	LastName     string                `protobuf:"bytes,4,opt,name=last_name,json=lastName" json:"last_name,omitempty"`
+	PrimaryEmail string                `protobuf:"bytes,5,opt,name=primary_email,json=primaryEmail" json:"primary_email,omitempty"`
+	Notes        string                `protobuf:"bytes,6,opt,name=notes" json:"notes,omitempty"`
+	Emails       []*Email              `protobuf:"bytes,7,rep,name=emails" json:"emails,omitempty"`
+	HomeAddress  *Address              `protobuf:"bytes,8,opt,name=home_address,json=homeAddress" json:"home_address,omitempty"`
+	WorkAddress  *Address              `protobuf:"bytes,9,opt,name=work_address,json=workAddress" json:"work_address,omitempty"`
+	ProfileId    *atlas_rpc.Identifier `protobuf:"bytes,10,opt,name=profile_id,json=profileId" json:"profile_id,omitempty"`
+	Groups       []*Group              `protobuf:"bytes,11,rep,name=groups" json:"groups,omitempty"`
+	// nicknames is arbitrary json, but should be used for a list of strings
+	Nicknames    *gorm_types.JSONValue `protobuf:"bytes,12,opt,name=nicknames" json:"nicknames,omitempty"`
+	PhoneNumbers []*PhoneNumber        `protobuf:"bytes,13,rep,name=phone_numbers,json=phoneNumbers" json:"phone_numbers,omitempty"`
+	PrimaryPhone string                `protobuf:"bytes,14,opt,name=primary_phone,json=primaryPhone" json:"primary_phone,omitempty"`
+	// etag identifies the version of the contact, an Update with an outdated etag fails
+	Etag string `protobuf:"bytes,15,opt,name=etag" json:"etag,omitempty"`
+	// created_at and updated_at are maintained by the server, values set by clients are ignored
+	CreatedAt *google_protobuf1.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	UpdatedAt *google_protobuf1.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt" json:"updated_at,omitempty"`
+	// carddav_name is the name of the vCard resource of the contact in the
+	// CardDAV address book of its profile, it is set when the contact is
+	// created and kept by updates
+	CarddavName string `protobuf:"bytes,18,opt,name=carddav_name,json=carddavName" json:"carddav_name,omitempty"`
+}
+
+func (m *Contact) Reset()                    { *m = Contact{} }
+func (m *Contact) String() string            { return proto.CompactTextString(m) }
+func (*Contact) ProtoMessage()               {}
+func (*Contact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *Contact) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Contact) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *Contact) GetMiddleName() string {
+	if m != nil {
+		return m.MiddleName
+	}
+	return ""
+}
+
+func (m *Contact) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *Contact) GetPrimaryEmail() string {
+	if m != nil {
+		return m.PrimaryEmail
+	}
+	return ""
+}
+
+func (m *Contact) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *Contact) GetEmails() []*Email {
+	if m != nil {
+		return m.Emails
+	}
+	return nil
+}
+
+func (m *Contact) GetHomeAddress() *Address {
+	if m != nil {
+		return m.HomeAddress
+	}
+	return nil
+}
+
+func (m *Contact) GetWorkAddress() *Address {
+	if m != nil {
+		return m.WorkAddress
 	}
 	return nil
 }
@@ -630,6 +1212,48 @@ func (m *Contact) GetNicknames() *gorm_types.JSONValue {
 	return nil
 }
 
+func (m *Contact) GetPhoneNumbers() []*PhoneNumber {
+	if m != nil {
+		return m.PhoneNumbers
+	}
+	return nil
+}
+
+func (m *Contact) GetPrimaryPhone() string {
+	if m != nil {
+		return m.PrimaryPhone
+	}
+	return ""
+}
+
+func (m *Contact) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *Contact) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Contact) GetUpdatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *Contact) GetCarddavName() string {
+	if m != nil {
+		return m.CarddavName
+	}
+	return ""
+}
+
 type Email struct {
 	Id      uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	Address string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
@@ -638,7 +1262,7 @@ type Email struct {
 func (m *Email) Reset()                    { *m = Email{} }
 func (m *Email) String() string            { return proto.CompactTextString(m) }
 func (*Email) ProtoMessage()               {}
-func (*Email) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*Email) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 func (m *Email) GetId() uint64 {
 	if m != nil {
@@ -654,6 +1278,39 @@ func (m *Email) GetAddress() string {
 	return ""
 }
 
+type PhoneNumber struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// number is a phone number in E.164 format, e.g. +14155552671
+	Number string           `protobuf:"bytes,2,opt,name=number" json:"number,omitempty"`
+	Type   PhoneNumber_Type `protobuf:"varint,3,opt,name=type,enum=api.contacts.PhoneNumber_Type" json:"type,omitempty"`
+}
+
+func (m *PhoneNumber) Reset()                    { *m = PhoneNumber{} }
+func (m *PhoneNumber) String() string            { return proto.CompactTextString(m) }
+func (*PhoneNumber) ProtoMessage()               {}
+func (*PhoneNumber) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *PhoneNumber) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PhoneNumber) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+func (m *PhoneNumber) GetType() PhoneNumber_Type {
+	if m != nil {
+		return m.Type
+	}
+	return PhoneNumber_MOBILE
+}
+
 type Address struct {
 	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
 	City    string `protobuf:"bytes,2,opt,name=city" json:"city,omitempty"`
@@ -665,7 +1322,7 @@ type Address struct {
 func (m *Address) Reset()                    { *m = Address{} }
 func (m *Address) String() string            { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()               {}
-func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *Address) GetAddress() string {
 	if m != nil {
@@ -709,7 +1366,7 @@ type CreateContactRequest struct {
 func (m *CreateContactRequest) Reset()                    { *m = CreateContactRequest{} }
 func (m *CreateContactRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateContactRequest) ProtoMessage()               {}
-func (*CreateContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*CreateContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *CreateContactRequest) GetPayload() *Contact {
 	if m != nil {
@@ -725,7 +1382,7 @@ type CreateContactResponse struct {
 func (m *CreateContactResponse) Reset()                    { *m = CreateContactResponse{} }
 func (m *CreateContactResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateContactResponse) ProtoMessage()               {}
-func (*CreateContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*CreateContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *CreateContactResponse) GetResult() *Contact {
 	if m != nil {
@@ -741,7 +1398,7 @@ type ReadContactRequest struct {
 func (m *ReadContactRequest) Reset()                    { *m = ReadContactRequest{} }
 func (m *ReadContactRequest) String() string            { return proto.CompactTextString(m) }
 func (*ReadContactRequest) ProtoMessage()               {}
-func (*ReadContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*ReadContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *ReadContactRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
@@ -757,7 +1414,7 @@ type ReadContactResponse struct {
 func (m *ReadContactResponse) Reset()                    { *m = ReadContactResponse{} }
 func (m *ReadContactResponse) String() string            { return proto.CompactTextString(m) }
 func (*ReadContactResponse) ProtoMessage()               {}
-func (*ReadContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*ReadContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *ReadContactResponse) GetResult() *Contact {
 	if m != nil {
@@ -774,7 +1431,7 @@ type UpdateContactRequest struct {
 func (m *UpdateContactRequest) Reset()                    { *m = UpdateContactRequest{} }
 func (m *UpdateContactRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateContactRequest) ProtoMessage()               {}
-func (*UpdateContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *UpdateContactRequest) GetPayload() *Contact {
 	if m != nil {
@@ -797,7 +1454,7 @@ type UpdateContactResponse struct {
 func (m *UpdateContactResponse) Reset()                    { *m = UpdateContactResponse{} }
 func (m *UpdateContactResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateContactResponse) ProtoMessage()               {}
-func (*UpdateContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*UpdateContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 func (m *UpdateContactResponse) GetResult() *Contact {
 	if m != nil {
@@ -813,7 +1470,7 @@ type DeleteContactRequest struct {
 func (m *DeleteContactRequest) Reset()                    { *m = DeleteContactRequest{} }
 func (m *DeleteContactRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteContactRequest) ProtoMessage()               {}
-func (*DeleteContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *DeleteContactRequest) GetId() *atlas_rpc.Identifier {
 	if m != nil {
@@ -828,7 +1485,39 @@ type DeleteContactResponse struct {
 func (m *DeleteContactResponse) Reset()                    { *m = DeleteContactResponse{} }
 func (m *DeleteContactResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteContactResponse) ProtoMessage()               {}
-func (*DeleteContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*DeleteContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+type UndeleteContactRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *UndeleteContactRequest) Reset()                    { *m = UndeleteContactRequest{} }
+func (m *UndeleteContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*UndeleteContactRequest) ProtoMessage()               {}
+func (*UndeleteContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *UndeleteContactRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type UndeleteContactResponse struct {
+	Result *Contact `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *UndeleteContactResponse) Reset()                    { *m = UndeleteContactResponse{} }
+func (m *UndeleteContactResponse) String() string            { return proto.CompactTextString(m) }
+func (*UndeleteContactResponse) ProtoMessage()               {}
+func (*UndeleteContactResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *UndeleteContactResponse) GetResult() *Contact {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
 
 type ListContactsResponse struct {
 	Results []*Contact `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
@@ -837,7 +1526,7 @@ type ListContactsResponse struct {
 func (m *ListContactsResponse) Reset()                    { *m = ListContactsResponse{} }
 func (m *ListContactsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListContactsResponse) ProtoMessage()               {}
-func (*ListContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*ListContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *ListContactsResponse) GetResults() []*Contact {
 	if m != nil {
@@ -854,7 +1543,7 @@ type SMSRequest struct {
 func (m *SMSRequest) Reset()                    { *m = SMSRequest{} }
 func (m *SMSRequest) String() string            { return proto.CompactTextString(m) }
 func (*SMSRequest) ProtoMessage()               {}
-func (*SMSRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*SMSRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *SMSRequest) GetId() uint64 {
 	if m != nil {
@@ -871,716 +1560,3694 @@ func (m *SMSRequest) GetMessage() string {
 }
 
 type SMSResponse struct {
+	// delivery_id identifies the message within the SMS delivery backend
+	DeliveryId string `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId" json:"delivery_id,omitempty"`
+	// status is the delivery status reported by the SMS delivery backend
+	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
 }
 
 func (m *SMSResponse) Reset()                    { *m = SMSResponse{} }
 func (m *SMSResponse) String() string            { return proto.CompactTextString(m) }
 func (*SMSResponse) ProtoMessage()               {}
-func (*SMSResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*SMSResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
-type ListContactRequest struct {
-	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
-	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
-	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
-	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+func (m *SMSResponse) GetDeliveryId() string {
+	if m != nil {
+		return m.DeliveryId
+	}
+	return ""
 }
 
-func (m *ListContactRequest) Reset()                    { *m = ListContactRequest{} }
-func (m *ListContactRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListContactRequest) ProtoMessage()               {}
-func (*ListContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (m *SMSResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
 
-func (m *ListContactRequest) GetFilter() *infoblox_api.Filtering {
+type SyncContactsRequest struct {
+	// sync_token is the token returned by the previous Sync call,
+	// an empty token requests all the contacts
+	SyncToken string `protobuf:"bytes,1,opt,name=sync_token,json=syncToken" json:"sync_token,omitempty"`
+	// limit is the maximum number of changes returned at once
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// current requests only the sync token of the current state of the
+	// contacts, no changes are returned
+	Current bool `protobuf:"varint,3,opt,name=current" json:"current,omitempty"`
+}
+
+func (m *SyncContactsRequest) Reset()                    { *m = SyncContactsRequest{} }
+func (m *SyncContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SyncContactsRequest) ProtoMessage()               {}
+func (*SyncContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *SyncContactsRequest) GetSyncToken() string {
 	if m != nil {
-		return m.Filter
+		return m.SyncToken
 	}
-	return nil
+	return ""
 }
 
-func (m *ListContactRequest) GetOrderBy() *infoblox_api.Sorting {
+func (m *SyncContactsRequest) GetLimit() int32 {
 	if m != nil {
-		return m.OrderBy
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *ListContactRequest) GetFields() *infoblox_api.FieldSelection {
+func (m *SyncContactsRequest) GetCurrent() bool {
 	if m != nil {
-		return m.Fields
+		return m.Current
 	}
-	return nil
+	return false
 }
 
-func (m *ListContactRequest) GetPaging() *infoblox_api.Pagination {
+type SyncContactsResponse struct {
+	// results are the contacts created or updated since the sync token
+	Results []*Contact `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// deleted identifies the contacts deleted since the sync token
+	Deleted []*atlas_rpc.Identifier `protobuf:"bytes,2,rep,name=deleted" json:"deleted,omitempty"`
+	// sync_token is passed to the next Sync call to get the further changes
+	SyncToken string `protobuf:"bytes,3,opt,name=sync_token,json=syncToken" json:"sync_token,omitempty"`
+	// has_more is set if there are more changes than the limit
+	HasMore bool `protobuf:"varint,4,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+}
+
+func (m *SyncContactsResponse) Reset()                    { *m = SyncContactsResponse{} }
+func (m *SyncContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SyncContactsResponse) ProtoMessage()               {}
+func (*SyncContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *SyncContactsResponse) GetResults() []*Contact {
 	if m != nil {
-		return m.Paging
+		return m.Results
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Profile)(nil), "api.contacts.Profile")
-	proto.RegisterType((*CreateProfileRequest)(nil), "api.contacts.CreateProfileRequest")
-	proto.RegisterType((*CreateProfileResponse)(nil), "api.contacts.CreateProfileResponse")
-	proto.RegisterType((*ReadProfileRequest)(nil), "api.contacts.ReadProfileRequest")
-	proto.RegisterType((*ReadProfileResponse)(nil), "api.contacts.ReadProfileResponse")
-	proto.RegisterType((*UpdateProfileRequest)(nil), "api.contacts.UpdateProfileRequest")
-	proto.RegisterType((*UpdateProfileResponse)(nil), "api.contacts.UpdateProfileResponse")
-	proto.RegisterType((*DeleteProfileRequest)(nil), "api.contacts.DeleteProfileRequest")
-	proto.RegisterType((*DeleteProfileResponse)(nil), "api.contacts.DeleteProfileResponse")
-	proto.RegisterType((*ListProfileRequest)(nil), "api.contacts.ListProfileRequest")
-	proto.RegisterType((*ListProfilesResponse)(nil), "api.contacts.ListProfilesResponse")
-	proto.RegisterType((*Group)(nil), "api.contacts.Group")
-	proto.RegisterType((*CreateGroupRequest)(nil), "api.contacts.CreateGroupRequest")
-	proto.RegisterType((*CreateGroupResponse)(nil), "api.contacts.CreateGroupResponse")
-	proto.RegisterType((*ReadGroupRequest)(nil), "api.contacts.ReadGroupRequest")
-	proto.RegisterType((*ReadGroupResponse)(nil), "api.contacts.ReadGroupResponse")
-	proto.RegisterType((*UpdateGroupRequest)(nil), "api.contacts.UpdateGroupRequest")
-	proto.RegisterType((*UpdateGroupResponse)(nil), "api.contacts.UpdateGroupResponse")
-	proto.RegisterType((*DeleteGroupRequest)(nil), "api.contacts.DeleteGroupRequest")
-	proto.RegisterType((*DeleteGroupResponse)(nil), "api.contacts.DeleteGroupResponse")
-	proto.RegisterType((*ListGroupRequest)(nil), "api.contacts.ListGroupRequest")
-	proto.RegisterType((*ListGroupsResponse)(nil), "api.contacts.ListGroupsResponse")
-	proto.RegisterType((*Contact)(nil), "api.contacts.Contact")
-	proto.RegisterType((*Email)(nil), "api.contacts.Email")
-	proto.RegisterType((*Address)(nil), "api.contacts.Address")
-	proto.RegisterType((*CreateContactRequest)(nil), "api.contacts.CreateContactRequest")
-	proto.RegisterType((*CreateContactResponse)(nil), "api.contacts.CreateContactResponse")
-	proto.RegisterType((*ReadContactRequest)(nil), "api.contacts.ReadContactRequest")
-	proto.RegisterType((*ReadContactResponse)(nil), "api.contacts.ReadContactResponse")
-	proto.RegisterType((*UpdateContactRequest)(nil), "api.contacts.UpdateContactRequest")
-	proto.RegisterType((*UpdateContactResponse)(nil), "api.contacts.UpdateContactResponse")
-	proto.RegisterType((*DeleteContactRequest)(nil), "api.contacts.DeleteContactRequest")
-	proto.RegisterType((*DeleteContactResponse)(nil), "api.contacts.DeleteContactResponse")
-	proto.RegisterType((*ListContactsResponse)(nil), "api.contacts.ListContactsResponse")
-	proto.RegisterType((*SMSRequest)(nil), "api.contacts.SMSRequest")
-	proto.RegisterType((*SMSResponse)(nil), "api.contacts.SMSResponse")
-	proto.RegisterType((*ListContactRequest)(nil), "api.contacts.ListContactRequest")
+func (m *SyncContactsResponse) GetDeleted() []*atlas_rpc.Identifier {
+	if m != nil {
+		return m.Deleted
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *SyncContactsResponse) GetSyncToken() string {
+	if m != nil {
+		return m.SyncToken
+	}
+	return ""
+}
+
+func (m *SyncContactsResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+type WatchContactsRequest struct {
+	// last_event_id is the id of the last event received before, the events
+	// which follow it are sent first. Zero means only new events are sent.
+	LastEventId int64 `protobuf:"varint,1,opt,name=last_event_id,json=lastEventId" json:"last_event_id,omitempty"`
+}
+
+func (m *WatchContactsRequest) Reset()                    { *m = WatchContactsRequest{} }
+func (m *WatchContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchContactsRequest) ProtoMessage()               {}
+func (*WatchContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *WatchContactsRequest) GetLastEventId() int64 {
+	if m != nil {
+		return m.LastEventId
+	}
+	return 0
+}
+
+type ContactEvent struct {
+	// id identifies the event within an account, the events are sent in the
+	// order of the transactions which stored them so the ids might not increase
+	Id   int64             `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Type ContactEvent_Type `protobuf:"varint,2,opt,name=type,enum=api.contacts.ContactEvent_Type" json:"type,omitempty"`
+	// contact is the current state of the contact, only its id is set if the
+	// contact is deleted
+	Contact   *Contact                    `protobuf:"bytes,3,opt,name=contact" json:"contact,omitempty"`
+	CreatedAt *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *ContactEvent) Reset()                    { *m = ContactEvent{} }
+func (m *ContactEvent) String() string            { return proto.CompactTextString(m) }
+func (*ContactEvent) ProtoMessage()               {}
+func (*ContactEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *ContactEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ContactEvent) GetType() ContactEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ContactEvent_CREATED
+}
+
+func (m *ContactEvent) GetContact() *Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *ContactEvent) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type SearchContactsRequest struct {
+	// q is the text to search for, e.g. "smith". A contact matches if all the
+	// words of the text are prefixes of the words of its names, notes, e-mail
+	// addresses, nicknames or addresses.
+	Q      string                   `protobuf:"bytes,1,opt,name=q" json:"q,omitempty"`
+	Paging *infoblox_api.Pagination `protobuf:"bytes,2,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *SearchContactsRequest) Reset()                    { *m = SearchContactsRequest{} }
+func (m *SearchContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchContactsRequest) ProtoMessage()               {}
+func (*SearchContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *SearchContactsRequest) GetQ() string {
+	if m != nil {
+		return m.Q
+	}
+	return ""
+}
+
+func (m *SearchContactsRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ContactSearchResult struct {
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact" json:"contact,omitempty"`
+	// rank is the relevance of the match, the results are ordered by it
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank" json:"rank,omitempty"`
+	// snippet is the matching text, the matched words are enclosed in <b> and </b>
+	Snippet string `protobuf:"bytes,3,opt,name=snippet" json:"snippet,omitempty"`
+}
+
+func (m *ContactSearchResult) Reset()                    { *m = ContactSearchResult{} }
+func (m *ContactSearchResult) String() string            { return proto.CompactTextString(m) }
+func (*ContactSearchResult) ProtoMessage()               {}
+func (*ContactSearchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *ContactSearchResult) GetContact() *Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *ContactSearchResult) GetRank() float32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *ContactSearchResult) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+type SearchContactsResponse struct {
+	Results []*ContactSearchResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *SearchContactsResponse) Reset()                    { *m = SearchContactsResponse{} }
+func (m *SearchContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchContactsResponse) ProtoMessage()               {}
+func (*SearchContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *SearchContactsResponse) GetResults() []*ContactSearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SuggestContactsRequest struct {
+	// q is the beginning of a name, nickname or primary e-mail address, or
+	// a misspelled one, e.g. "smi" or "smiht"
+	Q string `protobuf:"bytes,1,opt,name=q" json:"q,omitempty"`
+	// limit is the maximum number of suggestions, 10 by default
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *SuggestContactsRequest) Reset()                    { *m = SuggestContactsRequest{} }
+func (m *SuggestContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SuggestContactsRequest) ProtoMessage()               {}
+func (*SuggestContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *SuggestContactsRequest) GetQ() string {
+	if m != nil {
+		return m.Q
+	}
+	return ""
+}
+
+func (m *SuggestContactsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ContactSuggestion struct {
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact" json:"contact,omitempty"`
+	// similarity is the trigram similarity of q to the best matching word
+	// of the contact, from 0 to 1. The suggestions are ordered by it.
+	Similarity float32 `protobuf:"fixed32,2,opt,name=similarity" json:"similarity,omitempty"`
+}
+
+func (m *ContactSuggestion) Reset()                    { *m = ContactSuggestion{} }
+func (m *ContactSuggestion) String() string            { return proto.CompactTextString(m) }
+func (*ContactSuggestion) ProtoMessage()               {}
+func (*ContactSuggestion) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *ContactSuggestion) GetContact() *Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *ContactSuggestion) GetSimilarity() float32 {
+	if m != nil {
+		return m.Similarity
+	}
+	return 0
+}
+
+type SuggestContactsResponse struct {
+	Results []*ContactSuggestion `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *SuggestContactsResponse) Reset()                    { *m = SuggestContactsResponse{} }
+func (m *SuggestContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SuggestContactsResponse) ProtoMessage()               {}
+func (*SuggestContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *SuggestContactsResponse) GetResults() []*ContactSuggestion {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type FindDuplicatesRequest struct {
+	// min_confidence excludes the groups of contacts which are less likely
+	// to be duplicates, from 0 to 1
+	MinConfidence float32 `protobuf:"fixed32,1,opt,name=min_confidence,json=minConfidence" json:"min_confidence,omitempty"`
+}
+
+func (m *FindDuplicatesRequest) Reset()                    { *m = FindDuplicatesRequest{} }
+func (m *FindDuplicatesRequest) String() string            { return proto.CompactTextString(m) }
+func (*FindDuplicatesRequest) ProtoMessage()               {}
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *FindDuplicatesRequest) GetMinConfidence() float32 {
+	if m != nil {
+		return m.MinConfidence
+	}
+	return 0
+}
+
+// DuplicateGroup is a group of contacts which are likely the same person
+type DuplicateGroup struct {
+	Contacts []*Contact `protobuf:"bytes,1,rep,name=contacts" json:"contacts,omitempty"`
+	// confidence is the likelihood of the most similar pair of the contacts
+	// being duplicates, from 0 to 1. The groups are ordered by it.
+	Confidence float32 `protobuf:"fixed32,2,opt,name=confidence" json:"confidence,omitempty"`
+	// reasons are the properties the contacts share: "email", "name" or "address"
+	Reasons []string `protobuf:"bytes,3,rep,name=reasons" json:"reasons,omitempty"`
+}
+
+func (m *DuplicateGroup) Reset()                    { *m = DuplicateGroup{} }
+func (m *DuplicateGroup) String() string            { return proto.CompactTextString(m) }
+func (*DuplicateGroup) ProtoMessage()               {}
+func (*DuplicateGroup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *DuplicateGroup) GetContacts() []*Contact {
+	if m != nil {
+		return m.Contacts
+	}
+	return nil
+}
+
+func (m *DuplicateGroup) GetConfidence() float32 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+func (m *DuplicateGroup) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type FindDuplicatesResponse struct {
+	Results []*DuplicateGroup `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *FindDuplicatesResponse) Reset()                    { *m = FindDuplicatesResponse{} }
+func (m *FindDuplicatesResponse) String() string            { return proto.CompactTextString(m) }
+func (*FindDuplicatesResponse) ProtoMessage()               {}
+func (*FindDuplicatesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *FindDuplicatesResponse) GetResults() []*DuplicateGroup {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type MergeContactsRequest struct {
+	// id identifies the survivor, the contact the others are merged into
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// merged_ids identify the contacts merged into the survivor, they are
+	// deleted by the merge
+	MergedIds []*atlas_rpc.Identifier `protobuf:"bytes,2,rep,name=merged_ids,json=mergedIds" json:"merged_ids,omitempty"`
+	// primary_email is the primary e-mail address of the merged contact, one
+	// of the addresses of the contacts. The one of the survivor is kept by default.
+	PrimaryEmail string `protobuf:"bytes,3,opt,name=primary_email,json=primaryEmail" json:"primary_email,omitempty"`
+	// etag is the expected entity tag of the survivor, the merge is rejected
+	// if the survivor has changed since
+	Etag string `protobuf:"bytes,4,opt,name=etag" json:"etag,omitempty"`
+}
+
+func (m *MergeContactsRequest) Reset()                    { *m = MergeContactsRequest{} }
+func (m *MergeContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*MergeContactsRequest) ProtoMessage()               {}
+func (*MergeContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *MergeContactsRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *MergeContactsRequest) GetMergedIds() []*atlas_rpc.Identifier {
+	if m != nil {
+		return m.MergedIds
+	}
+	return nil
+}
+
+func (m *MergeContactsRequest) GetPrimaryEmail() string {
+	if m != nil {
+		return m.PrimaryEmail
+	}
+	return ""
+}
+
+func (m *MergeContactsRequest) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+type MergeContactsResponse struct {
+	Result *Contact `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *MergeContactsResponse) Reset()                    { *m = MergeContactsResponse{} }
+func (m *MergeContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*MergeContactsResponse) ProtoMessage()               {}
+func (*MergeContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *MergeContactsResponse) GetResult() *Contact {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListContactRequest struct {
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+	// show_deleted includes deleted contacts which are not purged yet
+	ShowDeleted bool `protobuf:"varint,5,opt,name=show_deleted,json=showDeleted" json:"show_deleted,omitempty"`
+}
+
+func (m *ListContactRequest) Reset()                    { *m = ListContactRequest{} }
+func (m *ListContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListContactRequest) ProtoMessage()               {}
+func (*ListContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *ListContactRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListContactRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListContactRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListContactRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func (m *ListContactRequest) GetShowDeleted() bool {
+	if m != nil {
+		return m.ShowDeleted
+	}
+	return false
+}
+
+type BatchCreateContactsRequest struct {
+	// the items are validated one by one, so an invalid item doesn't fail
+	// the whole batch in the BEST_EFFORT mode
+	Payload []*Contact `protobuf:"bytes,1,rep,name=payload" json:"payload,omitempty"`
+	Mode    BatchMode  `protobuf:"varint,2,opt,name=mode,enum=api.contacts.BatchMode" json:"mode,omitempty"`
+}
+
+func (m *BatchCreateContactsRequest) Reset()                    { *m = BatchCreateContactsRequest{} }
+func (m *BatchCreateContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchCreateContactsRequest) ProtoMessage()               {}
+func (*BatchCreateContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *BatchCreateContactsRequest) GetPayload() []*Contact {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *BatchCreateContactsRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+type BatchCreateContactsResponse struct {
+	// results are the created contacts in the order of the request items,
+	// the results of the failed items are empty
+	Results []*Contact `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// errors describe the failed items, the target of an error is the index of the item
+	Errors []*atlas_rpc1.TargetInfo `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *BatchCreateContactsResponse) Reset()                    { *m = BatchCreateContactsResponse{} }
+func (m *BatchCreateContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*BatchCreateContactsResponse) ProtoMessage()               {}
+func (*BatchCreateContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *BatchCreateContactsResponse) GetResults() []*Contact {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BatchCreateContactsResponse) GetErrors() []*atlas_rpc1.TargetInfo {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type BatchUpdateContactsRequest struct {
+	Payload []*Contact `protobuf:"bytes,1,rep,name=payload" json:"payload,omitempty"`
+	Mode    BatchMode  `protobuf:"varint,2,opt,name=mode,enum=api.contacts.BatchMode" json:"mode,omitempty"`
+}
+
+func (m *BatchUpdateContactsRequest) Reset()                    { *m = BatchUpdateContactsRequest{} }
+func (m *BatchUpdateContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchUpdateContactsRequest) ProtoMessage()               {}
+func (*BatchUpdateContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *BatchUpdateContactsRequest) GetPayload() []*Contact {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *BatchUpdateContactsRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+type BatchUpdateContactsResponse struct {
+	// results are the updated contacts in the order of the request items,
+	// the results of the failed items are empty
+	Results []*Contact `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// errors describe the failed items, the target of an error is the index of the item
+	Errors []*atlas_rpc1.TargetInfo `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *BatchUpdateContactsResponse) Reset()                    { *m = BatchUpdateContactsResponse{} }
+func (m *BatchUpdateContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*BatchUpdateContactsResponse) ProtoMessage()               {}
+func (*BatchUpdateContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *BatchUpdateContactsResponse) GetResults() []*Contact {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BatchUpdateContactsResponse) GetErrors() []*atlas_rpc1.TargetInfo {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type BatchDeleteContactsRequest struct {
+	Ids  []*atlas_rpc.Identifier `protobuf:"bytes,1,rep,name=ids" json:"ids,omitempty"`
+	Mode BatchMode               `protobuf:"varint,2,opt,name=mode,enum=api.contacts.BatchMode" json:"mode,omitempty"`
+}
+
+func (m *BatchDeleteContactsRequest) Reset()                    { *m = BatchDeleteContactsRequest{} }
+func (m *BatchDeleteContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchDeleteContactsRequest) ProtoMessage()               {}
+func (*BatchDeleteContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *BatchDeleteContactsRequest) GetIds() []*atlas_rpc.Identifier {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *BatchDeleteContactsRequest) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+type BatchDeleteContactsResponse struct {
+	// errors describe the failed items, the target of an error is the index of the item
+	Errors []*atlas_rpc1.TargetInfo `protobuf:"bytes,1,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *BatchDeleteContactsResponse) Reset()                    { *m = BatchDeleteContactsResponse{} }
+func (m *BatchDeleteContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*BatchDeleteContactsResponse) ProtoMessage()               {}
+func (*BatchDeleteContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *BatchDeleteContactsResponse) GetErrors() []*atlas_rpc1.TargetInfo {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ExportContactsRequest struct {
+	Filter  *infoblox_api.Filtering `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting   `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	// fields selects the exported columns, e.g. "first_name,home_address".
+	// It applies to CSV files only.
+	Fields *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
+	// format is the format of the file, "csv" (the default) or "vcard"
+	Format string `protobuf:"bytes,4,opt,name=format" json:"format,omitempty"`
+	// vcard_version is the version of exported vCards, "3.0" or "4.0" (the default)
+	VcardVersion string `protobuf:"bytes,5,opt,name=vcard_version,json=vcardVersion" json:"vcard_version,omitempty"`
+}
+
+func (m *ExportContactsRequest) Reset()                    { *m = ExportContactsRequest{} }
+func (m *ExportContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportContactsRequest) ProtoMessage()               {}
+func (*ExportContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *ExportContactsRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ExportContactsRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ExportContactsRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ExportContactsRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportContactsRequest) GetVcardVersion() string {
+	if m != nil {
+		return m.VcardVersion
+	}
+	return ""
+}
+
+type ReadVCardRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// version is the version of the vCard, "3.0" or "4.0" (the default)
+	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *ReadVCardRequest) Reset()                    { *m = ReadVCardRequest{} }
+func (m *ReadVCardRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadVCardRequest) ProtoMessage()               {}
+func (*ReadVCardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *ReadVCardRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReadVCardRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// FileChunk is a part of a file streamed by the server
+type FileChunk struct {
+	// content_type is the media type of the file, e.g. text/csv
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType" json:"content_type,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *FileChunk) Reset()                    { *m = FileChunk{} }
+func (m *FileChunk) String() string            { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()               {}
+func (*FileChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *FileChunk) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *FileChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportOptions struct {
+	// dry_run validates the rows and reports the errors without creating any contact
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	// mapping maps the columns of the CSV header to the columns of the export,
+	// e.g. "E-mail Address" to "primary_email". Columns named like the export
+	// columns don't need to be mapped, the other columns are ignored.
+	Mapping map[string]string `protobuf:"bytes,2,rep,name=mapping" json:"mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// format is the format of the file, "csv" or "vcard". The format is
+	// detected from the content of the file if it is not set.
+	Format string `protobuf:"bytes,3,opt,name=format" json:"format,omitempty"`
+}
+
+func (m *ImportOptions) Reset()                    { *m = ImportOptions{} }
+func (m *ImportOptions) String() string            { return proto.CompactTextString(m) }
+func (*ImportOptions) ProtoMessage()               {}
+func (*ImportOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *ImportOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportOptions) GetMapping() map[string]string {
+	if m != nil {
+		return m.Mapping
+	}
+	return nil
+}
+
+func (m *ImportOptions) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type ImportContactsRequest struct {
+	// options are taken from the first message of the stream
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options" json:"options,omitempty"`
+	// data is the next part of the file, the first row of a CSV file is the header
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImportContactsRequest) Reset()                    { *m = ImportContactsRequest{} }
+func (m *ImportContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportContactsRequest) ProtoMessage()               {}
+func (*ImportContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *ImportContactsRequest) GetOptions() *ImportOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ImportContactsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportContactsResponse struct {
+	// created is the number of created contacts, or the number of contacts
+	// which would be created in the dry-run mode
+	Created int32 `protobuf:"varint,1,opt,name=created" json:"created,omitempty"`
+	// errors describe the rejected rows, the target of an error is the row
+	// number where the header is row 1 or the number of the card in a vCard file
+	Errors []*atlas_rpc1.TargetInfo `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *ImportContactsResponse) Reset()                    { *m = ImportContactsResponse{} }
+func (m *ImportContactsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImportContactsResponse) ProtoMessage()               {}
+func (*ImportContactsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *ImportContactsResponse) GetCreated() int32 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *ImportContactsResponse) GetErrors() []*atlas_rpc1.TargetInfo {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// subject is the "sub" claim of the JWT of the request
+	Subject   string `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId" json:"request_id,omitempty"`
+	// method is the full gRPC method name, e.g. /api.contacts.Contacts/Create
+	Method string `protobuf:"bytes,4,opt,name=method" json:"method,omitempty"`
+	// resource_id identifies the changed profile, group or contact
+	ResourceId string `protobuf:"bytes,5,opt,name=resource_id,json=resourceId" json:"resource_id,omitempty"`
+	// diff maps the names of changed fields to their "before" and "after" values
+	Diff      *gorm_types.JSONValue       `protobuf:"bytes,6,opt,name=diff" json:"diff,omitempty"`
+	CreatedAt *google_protobuf1.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *AuditEvent) Reset()                    { *m = AuditEvent{} }
+func (m *AuditEvent) String() string            { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()               {}
+func (*AuditEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *AuditEvent) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetDiff() *gorm_types.JSONValue {
+	if m != nil {
+		return m.Diff
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventRequest struct {
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListAuditEventRequest) Reset()                    { *m = ListAuditEventRequest{} }
+func (m *ListAuditEventRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditEventRequest) ProtoMessage()               {}
+func (*ListAuditEventRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *ListAuditEventRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListAuditEventRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListAuditEventRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListAuditEventRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	Results []*AuditEvent `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ListAuditEventsResponse) Reset()                    { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()               {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *ListAuditEventsResponse) GetResults() []*AuditEvent {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type WebhookSubscription struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// url receives the events as POST requests with a JSON body
+	Url string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	// event_types selects the delivered events, all the events are delivered if it is empty
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes" json:"event_types,omitempty"`
+	// secret is the key of the HMAC-SHA256 signature of the deliveries, it is
+	// generated if it is not provided and is returned only by Create
+	Secret string `protobuf:"bytes,4,opt,name=secret" json:"secret,omitempty"`
+	// disabled subscriptions don't receive any events
+	Disabled bool `protobuf:"varint,5,opt,name=disabled" json:"disabled,omitempty"`
+}
+
+func (m *WebhookSubscription) Reset()                    { *m = WebhookSubscription{} }
+func (m *WebhookSubscription) String() string            { return proto.CompactTextString(m) }
+func (*WebhookSubscription) ProtoMessage()               {}
+func (*WebhookSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *WebhookSubscription) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WebhookSubscription) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookSubscription) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *WebhookSubscription) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *WebhookSubscription) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	Id             *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	SubscriptionId *atlas_rpc.Identifier `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId" json:"subscription_id,omitempty"`
+	EventType      string                `protobuf:"bytes,3,opt,name=event_type,json=eventType" json:"event_type,omitempty"`
+	// payload is the JSON body posted to the subscription url
+	Payload *gorm_types.JSONValue  `protobuf:"bytes,4,opt,name=payload" json:"payload,omitempty"`
+	Status  WebhookDelivery_Status `protobuf:"varint,5,opt,name=status,enum=api.contacts.WebhookDelivery_Status" json:"status,omitempty"`
+	// attempts is the number of failed and succeeded attempts to deliver the event
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts" json:"attempts,omitempty"`
+	// last_status_code is the HTTP status code returned by the last attempt
+	LastStatusCode int32 `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode" json:"last_status_code,omitempty"`
+	// last_error describes why the last attempt failed
+	LastError     string                      `protobuf:"bytes,8,opt,name=last_error,json=lastError" json:"last_error,omitempty"`
+	NextAttemptAt *google_protobuf1.Timestamp `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt" json:"next_attempt_at,omitempty"`
+	CreatedAt     *google_protobuf1.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+}
+
+func (m *WebhookDelivery) Reset()                    { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()               {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *WebhookDelivery) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetSubscriptionId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetPayload() *gorm_types.JSONValue {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetStatus() WebhookDelivery_Status {
+	if m != nil {
+		return m.Status
+	}
+	return WebhookDelivery_PENDING
+}
+
+func (m *WebhookDelivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetLastStatusCode() int32 {
+	if m != nil {
+		return m.LastStatusCode
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetNextAttemptAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	Payload *WebhookSubscription `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
+}
+
+func (m *CreateWebhookSubscriptionRequest) Reset()         { *m = CreateWebhookSubscriptionRequest{} }
+func (m *CreateWebhookSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWebhookSubscriptionRequest) ProtoMessage()    {}
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{85}
+}
+
+func (m *CreateWebhookSubscriptionRequest) GetPayload() *WebhookSubscription {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	Result *WebhookSubscription `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *CreateWebhookSubscriptionResponse) Reset()         { *m = CreateWebhookSubscriptionResponse{} }
+func (m *CreateWebhookSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateWebhookSubscriptionResponse) ProtoMessage()    {}
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{86}
+}
+
+func (m *CreateWebhookSubscriptionResponse) GetResult() *WebhookSubscription {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ReadWebhookSubscriptionRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ReadWebhookSubscriptionRequest) Reset()         { *m = ReadWebhookSubscriptionRequest{} }
+func (m *ReadWebhookSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadWebhookSubscriptionRequest) ProtoMessage()    {}
+func (*ReadWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{87}
+}
+
+func (m *ReadWebhookSubscriptionRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type ReadWebhookSubscriptionResponse struct {
+	Result *WebhookSubscription `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ReadWebhookSubscriptionResponse) Reset()         { *m = ReadWebhookSubscriptionResponse{} }
+func (m *ReadWebhookSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadWebhookSubscriptionResponse) ProtoMessage()    {}
+func (*ReadWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{88}
+}
+
+func (m *ReadWebhookSubscriptionResponse) GetResult() *WebhookSubscription {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateWebhookSubscriptionRequest struct {
+	Payload *WebhookSubscription `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
+}
+
+func (m *UpdateWebhookSubscriptionRequest) Reset()         { *m = UpdateWebhookSubscriptionRequest{} }
+func (m *UpdateWebhookSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateWebhookSubscriptionRequest) ProtoMessage()    {}
+func (*UpdateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{89}
+}
+
+func (m *UpdateWebhookSubscriptionRequest) GetPayload() *WebhookSubscription {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type UpdateWebhookSubscriptionResponse struct {
+	Result *WebhookSubscription `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *UpdateWebhookSubscriptionResponse) Reset()         { *m = UpdateWebhookSubscriptionResponse{} }
+func (m *UpdateWebhookSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateWebhookSubscriptionResponse) ProtoMessage()    {}
+func (*UpdateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{90}
+}
+
+func (m *UpdateWebhookSubscriptionResponse) GetResult() *WebhookSubscription {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteWebhookSubscriptionRequest) Reset()         { *m = DeleteWebhookSubscriptionRequest{} }
+func (m *DeleteWebhookSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage()    {}
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{91}
+}
+
+func (m *DeleteWebhookSubscriptionRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+}
+
+func (m *DeleteWebhookSubscriptionResponse) Reset()         { *m = DeleteWebhookSubscriptionResponse{} }
+func (m *DeleteWebhookSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage()    {}
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{92}
+}
+
+type ListWebhookSubscriptionRequest struct {
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListWebhookSubscriptionRequest) Reset()         { *m = ListWebhookSubscriptionRequest{} }
+func (m *ListWebhookSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookSubscriptionRequest) ProtoMessage()    {}
+func (*ListWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{93}
+}
+
+func (m *ListWebhookSubscriptionRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListWebhookSubscriptionRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListWebhookSubscriptionRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListWebhookSubscriptionRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	Results []*WebhookSubscription `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ListWebhookSubscriptionsResponse) Reset()         { *m = ListWebhookSubscriptionsResponse{} }
+func (m *ListWebhookSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookSubscriptionsResponse) ProtoMessage()    {}
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{94}
+}
+
+func (m *ListWebhookSubscriptionsResponse) GetResults() []*WebhookSubscription {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ListWebhookDeliveryRequest struct {
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListWebhookDeliveryRequest) Reset()                    { *m = ListWebhookDeliveryRequest{} }
+func (m *ListWebhookDeliveryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListWebhookDeliveryRequest) ProtoMessage()               {}
+func (*ListWebhookDeliveryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *ListWebhookDeliveryRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListWebhookDeliveryRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListWebhookDeliveryRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListWebhookDeliveryRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Results []*WebhookDelivery `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ListWebhookDeliveriesResponse) Reset()                    { *m = ListWebhookDeliveriesResponse{} }
+func (m *ListWebhookDeliveriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListWebhookDeliveriesResponse) ProtoMessage()               {}
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *ListWebhookDeliveriesResponse) GetResults() []*WebhookDelivery {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// SavedSearch is a named set of the collection operators of the list of
+// contacts which is run by Contacts.RunSavedSearch
+type SavedSearch struct {
+	Id   *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// profile_id assigns the search to a profile, the searches without a
+	// profile are shared by the whole account
+	ProfileId *atlas_rpc.Identifier `protobuf:"bytes,3,opt,name=profile_id,json=profileId" json:"profile_id,omitempty"`
+	// filter, order_by and fields have the syntax of the _filter, _order_by
+	// and _fields parameters of the list of contacts
+	Filter  string `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty"`
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  string `protobuf:"bytes,6,opt,name=fields" json:"fields,omitempty"`
+}
+
+func (m *SavedSearch) Reset()                    { *m = SavedSearch{} }
+func (m *SavedSearch) String() string            { return proto.CompactTextString(m) }
+func (*SavedSearch) ProtoMessage()               {}
+func (*SavedSearch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *SavedSearch) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *SavedSearch) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetProfileId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.ProfileId
+	}
+	return nil
+}
+
+func (m *SavedSearch) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *SavedSearch) GetFields() string {
+	if m != nil {
+		return m.Fields
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	Payload *SavedSearch `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
+}
+
+func (m *CreateSavedSearchRequest) Reset()                    { *m = CreateSavedSearchRequest{} }
+func (m *CreateSavedSearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSavedSearchRequest) ProtoMessage()               {}
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *CreateSavedSearchRequest) GetPayload() *SavedSearch {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type CreateSavedSearchResponse struct {
+	Result *SavedSearch `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *CreateSavedSearchResponse) Reset()                    { *m = CreateSavedSearchResponse{} }
+func (m *CreateSavedSearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateSavedSearchResponse) ProtoMessage()               {}
+func (*CreateSavedSearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+func (m *CreateSavedSearchResponse) GetResult() *SavedSearch {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ReadSavedSearchRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ReadSavedSearchRequest) Reset()                    { *m = ReadSavedSearchRequest{} }
+func (m *ReadSavedSearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadSavedSearchRequest) ProtoMessage()               {}
+func (*ReadSavedSearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *ReadSavedSearchRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type ReadSavedSearchResponse struct {
+	Result *SavedSearch `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *ReadSavedSearchResponse) Reset()                    { *m = ReadSavedSearchResponse{} }
+func (m *ReadSavedSearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReadSavedSearchResponse) ProtoMessage()               {}
+func (*ReadSavedSearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *ReadSavedSearchResponse) GetResult() *SavedSearch {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type UpdateSavedSearchRequest struct {
+	Payload *SavedSearch `protobuf:"bytes,1,opt,name=payload" json:"payload,omitempty"`
+}
+
+func (m *UpdateSavedSearchRequest) Reset()                    { *m = UpdateSavedSearchRequest{} }
+func (m *UpdateSavedSearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateSavedSearchRequest) ProtoMessage()               {}
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *UpdateSavedSearchRequest) GetPayload() *SavedSearch {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type UpdateSavedSearchResponse struct {
+	Result *SavedSearch `protobuf:"bytes,1,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *UpdateSavedSearchResponse) Reset()                    { *m = UpdateSavedSearchResponse{} }
+func (m *UpdateSavedSearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateSavedSearchResponse) ProtoMessage()               {}
+func (*UpdateSavedSearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+func (m *UpdateSavedSearchResponse) GetResult() *SavedSearch {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteSavedSearchRequest) Reset()                    { *m = DeleteSavedSearchRequest{} }
+func (m *DeleteSavedSearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSavedSearchRequest) ProtoMessage()               {}
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func (m *DeleteSavedSearchRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type DeleteSavedSearchResponse struct {
+}
+
+func (m *DeleteSavedSearchResponse) Reset()                    { *m = DeleteSavedSearchResponse{} }
+func (m *DeleteSavedSearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSavedSearchResponse) ProtoMessage()               {}
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+
+type ListSavedSearchRequest struct {
+	Filter  *infoblox_api.Filtering      `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	OrderBy *infoblox_api.Sorting        `protobuf:"bytes,2,opt,name=order_by,json=orderBy" json:"order_by,omitempty"`
+	Fields  *infoblox_api.FieldSelection `protobuf:"bytes,3,opt,name=fields" json:"fields,omitempty"`
+	Paging  *infoblox_api.Pagination     `protobuf:"bytes,4,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *ListSavedSearchRequest) Reset()                    { *m = ListSavedSearchRequest{} }
+func (m *ListSavedSearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSavedSearchRequest) ProtoMessage()               {}
+func (*ListSavedSearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *ListSavedSearchRequest) GetFilter() *infoblox_api.Filtering {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListSavedSearchRequest) GetOrderBy() *infoblox_api.Sorting {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *ListSavedSearchRequest) GetFields() *infoblox_api.FieldSelection {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListSavedSearchRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+type ListSavedSearchesResponse struct {
+	Results []*SavedSearch `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *ListSavedSearchesResponse) Reset()                    { *m = ListSavedSearchesResponse{} }
+func (m *ListSavedSearchesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListSavedSearchesResponse) ProtoMessage()               {}
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+func (m *ListSavedSearchesResponse) GetResults() []*SavedSearch {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type RunSavedSearchRequest struct {
+	Id *atlas_rpc.Identifier `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// paging pages the results like the paging of the list of contacts,
+	// page tokens are supported as well
+	Paging *infoblox_api.Pagination `protobuf:"bytes,2,opt,name=paging" json:"paging,omitempty"`
+}
+
+func (m *RunSavedSearchRequest) Reset()                    { *m = RunSavedSearchRequest{} }
+func (m *RunSavedSearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*RunSavedSearchRequest) ProtoMessage()               {}
+func (*RunSavedSearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+func (m *RunSavedSearchRequest) GetId() *atlas_rpc.Identifier {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *RunSavedSearchRequest) GetPaging() *infoblox_api.Pagination {
+	if m != nil {
+		return m.Paging
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Profile)(nil), "api.contacts.Profile")
+	proto.RegisterType((*CreateProfileRequest)(nil), "api.contacts.CreateProfileRequest")
+	proto.RegisterType((*CreateProfileResponse)(nil), "api.contacts.CreateProfileResponse")
+	proto.RegisterType((*ReadProfileRequest)(nil), "api.contacts.ReadProfileRequest")
+	proto.RegisterType((*ReadProfileResponse)(nil), "api.contacts.ReadProfileResponse")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "api.contacts.UpdateProfileRequest")
+	proto.RegisterType((*UpdateProfileResponse)(nil), "api.contacts.UpdateProfileResponse")
+	proto.RegisterType((*DeleteProfileRequest)(nil), "api.contacts.DeleteProfileRequest")
+	proto.RegisterType((*DeleteProfileResponse)(nil), "api.contacts.DeleteProfileResponse")
+	proto.RegisterType((*UndeleteProfileRequest)(nil), "api.contacts.UndeleteProfileRequest")
+	proto.RegisterType((*UndeleteProfileResponse)(nil), "api.contacts.UndeleteProfileResponse")
+	proto.RegisterType((*ListProfileRequest)(nil), "api.contacts.ListProfileRequest")
+	proto.RegisterType((*ListProfilesResponse)(nil), "api.contacts.ListProfilesResponse")
+	proto.RegisterType((*ListProfileContactsRequest)(nil), "api.contacts.ListProfileContactsRequest")
+	proto.RegisterType((*ListProfileGroupsRequest)(nil), "api.contacts.ListProfileGroupsRequest")
+	proto.RegisterType((*CreateProfileContactRequest)(nil), "api.contacts.CreateProfileContactRequest")
+	proto.RegisterType((*Group)(nil), "api.contacts.Group")
+	proto.RegisterType((*CreateGroupRequest)(nil), "api.contacts.CreateGroupRequest")
+	proto.RegisterType((*CreateGroupResponse)(nil), "api.contacts.CreateGroupResponse")
+	proto.RegisterType((*ReadGroupRequest)(nil), "api.contacts.ReadGroupRequest")
+	proto.RegisterType((*ReadGroupResponse)(nil), "api.contacts.ReadGroupResponse")
+	proto.RegisterType((*UpdateGroupRequest)(nil), "api.contacts.UpdateGroupRequest")
+	proto.RegisterType((*UpdateGroupResponse)(nil), "api.contacts.UpdateGroupResponse")
+	proto.RegisterType((*DeleteGroupRequest)(nil), "api.contacts.DeleteGroupRequest")
+	proto.RegisterType((*DeleteGroupResponse)(nil), "api.contacts.DeleteGroupResponse")
+	proto.RegisterType((*UndeleteGroupRequest)(nil), "api.contacts.UndeleteGroupRequest")
+	proto.RegisterType((*UndeleteGroupResponse)(nil), "api.contacts.UndeleteGroupResponse")
+	proto.RegisterType((*ListGroupRequest)(nil), "api.contacts.ListGroupRequest")
+	proto.RegisterType((*ListGroupsResponse)(nil), "api.contacts.ListGroupsResponse")
+	proto.RegisterType((*AddGroupContactsRequest)(nil), "api.contacts.AddGroupContactsRequest")
+	proto.RegisterType((*AddGroupContactsResponse)(nil), "api.contacts.AddGroupContactsResponse")
+	proto.RegisterType((*RemoveGroupContactsRequest)(nil), "api.contacts.RemoveGroupContactsRequest")
+	proto.RegisterType((*RemoveGroupContactsResponse)(nil), "api.contacts.RemoveGroupContactsResponse")
+	proto.RegisterType((*ListGroupMembersRequest)(nil), "api.contacts.ListGroupMembersRequest")
+	proto.RegisterType((*ListGroupMembersResponse)(nil), "api.contacts.ListGroupMembersResponse")
+	proto.RegisterType((*Contact)(nil), "api.contacts.Contact")
+	proto.RegisterType((*Email)(nil), "api.contacts.Email")
+	proto.RegisterType((*PhoneNumber)(nil), "api.contacts.PhoneNumber")
+	proto.RegisterType((*Address)(nil), "api.contacts.Address")
+	proto.RegisterType((*CreateContactRequest)(nil), "api.contacts.CreateContactRequest")
+	proto.RegisterType((*CreateContactResponse)(nil), "api.contacts.CreateContactResponse")
+	proto.RegisterType((*ReadContactRequest)(nil), "api.contacts.ReadContactRequest")
+	proto.RegisterType((*ReadContactResponse)(nil), "api.contacts.ReadContactResponse")
+	proto.RegisterType((*UpdateContactRequest)(nil), "api.contacts.UpdateContactRequest")
+	proto.RegisterType((*UpdateContactResponse)(nil), "api.contacts.UpdateContactResponse")
+	proto.RegisterType((*DeleteContactRequest)(nil), "api.contacts.DeleteContactRequest")
+	proto.RegisterType((*DeleteContactResponse)(nil), "api.contacts.DeleteContactResponse")
+	proto.RegisterType((*UndeleteContactRequest)(nil), "api.contacts.UndeleteContactRequest")
+	proto.RegisterType((*UndeleteContactResponse)(nil), "api.contacts.UndeleteContactResponse")
+	proto.RegisterType((*ListContactsResponse)(nil), "api.contacts.ListContactsResponse")
+	proto.RegisterType((*SMSRequest)(nil), "api.contacts.SMSRequest")
+	proto.RegisterType((*SMSResponse)(nil), "api.contacts.SMSResponse")
+	proto.RegisterType((*SyncContactsRequest)(nil), "api.contacts.SyncContactsRequest")
+	proto.RegisterType((*SyncContactsResponse)(nil), "api.contacts.SyncContactsResponse")
+	proto.RegisterType((*WatchContactsRequest)(nil), "api.contacts.WatchContactsRequest")
+	proto.RegisterType((*ContactEvent)(nil), "api.contacts.ContactEvent")
+	proto.RegisterType((*SearchContactsRequest)(nil), "api.contacts.SearchContactsRequest")
+	proto.RegisterType((*ContactSearchResult)(nil), "api.contacts.ContactSearchResult")
+	proto.RegisterType((*SearchContactsResponse)(nil), "api.contacts.SearchContactsResponse")
+	proto.RegisterType((*SuggestContactsRequest)(nil), "api.contacts.SuggestContactsRequest")
+	proto.RegisterType((*ContactSuggestion)(nil), "api.contacts.ContactSuggestion")
+	proto.RegisterType((*SuggestContactsResponse)(nil), "api.contacts.SuggestContactsResponse")
+	proto.RegisterType((*FindDuplicatesRequest)(nil), "api.contacts.FindDuplicatesRequest")
+	proto.RegisterType((*DuplicateGroup)(nil), "api.contacts.DuplicateGroup")
+	proto.RegisterType((*FindDuplicatesResponse)(nil), "api.contacts.FindDuplicatesResponse")
+	proto.RegisterType((*MergeContactsRequest)(nil), "api.contacts.MergeContactsRequest")
+	proto.RegisterType((*MergeContactsResponse)(nil), "api.contacts.MergeContactsResponse")
+	proto.RegisterType((*ListContactRequest)(nil), "api.contacts.ListContactRequest")
+	proto.RegisterType((*BatchCreateContactsRequest)(nil), "api.contacts.BatchCreateContactsRequest")
+	proto.RegisterType((*BatchCreateContactsResponse)(nil), "api.contacts.BatchCreateContactsResponse")
+	proto.RegisterType((*BatchUpdateContactsRequest)(nil), "api.contacts.BatchUpdateContactsRequest")
+	proto.RegisterType((*BatchUpdateContactsResponse)(nil), "api.contacts.BatchUpdateContactsResponse")
+	proto.RegisterType((*BatchDeleteContactsRequest)(nil), "api.contacts.BatchDeleteContactsRequest")
+	proto.RegisterType((*BatchDeleteContactsResponse)(nil), "api.contacts.BatchDeleteContactsResponse")
+	proto.RegisterType((*ExportContactsRequest)(nil), "api.contacts.ExportContactsRequest")
+	proto.RegisterType((*ReadVCardRequest)(nil), "api.contacts.ReadVCardRequest")
+	proto.RegisterType((*FileChunk)(nil), "api.contacts.FileChunk")
+	proto.RegisterType((*ImportOptions)(nil), "api.contacts.ImportOptions")
+	proto.RegisterType((*ImportContactsRequest)(nil), "api.contacts.ImportContactsRequest")
+	proto.RegisterType((*ImportContactsResponse)(nil), "api.contacts.ImportContactsResponse")
+	proto.RegisterType((*AuditEvent)(nil), "api.contacts.AuditEvent")
+	proto.RegisterType((*ListAuditEventRequest)(nil), "api.contacts.ListAuditEventRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "api.contacts.ListAuditEventsResponse")
+	proto.RegisterType((*WebhookSubscription)(nil), "api.contacts.WebhookSubscription")
+	proto.RegisterType((*WebhookDelivery)(nil), "api.contacts.WebhookDelivery")
+	proto.RegisterType((*CreateWebhookSubscriptionRequest)(nil), "api.contacts.CreateWebhookSubscriptionRequest")
+	proto.RegisterType((*CreateWebhookSubscriptionResponse)(nil), "api.contacts.CreateWebhookSubscriptionResponse")
+	proto.RegisterType((*ReadWebhookSubscriptionRequest)(nil), "api.contacts.ReadWebhookSubscriptionRequest")
+	proto.RegisterType((*ReadWebhookSubscriptionResponse)(nil), "api.contacts.ReadWebhookSubscriptionResponse")
+	proto.RegisterType((*UpdateWebhookSubscriptionRequest)(nil), "api.contacts.UpdateWebhookSubscriptionRequest")
+	proto.RegisterType((*UpdateWebhookSubscriptionResponse)(nil), "api.contacts.UpdateWebhookSubscriptionResponse")
+	proto.RegisterType((*DeleteWebhookSubscriptionRequest)(nil), "api.contacts.DeleteWebhookSubscriptionRequest")
+	proto.RegisterType((*DeleteWebhookSubscriptionResponse)(nil), "api.contacts.DeleteWebhookSubscriptionResponse")
+	proto.RegisterType((*ListWebhookSubscriptionRequest)(nil), "api.contacts.ListWebhookSubscriptionRequest")
+	proto.RegisterType((*ListWebhookSubscriptionsResponse)(nil), "api.contacts.ListWebhookSubscriptionsResponse")
+	proto.RegisterType((*ListWebhookDeliveryRequest)(nil), "api.contacts.ListWebhookDeliveryRequest")
+	proto.RegisterType((*ListWebhookDeliveriesResponse)(nil), "api.contacts.ListWebhookDeliveriesResponse")
+	proto.RegisterType((*SavedSearch)(nil), "api.contacts.SavedSearch")
+	proto.RegisterType((*CreateSavedSearchRequest)(nil), "api.contacts.CreateSavedSearchRequest")
+	proto.RegisterType((*CreateSavedSearchResponse)(nil), "api.contacts.CreateSavedSearchResponse")
+	proto.RegisterType((*ReadSavedSearchRequest)(nil), "api.contacts.ReadSavedSearchRequest")
+	proto.RegisterType((*ReadSavedSearchResponse)(nil), "api.contacts.ReadSavedSearchResponse")
+	proto.RegisterType((*UpdateSavedSearchRequest)(nil), "api.contacts.UpdateSavedSearchRequest")
+	proto.RegisterType((*UpdateSavedSearchResponse)(nil), "api.contacts.UpdateSavedSearchResponse")
+	proto.RegisterType((*DeleteSavedSearchRequest)(nil), "api.contacts.DeleteSavedSearchRequest")
+	proto.RegisterType((*DeleteSavedSearchResponse)(nil), "api.contacts.DeleteSavedSearchResponse")
+	proto.RegisterType((*ListSavedSearchRequest)(nil), "api.contacts.ListSavedSearchRequest")
+	proto.RegisterType((*ListSavedSearchesResponse)(nil), "api.contacts.ListSavedSearchesResponse")
+	proto.RegisterType((*RunSavedSearchRequest)(nil), "api.contacts.RunSavedSearchRequest")
+	proto.RegisterEnum("api.contacts.BatchMode", BatchMode_name, BatchMode_value)
+	proto.RegisterEnum("api.contacts.PhoneNumber_Type", PhoneNumber_Type_name, PhoneNumber_Type_value)
+	proto.RegisterEnum("api.contacts.ContactEvent_Type", ContactEvent_Type_name, ContactEvent_Type_value)
+	proto.RegisterEnum("api.contacts.WebhookDelivery_Status", WebhookDelivery_Status_name, WebhookDelivery_Status_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
-// Client API for Profiles service
+// Client API for Profiles service
+
+type ProfilesClient interface {
+	Create(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
+	Read(ctx context.Context, in *ReadProfileRequest, opts ...grpc.CallOption) (*ReadProfileResponse, error)
+	Update(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	Delete(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error)
+	Undelete(ctx context.Context, in *UndeleteProfileRequest, opts ...grpc.CallOption) (*UndeleteProfileResponse, error)
+	List(ctx context.Context, in *ListProfileRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	// ListContacts lists the contacts of the profile
+	ListContacts(ctx context.Context, in *ListProfileContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	// ListGroups lists the groups of the profile
+	ListGroups(ctx context.Context, in *ListProfileGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	// CreateContact creates a contact of the profile
+	CreateContact(ctx context.Context, in *CreateProfileContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error)
+}
+
+type profilesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProfilesClient(cc *grpc.ClientConn) ProfilesClient {
+	return &profilesClient{cc}
+}
+
+func (c *profilesClient) Create(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
+	out := new(CreateProfileResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) Read(ctx context.Context, in *ReadProfileRequest, opts ...grpc.CallOption) (*ReadProfileResponse, error) {
+	out := new(ReadProfileResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) Update(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	out := new(UpdateProfileResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) Delete(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error) {
+	out := new(DeleteProfileResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) Undelete(ctx context.Context, in *UndeleteProfileRequest, opts ...grpc.CallOption) (*UndeleteProfileResponse, error) {
+	out := new(UndeleteProfileResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Undelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) List(ctx context.Context, in *ListProfileRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
+	out := new(ListProfilesResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) ListContacts(ctx context.Context, in *ListProfileContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	out := new(ListContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/ListContacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) ListGroups(ctx context.Context, in *ListProfileGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	out := new(ListGroupsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/ListGroups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesClient) CreateContact(ctx context.Context, in *CreateProfileContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error) {
+	out := new(CreateContactResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Profiles/CreateContact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Profiles service
+
+type ProfilesServer interface {
+	Create(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
+	Read(context.Context, *ReadProfileRequest) (*ReadProfileResponse, error)
+	Update(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	Delete(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
+	Undelete(context.Context, *UndeleteProfileRequest) (*UndeleteProfileResponse, error)
+	List(context.Context, *ListProfileRequest) (*ListProfilesResponse, error)
+	// ListContacts lists the contacts of the profile
+	ListContacts(context.Context, *ListProfileContactsRequest) (*ListContactsResponse, error)
+	// ListGroups lists the groups of the profile
+	ListGroups(context.Context, *ListProfileGroupsRequest) (*ListGroupsResponse, error)
+	// CreateContact creates a contact of the profile
+	CreateContact(context.Context, *CreateProfileContactRequest) (*CreateContactResponse, error)
+}
+
+func RegisterProfilesServer(s *grpc.Server, srv ProfilesServer) {
+	s.RegisterService(&_Profiles_serviceDesc, srv)
+}
+
+func _Profiles_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).Create(ctx, req.(*CreateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).Read(ctx, req.(*ReadProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).Update(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).Delete(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/Undelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).Undelete(ctx, req.(*UndeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).List(ctx, req.(*ListProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/ListContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).ListContacts(ctx, req.(*ListProfileContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).ListGroups(ctx, req.(*ListProfileGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profiles_CreateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).CreateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Profiles/CreateContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).CreateContact(ctx, req.(*CreateProfileContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Profiles_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.contacts.Profiles",
+	HandlerType: (*ProfilesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Profiles_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Profiles_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Profiles_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Profiles_Delete_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _Profiles_Undelete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Profiles_List_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _Profiles_ListContacts_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _Profiles_ListGroups_Handler,
+		},
+		{
+			MethodName: "CreateContact",
+			Handler:    _Profiles_CreateContact_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/pb/contacts.proto",
+}
+
+// Client API for Groups service
+
+type GroupsClient interface {
+	Create(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	Read(ctx context.Context, in *ReadGroupRequest, opts ...grpc.CallOption) (*ReadGroupResponse, error)
+	Update(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
+	Delete(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	Undelete(ctx context.Context, in *UndeleteGroupRequest, opts ...grpc.CallOption) (*UndeleteGroupResponse, error)
+	List(ctx context.Context, in *ListGroupRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	// AddContacts makes the contacts members of the group without sending
+	// the other members
+	AddContacts(ctx context.Context, in *AddGroupContactsRequest, opts ...grpc.CallOption) (*AddGroupContactsResponse, error)
+	RemoveContacts(ctx context.Context, in *RemoveGroupContactsRequest, opts ...grpc.CallOption) (*RemoveGroupContactsResponse, error)
+	ListMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error)
+}
+
+type groupsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGroupsClient(cc *grpc.ClientConn) GroupsClient {
+	return &groupsClient{cc}
+}
+
+func (c *groupsClient) Create(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	out := new(CreateGroupResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) Read(ctx context.Context, in *ReadGroupRequest, opts ...grpc.CallOption) (*ReadGroupResponse, error) {
+	out := new(ReadGroupResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) Update(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error) {
+	out := new(UpdateGroupResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) Delete(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	out := new(DeleteGroupResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) Undelete(ctx context.Context, in *UndeleteGroupRequest, opts ...grpc.CallOption) (*UndeleteGroupResponse, error) {
+	out := new(UndeleteGroupResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/Undelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) List(ctx context.Context, in *ListGroupRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	out := new(ListGroupsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) AddContacts(ctx context.Context, in *AddGroupContactsRequest, opts ...grpc.CallOption) (*AddGroupContactsResponse, error) {
+	out := new(AddGroupContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/AddContacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) RemoveContacts(ctx context.Context, in *RemoveGroupContactsRequest, opts ...grpc.CallOption) (*RemoveGroupContactsResponse, error) {
+	out := new(RemoveGroupContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/RemoveContacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) ListMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error) {
+	out := new(ListGroupMembersResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Groups/ListMembers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Groups service
+
+type GroupsServer interface {
+	Create(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	Read(context.Context, *ReadGroupRequest) (*ReadGroupResponse, error)
+	Update(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	Delete(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	Undelete(context.Context, *UndeleteGroupRequest) (*UndeleteGroupResponse, error)
+	List(context.Context, *ListGroupRequest) (*ListGroupsResponse, error)
+	// AddContacts makes the contacts members of the group without sending
+	// the other members
+	AddContacts(context.Context, *AddGroupContactsRequest) (*AddGroupContactsResponse, error)
+	RemoveContacts(context.Context, *RemoveGroupContactsRequest) (*RemoveGroupContactsResponse, error)
+	ListMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error)
+}
+
+func RegisterGroupsServer(s *grpc.Server, srv GroupsServer) {
+	s.RegisterService(&_Groups_serviceDesc, srv)
+}
+
+func _Groups_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).Create(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).Read(ctx, req.(*ReadGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).Update(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).Delete(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/Undelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).Undelete(ctx, req.(*UndeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).List(ctx, req.(*ListGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_AddContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).AddContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/AddContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).AddContacts(ctx, req.(*AddGroupContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_RemoveContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).RemoveContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/RemoveContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).RemoveContacts(ctx, req.(*RemoveGroupContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Groups/ListMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ListMembers(ctx, req.(*ListGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Groups_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.contacts.Groups",
+	HandlerType: (*GroupsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Groups_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Groups_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Groups_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Groups_Delete_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _Groups_Undelete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Groups_List_Handler,
+		},
+		{
+			MethodName: "AddContacts",
+			Handler:    _Groups_AddContacts_Handler,
+		},
+		{
+			MethodName: "RemoveContacts",
+			Handler:    _Groups_RemoveContacts_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _Groups_ListMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/pb/contacts.proto",
+}
+
+// Client API for Contacts service
+
+type ContactsClient interface {
+	Create(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error)
+	Read(ctx context.Context, in *ReadContactRequest, opts ...grpc.CallOption) (*ReadContactResponse, error)
+	Update(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
+	Delete(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
+	Undelete(ctx context.Context, in *UndeleteContactRequest, opts ...grpc.CallOption) (*UndeleteContactResponse, error)
+	List(ctx context.Context, in *ListContactRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	SendSMS(ctx context.Context, in *SMSRequest, opts ...grpc.CallOption) (*SMSResponse, error)
+	Sync(ctx context.Context, in *SyncContactsRequest, opts ...grpc.CallOption) (*SyncContactsResponse, error)
+	Search(ctx context.Context, in *SearchContactsRequest, opts ...grpc.CallOption) (*SearchContactsResponse, error)
+	Suggest(ctx context.Context, in *SuggestContactsRequest, opts ...grpc.CallOption) (*SuggestContactsResponse, error)
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error)
+	// Merge merges the contacts into the survivor in one transaction. The
+	// survivor gets the e-mail addresses, phone numbers, groups, nicknames and
+	// notes of all of them and the addresses and names it doesn't have.
+	Merge(ctx context.Context, in *MergeContactsRequest, opts ...grpc.CallOption) (*MergeContactsResponse, error)
+	// RunSavedSearch lists the contacts with the collection operators of the
+	// saved search
+	RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	Watch(ctx context.Context, in *WatchContactsRequest, opts ...grpc.CallOption) (Contacts_WatchClient, error)
+	BatchCreate(ctx context.Context, in *BatchCreateContactsRequest, opts ...grpc.CallOption) (*BatchCreateContactsResponse, error)
+	BatchUpdate(ctx context.Context, in *BatchUpdateContactsRequest, opts ...grpc.CallOption) (*BatchUpdateContactsResponse, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteContactsRequest, opts ...grpc.CallOption) (*BatchDeleteContactsResponse, error)
+	Export(ctx context.Context, in *ExportContactsRequest, opts ...grpc.CallOption) (Contacts_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (Contacts_ImportClient, error)
+	// ReadVCard returns the contact as a vCard. The gateway serves it at
+	// /contacts/{id}.vcf as well.
+	ReadVCard(ctx context.Context, in *ReadVCardRequest, opts ...grpc.CallOption) (*FileChunk, error)
+}
+
+type contactsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewContactsClient(cc *grpc.ClientConn) ContactsClient {
+	return &contactsClient{cc}
+}
+
+func (c *contactsClient) Create(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error) {
+	out := new(CreateContactResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Read(ctx context.Context, in *ReadContactRequest, opts ...grpc.CallOption) (*ReadContactResponse, error) {
+	out := new(ReadContactResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Update(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
+	out := new(UpdateContactResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Delete(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
+	out := new(DeleteContactResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Undelete(ctx context.Context, in *UndeleteContactRequest, opts ...grpc.CallOption) (*UndeleteContactResponse, error) {
+	out := new(UndeleteContactResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Undelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) List(ctx context.Context, in *ListContactRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	out := new(ListContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) SendSMS(ctx context.Context, in *SMSRequest, opts ...grpc.CallOption) (*SMSResponse, error) {
+	out := new(SMSResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/SendSMS", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Sync(ctx context.Context, in *SyncContactsRequest, opts ...grpc.CallOption) (*SyncContactsResponse, error) {
+	out := new(SyncContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Sync", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Search(ctx context.Context, in *SearchContactsRequest, opts ...grpc.CallOption) (*SearchContactsResponse, error) {
+	out := new(SearchContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Suggest(ctx context.Context, in *SuggestContactsRequest, opts ...grpc.CallOption) (*SuggestContactsResponse, error) {
+	out := new(SuggestContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Suggest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*FindDuplicatesResponse, error) {
+	out := new(FindDuplicatesResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/FindDuplicates", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Merge(ctx context.Context, in *MergeContactsRequest, opts ...grpc.CallOption) (*MergeContactsResponse, error) {
+	out := new(MergeContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Merge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	out := new(ListContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/RunSavedSearch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Watch(ctx context.Context, in *WatchContactsRequest, opts ...grpc.CallOption) (Contacts_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Contacts_serviceDesc.Streams[0], c.cc, "/api.contacts.Contacts/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contactsWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contacts_WatchClient interface {
+	Recv() (*ContactEvent, error)
+	grpc.ClientStream
+}
+
+type contactsWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *contactsWatchClient) Recv() (*ContactEvent, error) {
+	m := new(ContactEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contactsClient) BatchCreate(ctx context.Context, in *BatchCreateContactsRequest, opts ...grpc.CallOption) (*BatchCreateContactsResponse, error) {
+	out := new(BatchCreateContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/BatchCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) BatchUpdate(ctx context.Context, in *BatchUpdateContactsRequest, opts ...grpc.CallOption) (*BatchUpdateContactsResponse, error) {
+	out := new(BatchUpdateContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/BatchUpdate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) BatchDelete(ctx context.Context, in *BatchDeleteContactsRequest, opts ...grpc.CallOption) (*BatchDeleteContactsResponse, error) {
+	out := new(BatchDeleteContactsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/BatchDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactsClient) Export(ctx context.Context, in *ExportContactsRequest, opts ...grpc.CallOption) (Contacts_ExportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Contacts_serviceDesc.Streams[1], c.cc, "/api.contacts.Contacts/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contactsExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Contacts_ExportClient interface {
+	Recv() (*FileChunk, error)
+	grpc.ClientStream
+}
+
+type contactsExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *contactsExportClient) Recv() (*FileChunk, error) {
+	m := new(FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contactsClient) Import(ctx context.Context, opts ...grpc.CallOption) (Contacts_ImportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Contacts_serviceDesc.Streams[2], c.cc, "/api.contacts.Contacts/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contactsImportClient{stream}
+	return x, nil
+}
+
+type Contacts_ImportClient interface {
+	Send(*ImportContactsRequest) error
+	CloseAndRecv() (*ImportContactsResponse, error)
+	grpc.ClientStream
+}
+
+type contactsImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *contactsImportClient) Send(m *ImportContactsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *contactsImportClient) CloseAndRecv() (*ImportContactsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportContactsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contactsClient) ReadVCard(ctx context.Context, in *ReadVCardRequest, opts ...grpc.CallOption) (*FileChunk, error) {
+	out := new(FileChunk)
+	err := grpc.Invoke(ctx, "/api.contacts.Contacts/ReadVCard", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Contacts service
+
+type ContactsServer interface {
+	Create(context.Context, *CreateContactRequest) (*CreateContactResponse, error)
+	Read(context.Context, *ReadContactRequest) (*ReadContactResponse, error)
+	Update(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error)
+	Delete(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
+	Undelete(context.Context, *UndeleteContactRequest) (*UndeleteContactResponse, error)
+	List(context.Context, *ListContactRequest) (*ListContactsResponse, error)
+	SendSMS(context.Context, *SMSRequest) (*SMSResponse, error)
+	Sync(context.Context, *SyncContactsRequest) (*SyncContactsResponse, error)
+	Search(context.Context, *SearchContactsRequest) (*SearchContactsResponse, error)
+	Suggest(context.Context, *SuggestContactsRequest) (*SuggestContactsResponse, error)
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*FindDuplicatesResponse, error)
+	// Merge merges the contacts into the survivor in one transaction. The
+	// survivor gets the e-mail addresses, phone numbers, groups, nicknames and
+	// notes of all of them and the addresses and names it doesn't have.
+	Merge(context.Context, *MergeContactsRequest) (*MergeContactsResponse, error)
+	// RunSavedSearch lists the contacts with the collection operators of the
+	// saved search
+	RunSavedSearch(context.Context, *RunSavedSearchRequest) (*ListContactsResponse, error)
+	Watch(*WatchContactsRequest, Contacts_WatchServer) error
+	BatchCreate(context.Context, *BatchCreateContactsRequest) (*BatchCreateContactsResponse, error)
+	BatchUpdate(context.Context, *BatchUpdateContactsRequest) (*BatchUpdateContactsResponse, error)
+	BatchDelete(context.Context, *BatchDeleteContactsRequest) (*BatchDeleteContactsResponse, error)
+	Export(*ExportContactsRequest, Contacts_ExportServer) error
+	Import(Contacts_ImportServer) error
+	// ReadVCard returns the contact as a vCard. The gateway serves it at
+	// /contacts/{id}.vcf as well.
+	ReadVCard(context.Context, *ReadVCardRequest) (*FileChunk, error)
+}
+
+func RegisterContactsServer(s *grpc.Server, srv ContactsServer) {
+	s.RegisterService(&_Contacts_serviceDesc, srv)
+}
+
+func _Contacts_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Create(ctx, req.(*CreateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contacts_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Read(ctx, req.(*ReadContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contacts_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Update(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contacts_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Delete(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contacts_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Undelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Undelete(ctx, req.(*UndeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-type ProfilesClient interface {
-	Create(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
-	Read(ctx context.Context, in *ReadProfileRequest, opts ...grpc.CallOption) (*ReadProfileResponse, error)
-	Update(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
-	Delete(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error)
-	List(ctx context.Context, in *ListProfileRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+func _Contacts_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).List(ctx, req.(*ListContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type profilesClient struct {
-	cc *grpc.ClientConn
+func _Contacts_SendSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).SendSMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/SendSMS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).SendSMS(ctx, req.(*SMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewProfilesClient(cc *grpc.ClientConn) ProfilesClient {
-	return &profilesClient{cc}
+func _Contacts_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Sync(ctx, req.(*SyncContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profilesClient) Create(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
-	out := new(CreateProfileResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Create", in, out, c.cc, opts...)
-	if err != nil {
+func _Contacts_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContactsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ContactsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Search(ctx, req.(*SearchContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profilesClient) Read(ctx context.Context, in *ReadProfileRequest, opts ...grpc.CallOption) (*ReadProfileResponse, error) {
-	out := new(ReadProfileResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Read", in, out, c.cc, opts...)
-	if err != nil {
+func _Contacts_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestContactsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ContactsServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Suggest(ctx, req.(*SuggestContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profilesClient) Update(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
-	out := new(UpdateProfileResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Update", in, out, c.cc, opts...)
-	if err != nil {
+func _Contacts_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ContactsServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/FindDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profilesClient) Delete(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error) {
-	out := new(DeleteProfileResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Profiles/Delete", in, out, c.cc, opts...)
-	if err != nil {
+func _Contacts_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeContactsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ContactsServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/Merge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).Merge(ctx, req.(*MergeContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *profilesClient) List(ctx context.Context, in *ListProfileRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
-	out := new(ListProfilesResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Profiles/List", in, out, c.cc, opts...)
-	if err != nil {
+func _Contacts_RunSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunSavedSearchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ContactsServer).RunSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/RunSavedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).RunSavedSearch(ctx, req.(*RunSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Server API for Profiles service
+func _Contacts_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContactsServer).Watch(m, &contactsWatchServer{stream})
+}
 
-type ProfilesServer interface {
-	Create(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
-	Read(context.Context, *ReadProfileRequest) (*ReadProfileResponse, error)
-	Update(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
-	Delete(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
-	List(context.Context, *ListProfileRequest) (*ListProfilesResponse, error)
+type Contacts_WatchServer interface {
+	Send(*ContactEvent) error
+	grpc.ServerStream
 }
 
-func RegisterProfilesServer(s *grpc.Server, srv ProfilesServer) {
-	s.RegisterService(&_Profiles_serviceDesc, srv)
+type contactsWatchServer struct {
+	grpc.ServerStream
 }
 
-func _Profiles_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProfileRequest)
+func (x *contactsWatchServer) Send(m *ContactEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contacts_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateContactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfilesServer).Create(ctx, in)
+		return srv.(ContactsServer).BatchCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Profiles/Create",
+		FullMethod: "/api.contacts.Contacts/BatchCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfilesServer).Create(ctx, req.(*CreateProfileRequest))
+		return srv.(ContactsServer).BatchCreate(ctx, req.(*BatchCreateContactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profiles_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadProfileRequest)
+func _Contacts_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateContactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfilesServer).Read(ctx, in)
+		return srv.(ContactsServer).BatchUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Profiles/Read",
+		FullMethod: "/api.contacts.Contacts/BatchUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfilesServer).Read(ctx, req.(*ReadProfileRequest))
+		return srv.(ContactsServer).BatchUpdate(ctx, req.(*BatchUpdateContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contacts_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).BatchDelete(ctx, req.(*BatchDeleteContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Contacts_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContactsServer).Export(m, &contactsExportServer{stream})
+}
+
+type Contacts_ExportServer interface {
+	Send(*FileChunk) error
+	grpc.ServerStream
+}
+
+type contactsExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *contactsExportServer) Send(m *FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Contacts_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContactsServer).Import(&contactsImportServer{stream})
+}
+
+type Contacts_ImportServer interface {
+	SendAndClose(*ImportContactsResponse) error
+	Recv() (*ImportContactsRequest, error)
+	grpc.ServerStream
+}
+
+type contactsImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *contactsImportServer) SendAndClose(m *ImportContactsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *contactsImportServer) Recv() (*ImportContactsRequest, error) {
+	m := new(ImportContactsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Contacts_ReadVCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadVCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).ReadVCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Contacts/ReadVCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).ReadVCard(ctx, req.(*ReadVCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profiles_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProfileRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProfilesServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.contacts.Profiles/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfilesServer).Update(ctx, req.(*UpdateProfileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var _Contacts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.contacts.Contacts",
+	HandlerType: (*ContactsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Contacts_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Contacts_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Contacts_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Contacts_Delete_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _Contacts_Undelete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Contacts_List_Handler,
+		},
+		{
+			MethodName: "SendSMS",
+			Handler:    _Contacts_SendSMS_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _Contacts_Sync_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Contacts_Search_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Contacts_Suggest_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _Contacts_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _Contacts_Merge_Handler,
+		},
+		{
+			MethodName: "RunSavedSearch",
+			Handler:    _Contacts_RunSavedSearch_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _Contacts_BatchCreate_Handler,
+		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _Contacts_BatchUpdate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _Contacts_BatchDelete_Handler,
+		},
+		{
+			MethodName: "ReadVCard",
+			Handler:    _Contacts_ReadVCard_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Contacts_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Contacts_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Contacts_Import_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/pb/contacts.proto",
+}
+
+// Client API for AuditLog service
+
+type AuditLogClient interface {
+	List(ctx context.Context, in *ListAuditEventRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
-func _Profiles_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProfileRequest)
-	if err := dec(in); err != nil {
+type auditLogClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditLogClient(cc *grpc.ClientConn) AuditLogClient {
+	return &auditLogClient{cc}
+}
+
+func (c *auditLogClient) List(ctx context.Context, in *ListAuditEventRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.AuditLog/List", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ProfilesServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.contacts.Profiles/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfilesServer).Delete(ctx, req.(*DeleteProfileRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Profiles_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProfileRequest)
+// Server API for AuditLog service
+
+type AuditLogServer interface {
+	List(context.Context, *ListAuditEventRequest) (*ListAuditEventsResponse, error)
+}
+
+func RegisterAuditLogServer(s *grpc.Server, srv AuditLogServer) {
+	s.RegisterService(&_AuditLog_serviceDesc, srv)
+}
+
+func _AuditLog_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfilesServer).List(ctx, in)
+		return srv.(AuditLogServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Profiles/List",
+		FullMethod: "/api.contacts.AuditLog/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfilesServer).List(ctx, req.(*ListProfileRequest))
+		return srv.(AuditLogServer).List(ctx, req.(*ListAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Profiles_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.contacts.Profiles",
-	HandlerType: (*ProfilesServer)(nil),
+var _AuditLog_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.contacts.AuditLog",
+	HandlerType: (*AuditLogServer)(nil),
 	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _Profiles_Create_Handler,
-		},
-		{
-			MethodName: "Read",
-			Handler:    _Profiles_Read_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _Profiles_Update_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _Profiles_Delete_Handler,
-		},
 		{
 			MethodName: "List",
-			Handler:    _Profiles_List_Handler,
+			Handler:    _AuditLog_List_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/contacts.proto",
 }
 
-// Client API for Groups service
+// Client API for Webhooks service
 
-type GroupsClient interface {
-	Create(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
-	Read(ctx context.Context, in *ReadGroupRequest, opts ...grpc.CallOption) (*ReadGroupResponse, error)
-	Update(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
-	Delete(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
-	List(ctx context.Context, in *ListGroupRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+type WebhooksClient interface {
+	Create(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	Read(ctx context.Context, in *ReadWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ReadWebhookSubscriptionResponse, error)
+	Update(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebhookSubscriptionResponse, error)
+	Delete(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	List(ctx context.Context, in *ListWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	ListDeliveries(ctx context.Context, in *ListWebhookDeliveryRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
 }
 
-type groupsClient struct {
+type webhooksClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewGroupsClient(cc *grpc.ClientConn) GroupsClient {
-	return &groupsClient{cc}
+func NewWebhooksClient(cc *grpc.ClientConn) WebhooksClient {
+	return &webhooksClient{cc}
 }
 
-func (c *groupsClient) Create(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
-	out := new(CreateGroupResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Groups/Create", in, out, c.cc, opts...)
+func (c *webhooksClient) Create(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	out := new(CreateWebhookSubscriptionResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Webhooks/Create", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupsClient) Read(ctx context.Context, in *ReadGroupRequest, opts ...grpc.CallOption) (*ReadGroupResponse, error) {
-	out := new(ReadGroupResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Groups/Read", in, out, c.cc, opts...)
+func (c *webhooksClient) Read(ctx context.Context, in *ReadWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ReadWebhookSubscriptionResponse, error) {
+	out := new(ReadWebhookSubscriptionResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Webhooks/Read", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupsClient) Update(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error) {
-	out := new(UpdateGroupResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Groups/Update", in, out, c.cc, opts...)
+func (c *webhooksClient) Update(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*UpdateWebhookSubscriptionResponse, error) {
+	out := new(UpdateWebhookSubscriptionResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Webhooks/Update", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupsClient) Delete(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
-	out := new(DeleteGroupResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Groups/Delete", in, out, c.cc, opts...)
+func (c *webhooksClient) Delete(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Webhooks/Delete", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupsClient) List(ctx context.Context, in *ListGroupRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
-	out := new(ListGroupsResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Groups/List", in, out, c.cc, opts...)
+func (c *webhooksClient) List(ctx context.Context, in *ListWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	out := new(ListWebhookSubscriptionsResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Webhooks/List", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Groups service
+func (c *webhooksClient) ListDeliveries(ctx context.Context, in *ListWebhookDeliveryRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.Webhooks/ListDeliveries", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type GroupsServer interface {
-	Create(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
-	Read(context.Context, *ReadGroupRequest) (*ReadGroupResponse, error)
-	Update(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
-	Delete(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
-	List(context.Context, *ListGroupRequest) (*ListGroupsResponse, error)
+// Server API for Webhooks service
+
+type WebhooksServer interface {
+	Create(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	Read(context.Context, *ReadWebhookSubscriptionRequest) (*ReadWebhookSubscriptionResponse, error)
+	Update(context.Context, *UpdateWebhookSubscriptionRequest) (*UpdateWebhookSubscriptionResponse, error)
+	Delete(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	List(context.Context, *ListWebhookSubscriptionRequest) (*ListWebhookSubscriptionsResponse, error)
+	ListDeliveries(context.Context, *ListWebhookDeliveryRequest) (*ListWebhookDeliveriesResponse, error)
 }
 
-func RegisterGroupsServer(s *grpc.Server, srv GroupsServer) {
-	s.RegisterService(&_Groups_serviceDesc, srv)
+func RegisterWebhooksServer(s *grpc.Server, srv WebhooksServer) {
+	s.RegisterService(&_Webhooks_serviceDesc, srv)
 }
 
-func _Groups_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupRequest)
+func _Webhooks_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServer).Create(ctx, in)
+		return srv.(WebhooksServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Groups/Create",
+		FullMethod: "/api.contacts.Webhooks/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServer).Create(ctx, req.(*CreateGroupRequest))
+		return srv.(WebhooksServer).Create(ctx, req.(*CreateWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Groups_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadGroupRequest)
+func _Webhooks_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServer).Read(ctx, in)
+		return srv.(WebhooksServer).Read(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Groups/Read",
+		FullMethod: "/api.contacts.Webhooks/Read",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServer).Read(ctx, req.(*ReadGroupRequest))
+		return srv.(WebhooksServer).Read(ctx, req.(*ReadWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Groups_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGroupRequest)
+func _Webhooks_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServer).Update(ctx, in)
+		return srv.(WebhooksServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Groups/Update",
+		FullMethod: "/api.contacts.Webhooks/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServer).Update(ctx, req.(*UpdateGroupRequest))
+		return srv.(WebhooksServer).Update(ctx, req.(*UpdateWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Groups_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupRequest)
+func _Webhooks_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServer).Delete(ctx, in)
+		return srv.(WebhooksServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Groups/Delete",
+		FullMethod: "/api.contacts.Webhooks/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServer).Delete(ctx, req.(*DeleteGroupRequest))
+		return srv.(WebhooksServer).Delete(ctx, req.(*DeleteWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Groups_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListGroupRequest)
+func _Webhooks_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupsServer).List(ctx, in)
+		return srv.(WebhooksServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Groups/List",
+		FullMethod: "/api.contacts.Webhooks/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupsServer).List(ctx, req.(*ListGroupRequest))
+		return srv.(WebhooksServer).List(ctx, req.(*ListWebhookSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Groups_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.contacts.Groups",
-	HandlerType: (*GroupsServer)(nil),
+func _Webhooks_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.contacts.Webhooks/ListDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).ListDeliveries(ctx, req.(*ListWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Webhooks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.contacts.Webhooks",
+	HandlerType: (*WebhooksServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _Groups_Create_Handler,
+			Handler:    _Webhooks_Create_Handler,
 		},
 		{
 			MethodName: "Read",
-			Handler:    _Groups_Read_Handler,
+			Handler:    _Webhooks_Read_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _Groups_Update_Handler,
+			Handler:    _Webhooks_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _Groups_Delete_Handler,
+			Handler:    _Webhooks_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _Groups_List_Handler,
+			Handler:    _Webhooks_List_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _Webhooks_ListDeliveries_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/contacts.proto",
 }
 
-// Client API for Contacts service
+// Client API for SavedSearches service
 
-type ContactsClient interface {
-	Create(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error)
-	Read(ctx context.Context, in *ReadContactRequest, opts ...grpc.CallOption) (*ReadContactResponse, error)
-	Update(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
-	Delete(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error)
-	List(ctx context.Context, in *ListContactRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
-	SendSMS(ctx context.Context, in *SMSRequest, opts ...grpc.CallOption) (*SMSResponse, error)
+type SavedSearchesClient interface {
+	Create(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error)
+	Read(ctx context.Context, in *ReadSavedSearchRequest, opts ...grpc.CallOption) (*ReadSavedSearchResponse, error)
+	Update(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*UpdateSavedSearchResponse, error)
+	Delete(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	List(ctx context.Context, in *ListSavedSearchRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
 }
 
-type contactsClient struct {
+type savedSearchesClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewContactsClient(cc *grpc.ClientConn) ContactsClient {
-	return &contactsClient{cc}
-}
-
-func (c *contactsClient) Create(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*CreateContactResponse, error) {
-	out := new(CreateContactResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Create", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewSavedSearchesClient(cc *grpc.ClientConn) SavedSearchesClient {
+	return &savedSearchesClient{cc}
 }
 
-func (c *contactsClient) Read(ctx context.Context, in *ReadContactRequest, opts ...grpc.CallOption) (*ReadContactResponse, error) {
-	out := new(ReadContactResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Read", in, out, c.cc, opts...)
+func (c *savedSearchesClient) Create(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error) {
+	out := new(CreateSavedSearchResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.SavedSearches/Create", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *contactsClient) Update(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
-	out := new(UpdateContactResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Update", in, out, c.cc, opts...)
+func (c *savedSearchesClient) Read(ctx context.Context, in *ReadSavedSearchRequest, opts ...grpc.CallOption) (*ReadSavedSearchResponse, error) {
+	out := new(ReadSavedSearchResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.SavedSearches/Read", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *contactsClient) Delete(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactResponse, error) {
-	out := new(DeleteContactResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Contacts/Delete", in, out, c.cc, opts...)
+func (c *savedSearchesClient) Update(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*UpdateSavedSearchResponse, error) {
+	out := new(UpdateSavedSearchResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.SavedSearches/Update", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *contactsClient) List(ctx context.Context, in *ListContactRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
-	out := new(ListContactsResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Contacts/List", in, out, c.cc, opts...)
+func (c *savedSearchesClient) Delete(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	out := new(DeleteSavedSearchResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.SavedSearches/Delete", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *contactsClient) SendSMS(ctx context.Context, in *SMSRequest, opts ...grpc.CallOption) (*SMSResponse, error) {
-	out := new(SMSResponse)
-	err := grpc.Invoke(ctx, "/api.contacts.Contacts/SendSMS", in, out, c.cc, opts...)
+func (c *savedSearchesClient) List(ctx context.Context, in *ListSavedSearchRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	out := new(ListSavedSearchesResponse)
+	err := grpc.Invoke(ctx, "/api.contacts.SavedSearches/List", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Contacts service
-
-type ContactsServer interface {
-	Create(context.Context, *CreateContactRequest) (*CreateContactResponse, error)
-	Read(context.Context, *ReadContactRequest) (*ReadContactResponse, error)
-	Update(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error)
-	Delete(context.Context, *DeleteContactRequest) (*DeleteContactResponse, error)
-	List(context.Context, *ListContactRequest) (*ListContactsResponse, error)
-	SendSMS(context.Context, *SMSRequest) (*SMSResponse, error)
-}
+// Server API for SavedSearches service
 
-func RegisterContactsServer(s *grpc.Server, srv ContactsServer) {
-	s.RegisterService(&_Contacts_serviceDesc, srv)
+type SavedSearchesServer interface {
+	Create(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error)
+	Read(context.Context, *ReadSavedSearchRequest) (*ReadSavedSearchResponse, error)
+	Update(context.Context, *UpdateSavedSearchRequest) (*UpdateSavedSearchResponse, error)
+	Delete(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	List(context.Context, *ListSavedSearchRequest) (*ListSavedSearchesResponse, error)
 }
 
-func _Contacts_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateContactRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ContactsServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.contacts.Contacts/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContactsServer).Create(ctx, req.(*CreateContactRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterSavedSearchesServer(s *grpc.Server, srv SavedSearchesServer) {
+	s.RegisterService(&_SavedSearches_serviceDesc, srv)
 }
 
-func _Contacts_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadContactRequest)
+func _SavedSearches_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContactsServer).Read(ctx, in)
+		return srv.(SavedSearchesServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Contacts/Read",
+		FullMethod: "/api.contacts.SavedSearches/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContactsServer).Read(ctx, req.(*ReadContactRequest))
+		return srv.(SavedSearchesServer).Create(ctx, req.(*CreateSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Contacts_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateContactRequest)
+func _SavedSearches_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContactsServer).Update(ctx, in)
+		return srv.(SavedSearchesServer).Read(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Contacts/Update",
+		FullMethod: "/api.contacts.SavedSearches/Read",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContactsServer).Update(ctx, req.(*UpdateContactRequest))
+		return srv.(SavedSearchesServer).Read(ctx, req.(*ReadSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Contacts_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteContactRequest)
+func _SavedSearches_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContactsServer).Delete(ctx, in)
+		return srv.(SavedSearchesServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Contacts/Delete",
+		FullMethod: "/api.contacts.SavedSearches/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContactsServer).Delete(ctx, req.(*DeleteContactRequest))
+		return srv.(SavedSearchesServer).Update(ctx, req.(*UpdateSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Contacts_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListContactRequest)
+func _SavedSearches_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContactsServer).List(ctx, in)
+		return srv.(SavedSearchesServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Contacts/List",
+		FullMethod: "/api.contacts.SavedSearches/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContactsServer).List(ctx, req.(*ListContactRequest))
+		return srv.(SavedSearchesServer).Delete(ctx, req.(*DeleteSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Contacts_SendSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SMSRequest)
+func _SavedSearches_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ContactsServer).SendSMS(ctx, in)
+		return srv.(SavedSearchesServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.contacts.Contacts/SendSMS",
+		FullMethod: "/api.contacts.SavedSearches/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContactsServer).SendSMS(ctx, req.(*SMSRequest))
+		return srv.(SavedSearchesServer).List(ctx, req.(*ListSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Contacts_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.contacts.Contacts",
-	HandlerType: (*ContactsServer)(nil),
+var _SavedSearches_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.contacts.SavedSearches",
+	HandlerType: (*SavedSearchesServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Create",
-			Handler:    _Contacts_Create_Handler,
+			Handler:    _SavedSearches_Create_Handler,
 		},
 		{
 			MethodName: "Read",
-			Handler:    _Contacts_Read_Handler,
+			Handler:    _SavedSearches_Read_Handler,
 		},
 		{
 			MethodName: "Update",
-			Handler:    _Contacts_Update_Handler,
+			Handler:    _SavedSearches_Update_Handler,
 		},
 		{
 			MethodName: "Delete",
-			Handler:    _Contacts_Delete_Handler,
+			Handler:    _SavedSearches_Delete_Handler,
 		},
 		{
 			MethodName: "List",
-			Handler:    _Contacts_List_Handler,
-		},
-		{
-			MethodName: "SendSMS",
-			Handler:    _Contacts_SendSMS_Handler,
+			Handler:    _SavedSearches_List_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1590,113 +5257,317 @@ var _Contacts_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/pb/contacts.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1725 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0x2e, 0x65, 0x59, 0x3f, 0xc7, 0xce, 0xc6, 0x3b, 0xb6, 0xbb, 0x94, 0xba, 0x9b, 0x28, 0x2c,
-	0x0a, 0x2c, 0xec, 0x4a, 0xdc, 0xd5, 0x2e, 0xda, 0xae, 0x17, 0x05, 0x12, 0x39, 0xc9, 0x22, 0x8b,
-	0x6e, 0x1a, 0x48, 0x68, 0x81, 0x16, 0x08, 0xd4, 0x11, 0x39, 0x92, 0x27, 0xa6, 0x38, 0x0c, 0x87,
-	0x6a, 0xaa, 0x04, 0x01, 0x8a, 0x14, 0xe8, 0x03, 0xb4, 0x37, 0x7d, 0x15, 0xeb, 0xa2, 0xc8, 0x55,
-	0x1f, 0xa0, 0x05, 0x7a, 0x57, 0x14, 0x05, 0xda, 0x8b, 0xbe, 0x45, 0xc1, 0xf9, 0xa1, 0x48, 0x8a,
-	0xd2, 0x2a, 0x76, 0x91, 0x8b, 0xdc, 0x18, 0x24, 0xe7, 0xfc, 0xcd, 0x99, 0xef, 0x7c, 0x73, 0x8e,
-	0x0c, 0xc7, 0xc1, 0xe5, 0xc4, 0x0e, 0x46, 0xb6, 0xc3, 0xfc, 0x08, 0x3b, 0x11, 0xef, 0x04, 0x21,
-	0x8b, 0x18, 0xda, 0xc7, 0x01, 0xed, 0xe8, 0x6f, 0xcd, 0xd6, 0x84, 0xb1, 0x89, 0x47, 0x6c, 0xb1,
-	0x36, 0x9a, 0x8d, 0xed, 0x31, 0x25, 0x9e, 0x3b, 0x9c, 0x62, 0x7e, 0x29, 0xe5, 0x9b, 0x77, 0x95,
-	0x04, 0x0e, 0xa8, 0x8d, 0x7d, 0x9f, 0x45, 0x38, 0xa2, 0xcc, 0x57, 0xd6, 0x9a, 0x4f, 0x27, 0x34,
-	0xba, 0x98, 0x8d, 0x3a, 0x0e, 0x9b, 0xda, 0xde, 0x7c, 0x1c, 0x49, 0x43, 0x4e, 0x7b, 0x42, 0xfc,
-	0xf6, 0xaf, 0xb1, 0x47, 0x5d, 0x1c, 0x11, 0x7b, 0xe5, 0x41, 0x29, 0x7f, 0x3f, 0x25, 0xcc, 0x3f,
-	0xc1, 0x93, 0x09, 0x09, 0x6d, 0x16, 0x08, 0xf3, 0x05, 0xae, 0xce, 0x52, 0xae, 0xa8, 0x3f, 0x66,
-	0x23, 0x8f, 0xfd, 0x86, 0x05, 0xc4, 0x4f, 0xbb, 0x9c, 0xb0, 0x70, 0x9a, 0x98, 0x88, 0x5f, 0x94,
-	0xee, 0x93, 0x6d, 0x75, 0xa3, 0x79, 0x40, 0xb8, 0xfc, 0xab, 0x54, 0x9f, 0xaf, 0x53, 0xc5, 0x91,
-	0x87, 0x79, 0x1b, 0x07, 0x41, 0x3b, 0x62, 0xcc, 0xbb, 0xa4, 0x91, 0xfd, 0xf1, 0x8c, 0x84, 0x73,
-	0xdb, 0x61, 0x9e, 0x47, 0x9c, 0x38, 0x84, 0x21, 0x0b, 0x48, 0x88, 0x23, 0x16, 0x6a, 0x5b, 0xef,
-	0x6c, 0x6f, 0x2b, 0x0c, 0x1c, 0x3b, 0x24, 0x9c, 0xcd, 0x42, 0x87, 0x24, 0x0f, 0xd2, 0x8c, 0xf5,
-	0x77, 0x03, 0xaa, 0x1f, 0x84, 0x6c, 0x4c, 0x3d, 0x82, 0x7e, 0x08, 0x25, 0xea, 0x9a, 0x46, 0xcb,
-	0xb8, 0xbf, 0xd7, 0x3d, 0xee, 0x08, 0x3b, 0x9d, 0x30, 0x70, 0x3a, 0xef, 0xb9, 0xc4, 0x8f, 0xe8,
-	0x98, 0x92, 0xb0, 0x77, 0xb0, 0xb8, 0x6a, 0xec, 0x03, 0xa0, 0x0a, 0x27, 0x21, 0xc5, 0xde, 0x7d,
-	0xa3, 0x5f, 0xa2, 0x2e, 0x42, 0x50, 0xf6, 0xf1, 0x94, 0x98, 0xa5, 0x96, 0x71, 0xbf, 0xde, 0x17,
-	0xcf, 0xe8, 0x08, 0x76, 0x7d, 0x16, 0x11, 0x6e, 0xee, 0x88, 0x8f, 0xf2, 0x05, 0x3d, 0x84, 0x9a,
-	0xc6, 0x8b, 0x59, 0x6e, 0xed, 0x48, 0x47, 0x29, 0x10, 0x75, 0xce, 0xe5, 0x43, 0x3f, 0x11, 0x43,
-	0xa7, 0x50, 0x99, 0x84, 0x6c, 0x16, 0x70, 0x73, 0x57, 0x28, 0x1c, 0x66, 0x15, 0x9e, 0xc5, 0x6b,
-	0x7d, 0x25, 0x72, 0x56, 0x5b, 0x5c, 0x35, 0xca, 0x35, 0xa3, 0x65, 0x58, 0xcf, 0xe0, 0xe8, 0x3c,
-	0x24, 0x38, 0x22, 0x6a, 0x77, 0x7d, 0xf2, 0xf1, 0x8c, 0xf0, 0x08, 0xd9, 0x50, 0x0d, 0xf0, 0xdc,
-	0x63, 0x38, 0xb5, 0xd3, 0xb4, 0x3d, 0x2d, 0xae, 0xa5, 0xac, 0x77, 0xe1, 0x38, 0x67, 0x88, 0x07,
-	0xcc, 0xe7, 0x04, 0xb5, 0xa1, 0x12, 0x12, 0x3e, 0xf3, 0xa2, 0xcd, 0x86, 0x94, 0x90, 0xf5, 0x14,
-	0x50, 0x9f, 0x60, 0x37, 0x17, 0xce, 0xf7, 0x5e, 0x9a, 0xf3, 0x38, 0xc3, 0xd6, 0xdb, 0x70, 0x98,
-	0x51, 0xbe, 0x5e, 0x08, 0xcf, 0xe0, 0xe8, 0x67, 0x81, 0xfb, 0xff, 0xc9, 0x49, 0xce, 0xd0, 0xf5,
-	0x02, 0xfa, 0x31, 0x1c, 0xbd, 0x4d, 0x3c, 0xb2, 0x12, 0xd0, 0x96, 0x59, 0xb9, 0x03, 0xc7, 0x39,
-	0x75, 0x19, 0x86, 0xf5, 0x4f, 0x03, 0xd0, 0x4f, 0x28, 0x8f, 0x56, 0xf6, 0x59, 0x19, 0x53, 0x2f,
-	0x22, 0xa1, 0x32, 0x7d, 0xa7, 0xa3, 0x2b, 0x47, 0x84, 0xf9, 0xae, 0x58, 0xa3, 0xfe, 0xa4, 0xaf,
-	0xc4, 0xd0, 0x03, 0xa8, 0xb1, 0xd0, 0x25, 0xe1, 0x70, 0x34, 0x17, 0xe0, 0x8e, 0xa3, 0xc9, 0xa8,
-	0x0c, 0x58, 0x18, 0xc5, 0x0a, 0x55, 0x21, 0xd6, 0x9b, 0xa3, 0xc7, 0xb1, 0x0b, 0xe2, 0xb9, 0x12,
-	0xf7, 0x7b, 0xdd, 0xbb, 0x79, 0x17, 0xc4, 0x73, 0x07, 0x44, 0x15, 0x75, 0x5f, 0xc9, 0xa2, 0x07,
-	0x50, 0x09, 0xf0, 0x84, 0xfa, 0x13, 0xb3, 0x2c, 0xb4, 0xcc, 0xac, 0xd6, 0x07, 0xf1, 0x1a, 0x96,
-	0x1a, 0x52, 0x2e, 0x3e, 0xca, 0xd4, 0x06, 0x79, 0x72, 0x00, 0x36, 0x54, 0x65, 0x6e, 0xb9, 0x69,
-	0x14, 0xd5, 0x57, 0x72, 0x94, 0x4a, 0xca, 0xfa, 0x8f, 0x01, 0xbb, 0xa2, 0x86, 0xbe, 0x8e, 0xf2,
-	0x7f, 0x0c, 0x10, 0xc8, 0x00, 0x86, 0xd4, 0x55, 0x7b, 0x5d, 0x73, 0xbe, 0x75, 0x25, 0xf8, 0x9e,
-	0x8b, 0x9e, 0xa4, 0x48, 0x63, 0x77, 0x03, 0x69, 0xf4, 0x2a, 0x8b, 0xab, 0x46, 0xa9, 0xfb, 0xad,
-	0x25, 0x79, 0xa4, 0xf8, 0xe0, 0x1c, 0x90, 0x2c, 0x63, 0x49, 0x18, 0x0a, 0x11, 0xed, 0x3c, 0xf2,
-	0x0b, 0xd9, 0x25, 0xc1, 0x7d, 0x0f, 0x0e, 0x33, 0x46, 0x54, 0xd2, 0x4f, 0x73, 0xa8, 0x2f, 0xa6,
-	0x28, 0x85, 0xf9, 0x27, 0x70, 0x10, 0x97, 0x72, 0x26, 0x8c, 0x2d, 0xf1, 0xfe, 0x26, 0xdc, 0x4e,
-	0xa9, 0x5e, 0xc7, 0xf9, 0x39, 0x20, 0x59, 0xb8, 0x37, 0xcc, 0x42, 0xc6, 0xc8, 0x75, 0x02, 0x79,
-	0x0a, 0x48, 0x96, 0xee, 0x75, 0xf2, 0x70, 0x0c, 0x87, 0x19, 0x65, 0x55, 0xf5, 0xff, 0x30, 0xe0,
-	0x20, 0x2e, 0x8a, 0x8c, 0xc9, 0x6f, 0x50, 0xcd, 0x9f, 0x4b, 0x52, 0x13, 0xdb, 0xe3, 0x29, 0xca,
-	0xcd, 0x55, 0x7c, 0xf1, 0xe1, 0xe9, 0x7a, 0xff, 0x4b, 0x19, 0xaa, 0xaa, 0x5e, 0xae, 0x5f, 0xf1,
-	0xf7, 0x00, 0xc6, 0x34, 0xe4, 0xd1, 0x30, 0x55, 0xf7, 0x75, 0xf1, 0xe5, 0xfd, 0xb8, 0xf8, 0x5f,
-	0x87, 0xbd, 0x29, 0x75, 0x5d, 0x8f, 0xc8, 0x75, 0x49, 0x01, 0x20, 0x3f, 0x09, 0x81, 0xef, 0x40,
-	0xdd, 0xc3, 0x5a, 0xbd, 0x2c, 0x96, 0x6b, 0xf1, 0x07, 0xb1, 0xf8, 0x18, 0x5e, 0x09, 0x42, 0x3a,
-	0xc5, 0xe1, 0x7c, 0x48, 0xa6, 0x98, 0x7a, 0xe6, 0x6e, 0x2c, 0xd0, 0x7b, 0x35, 0x2e, 0xee, 0x03,
-	0x63, 0xf1, 0xdf, 0x2f, 0x77, 0xca, 0x61, 0xe9, 0x57, 0x46, 0x7f, 0x5f, 0x49, 0xbd, 0x13, 0x0b,
-	0x2d, 0x09, 0xa7, 0x92, 0x26, 0x9c, 0x53, 0xa8, 0x08, 0x1b, 0xdc, 0xac, 0x16, 0xe5, 0x46, 0xa8,
-	0xf6, 0x95, 0x08, 0xfa, 0x11, 0xec, 0x5f, 0xb0, 0x29, 0x19, 0x62, 0xd7, 0x0d, 0x09, 0xe7, 0x66,
-	0xad, 0xe8, 0x0a, 0x7b, 0x4b, 0x2e, 0xf6, 0xf7, 0x62, 0x51, 0xf5, 0x12, 0x6b, 0x7e, 0xc2, 0xc2,
-	0xcb, 0x44, 0xb3, 0xbe, 0x51, 0x33, 0x16, 0xd5, 0x9a, 0x59, 0x46, 0x84, 0x2d, 0x19, 0xf1, 0x3c,
-	0xe9, 0x89, 0xf6, 0xd6, 0x1e, 0x79, 0xef, 0xdb, 0x8b, 0xab, 0x06, 0xea, 0x1e, 0xc0, 0x2d, 0x21,
-	0x3a, 0xd4, 0xab, 0xba, 0x57, 0x42, 0x8f, 0xa0, 0xee, 0x53, 0xe7, 0x32, 0x3e, 0x03, 0x6e, 0xee,
-	0x2b, 0xcf, 0xa2, 0xd1, 0x95, 0x3d, 0xeb, 0xf3, 0xc1, 0x4f, 0xdf, 0xff, 0x39, 0xf6, 0x66, 0xa4,
-	0xbf, 0x94, 0x4b, 0x11, 0xaa, 0x03, 0xbb, 0x32, 0xf3, 0xb7, 0x12, 0x14, 0x95, 0x05, 0x38, 0x4e,
-	0xa1, 0xaa, 0xf3, 0x20, 0x90, 0xd1, 0xbb, 0x1d, 0xeb, 0x40, 0xe9, 0x41, 0xea, 0xec, 0xb4, 0xc4,
-	0xd9, 0xbd, 0xc5, 0x55, 0xa3, 0x51, 0x33, 0xd0, 0x21, 0xec, 0x9e, 0x8c, 0x18, 0xf3, 0x10, 0x50,
-	0x3e, 0x54, 0x07, 0xdb, 0x32, 0xac, 0xdf, 0x19, 0x50, 0xd5, 0xa9, 0x32, 0x97, 0x76, 0x0d, 0x71,
-	0xc6, 0xfa, 0x35, 0xbe, 0x80, 0x1c, 0x1a, 0xcd, 0xf5, 0x05, 0x14, 0x3f, 0xc7, 0x78, 0xe0, 0x11,
-	0x8e, 0x34, 0xfa, 0xe4, 0x0b, 0x3a, 0x80, 0x9d, 0x4f, 0x69, 0xa0, 0x20, 0x17, 0x3f, 0xc6, 0x56,
-	0x1d, 0x36, 0xf3, 0xa3, 0x70, 0x2e, 0x71, 0xd6, 0xd7, 0xaf, 0x45, 0xbd, 0xa4, 0xee, 0x4e, 0xb7,
-	0xec, 0x9b, 0xb4, 0xf8, 0x6a, 0x2f, 0x99, 0x18, 0xda, 0xae, 0x6f, 0xd2, 0xe2, 0xb9, 0x5e, 0x32,
-	0x17, 0xce, 0x57, 0xeb, 0x25, 0x6f, 0x18, 0xc2, 0x67, 0xba, 0x97, 0xbc, 0x61, 0x4e, 0x50, 0x37,
-	0x61, 0x4f, 0xc9, 0xb6, 0xcd, 0x8e, 0x9c, 0x12, 0x3b, 0x7a, 0x8e, 0x94, 0x04, 0xfa, 0x02, 0xf3,
-	0x4b, 0xcd, 0x9d, 0xcb, 0xfe, 0xf3, 0x86, 0x9b, 0x48, 0xfa, 0xcf, 0xeb, 0x65, 0x32, 0xe9, 0x3f,
-	0x73, 0x61, 0xe8, 0xee, 0xec, 0x5c, 0x97, 0xdc, 0xb6, 0xdd, 0x59, 0x92, 0x1c, 0xcd, 0xd6, 0x3f,
-	0x00, 0x18, 0xbc, 0x18, 0xe8, 0xb0, 0xf2, 0x95, 0x66, 0x42, 0x75, 0x4a, 0x38, 0xc7, 0x13, 0xcd,
-	0xc1, 0xfa, 0xd5, 0x7a, 0x05, 0xf6, 0x84, 0x5e, 0xae, 0x1f, 0x5e, 0x39, 0xab, 0x6f, 0xca, 0xdd,
-	0xd8, 0xfd, 0x57, 0x19, 0x6a, 0xba, 0x19, 0x46, 0x53, 0xa8, 0xc8, 0x32, 0x43, 0x56, 0x2e, 0xbf,
-	0x05, 0x13, 0x61, 0xf3, 0xbb, 0x1b, 0x65, 0x54, 0x06, 0x9b, 0x5f, 0xfc, 0xed, 0xdf, 0x7f, 0x2c,
-	0x1d, 0x59, 0x75, 0x5b, 0xb1, 0x30, 0x3f, 0x4b, 0x10, 0xcc, 0xa0, 0x1c, 0x17, 0x14, 0x6a, 0x65,
-	0x0d, 0xad, 0x4e, 0x7b, 0xcd, 0x37, 0x36, 0x48, 0x28, 0x47, 0x96, 0x70, 0x74, 0x17, 0x35, 0x13,
-	0x47, 0xf6, 0x67, 0xd4, 0xed, 0xe8, 0xb1, 0x7d, 0x48, 0xdd, 0xcf, 0xd1, 0xef, 0x0d, 0xa8, 0x48,
-	0xfc, 0xe7, 0x37, 0x58, 0x34, 0xde, 0xe5, 0x37, 0x58, 0x38, 0xb9, 0x59, 0x8f, 0x84, 0xdf, 0x76,
-	0xd3, 0x4a, 0xf9, 0x55, 0x1b, 0xec, 0xe4, 0xfc, 0x2f, 0x77, 0xfe, 0x85, 0x01, 0x15, 0x59, 0x01,
-	0xf9, 0x40, 0x8a, 0xc6, 0xba, 0x7c, 0x20, 0xc5, 0xb3, 0x9b, 0xbd, 0xb8, 0x6a, 0xd4, 0x93, 0x1f,
-	0x25, 0x64, 0x36, 0x4e, 0x36, 0x65, 0x63, 0x08, 0xe5, 0x18, 0xdb, 0xf9, 0xf4, 0xaf, 0xce, 0x7f,
-	0x4d, 0x6b, 0xad, 0x44, 0x52, 0xa2, 0xd6, 0x6d, 0xe1, 0x71, 0x0f, 0x2d, 0x0f, 0xba, 0x29, 0xc6,
-	0x8a, 0x9a, 0xd1, 0xfd, 0x73, 0x19, 0x2a, 0xb2, 0xf9, 0x42, 0x93, 0x04, 0x61, 0xad, 0x22, 0xf4,
-	0xa4, 0x3b, 0xd0, 0xfc, 0xa1, 0x17, 0x0c, 0x10, 0x96, 0x29, 0x9c, 0x22, 0xab, 0x6a, 0xab, 0xdf,
-	0x31, 0x92, 0x0c, 0x53, 0x85, 0xad, 0xd7, 0x56, 0x91, 0x93, 0x71, 0xf2, 0xfa, 0xda, 0x75, 0xe5,
-	0xa2, 0x25, 0x5c, 0x34, 0x91, 0xa9, 0x5c, 0xac, 0xe6, 0xf1, 0xb7, 0x4b, 0x54, 0xb5, 0x8a, 0x10,
-	0xb3, 0x69, 0x53, 0x05, 0xf3, 0x80, 0xf5, 0x50, 0x78, 0x3c, 0x6d, 0xb6, 0x12, 0x8f, 0x2f, 0xc5,
-	0xd3, 0xa7, 0x09, 0x9c, 0x5a, 0x45, 0x50, 0xd9, 0x14, 0x41, 0xd1, 0x40, 0x70, 0xba, 0xb8, 0x6a,
-	0x54, 0xd5, 0x78, 0x2b, 0xb7, 0x7f, 0xb2, 0x7e, 0xfb, 0xbf, 0x50, 0x30, 0x7a, 0x6d, 0x15, 0x24,
-	0x19, 0xbf, 0xad, 0x35, 0xeb, 0x4b, 0x08, 0xbd, 0x2a, 0x7c, 0xd5, 0x91, 0x3e, 0xcd, 0x04, 0x40,
-	0x5f, 0xee, 0x42, 0x4d, 0xdf, 0x09, 0x2f, 0x23, 0xa9, 0x2c, 0x55, 0x17, 0x93, 0x54, 0xfe, 0xda,
-	0x59, 0x92, 0x54, 0x32, 0x00, 0x6f, 0x43, 0x52, 0x39, 0x57, 0x6f, 0x6c, 0x90, 0x58, 0x21, 0x29,
-	0x2d, 0xf6, 0xd5, 0x49, 0x6a, 0xf3, 0x06, 0x0b, 0xaf, 0xf7, 0x14, 0x49, 0x2d, 0xfd, 0xde, 0x98,
-	0xa4, 0x36, 0x07, 0x52, 0x7c, 0xc1, 0x2b, 0x92, 0x52, 0x9f, 0x13, 0x92, 0x5a, 0x9f, 0x8d, 0x0d,
-	0x24, 0x95, 0xf3, 0x6f, 0xad, 0x95, 0x28, 0x22, 0xa9, 0xe4, 0x67, 0xd2, 0x0f, 0xa1, 0x3a, 0x20,
-	0xbe, 0x3b, 0x78, 0x31, 0x40, 0x66, 0xd6, 0xc2, 0xb2, 0x81, 0x68, 0x36, 0x0a, 0x56, 0x94, 0xc9,
-	0x7b, 0xc2, 0xe4, 0x1d, 0x0b, 0x65, 0x36, 0xf1, 0xb9, 0xcd, 0xa7, 0xfc, 0xcc, 0x38, 0xd1, 0x10,
-	0xee, 0xfd, 0xd5, 0xf8, 0xc3, 0x5b, 0x7f, 0x32, 0x90, 0xbf, 0x04, 0xb2, 0xf5, 0x21, 0xdc, 0x7a,
-	0xce, 0x2e, 0xfc, 0x56, 0x8f, 0x78, 0x78, 0x8a, 0x43, 0xea, 0xa0, 0xee, 0x45, 0x14, 0x05, 0xfc,
-	0xcc, 0xb6, 0x37, 0xff, 0x46, 0xad, 0x1d, 0xb5, 0x71, 0x10, 0x34, 0xef, 0x7c, 0x34, 0xd2, 0xfa,
-	0x6f, 0x26, 0x97, 0xbd, 0xc3, 0xa6, 0xdd, 0x9d, 0x87, 0x9d, 0x07, 0x27, 0x25, 0xa3, 0xd4, 0x3d,
-	0xc0, 0x41, 0xe0, 0x51, 0x47, 0xdc, 0xfb, 0xf6, 0x47, 0x9c, 0xf9, 0x67, 0x2b, 0x5f, 0x7e, 0xf9,
-	0x78, 0x7b, 0x8f, 0xb6, 0xfc, 0x9f, 0xc6, 0xd3, 0x60, 0x34, 0xaa, 0x88, 0x56, 0xf3, 0xd1, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x48, 0x1a, 0x3d, 0xc0, 0xe7, 0x18, 0x00, 0x00,
+	// 4988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5c, 0x5d, 0x70, 0x1c, 0x57,
+	0x56, 0x56, 0x8f, 0xe6, 0xf7, 0x8c, 0x7e, 0xc6, 0xd7, 0xfa, 0x99, 0x19, 0xc7, 0xf6, 0xa8, 0xfd,
+	0x83, 0x22, 0x47, 0x33, 0xb6, 0xec, 0xcd, 0xc6, 0xf2, 0x2e, 0x59, 0xfd, 0x8c, 0xb3, 0x4a, 0x2c,
+	0xcb, 0xb4, 0x9c, 0x04, 0xc8, 0x26, 0x43, 0x6b, 0xfa, 0x6a, 0xd4, 0xd1, 0x4c, 0xf7, 0xa4, 0xbb,
+	0xc7, 0x8e, 0x36, 0x65, 0xc8, 0x86, 0x85, 0xc0, 0x42, 0x6d, 0x81, 0x09, 0x55, 0x14, 0x0f, 0x3c,
+	0xc2, 0x3b, 0xf0, 0x22, 0x3d, 0xe5, 0x71, 0x8b, 0x2a, 0x8a, 0xaa, 0xa5, 0x8a, 0x07, 0x78, 0x5b,
+	0xa8, 0x62, 0x29, 0x0a, 0x8a, 0x47, 0xde, 0xa0, 0xee, 0x4f, 0xff, 0xdd, 0xe9, 0xf9, 0xd1, 0x68,
+	0x77, 0x2b, 0x95, 0xbc, 0xa8, 0xa6, 0x6f, 0x9f, 0x7b, 0xce, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0x77,
+	0xcf, 0x3d, 0x2d, 0x98, 0x6d, 0x1f, 0x36, 0x2a, 0xed, 0xbd, 0x4a, 0xdd, 0x34, 0x1c, 0xb5, 0xee,
+	0xd8, 0xe5, 0xb6, 0x65, 0x3a, 0x26, 0x9a, 0x50, 0xdb, 0x7a, 0xd9, 0x6d, 0x2b, 0x96, 0x1a, 0xa6,
+	0xd9, 0x68, 0xe2, 0x0a, 0x7d, 0xb7, 0xd7, 0xd9, 0xaf, 0xec, 0xeb, 0xb8, 0xa9, 0xd5, 0x5a, 0xaa,
+	0x7d, 0xc8, 0xe8, 0x8b, 0x97, 0x45, 0x0a, 0x47, 0x6f, 0x61, 0xdb, 0x51, 0x5b, 0x6d, 0x4e, 0xf0,
+	0x02, 0x27, 0x50, 0xdb, 0x7a, 0x45, 0x35, 0x0c, 0xd3, 0x51, 0x1d, 0xdd, 0x34, 0xb8, 0xb8, 0xe2,
+	0xbd, 0x86, 0xee, 0x1c, 0x74, 0xf6, 0xca, 0x75, 0xb3, 0x55, 0x69, 0x1e, 0xed, 0x3b, 0x8c, 0x4f,
+	0x7d, 0xb9, 0x81, 0x8d, 0xe5, 0x27, 0x6a, 0x53, 0xd7, 0x54, 0x07, 0x57, 0xba, 0x7e, 0xf0, 0xce,
+	0x2f, 0x05, 0x88, 0xed, 0xa7, 0x6a, 0xa3, 0x81, 0xad, 0x8a, 0xd9, 0xa6, 0xec, 0x23, 0x44, 0xad,
+	0x06, 0x44, 0xe9, 0xc6, 0xbe, 0xb9, 0xd7, 0x34, 0x3f, 0x34, 0xdb, 0xd8, 0x08, 0x8a, 0x6c, 0x98,
+	0x56, 0xcb, 0x63, 0x41, 0x1e, 0x78, 0xdf, 0xbb, 0xc3, 0xf6, 0x75, 0x8e, 0xda, 0xd8, 0x66, 0x7f,
+	0x79, 0xd7, 0xd7, 0x7b, 0x75, 0x55, 0x9d, 0xa6, 0x6a, 0x2f, 0xab, 0xed, 0xf6, 0xb2, 0x63, 0x9a,
+	0xcd, 0x43, 0xdd, 0xa9, 0x7c, 0xd0, 0xc1, 0xd6, 0x51, 0xa5, 0x6e, 0x36, 0x9b, 0xb8, 0x4e, 0x54,
+	0xa8, 0x99, 0x6d, 0x6c, 0xa9, 0x8e, 0x69, 0xb9, 0xbc, 0xaa, 0xc3, 0xf3, 0xb2, 0xda, 0xf5, 0x8a,
+	0x85, 0x6d, 0xb3, 0x63, 0xd5, 0xb1, 0xf7, 0x83, 0xb3, 0x79, 0x70, 0x3a, 0x36, 0xd8, 0xb2, 0x34,
+	0xec, 0xa8, 0x7a, 0xd3, 0x26, 0x3f, 0x4d, 0xab, 0xc6, 0x9f, 0x18, 0x37, 0xf9, 0xb3, 0x71, 0x48,
+	0x3d, 0xb2, 0xcc, 0x7d, 0xbd, 0x89, 0xd1, 0xd7, 0x21, 0xa6, 0x6b, 0x79, 0xa9, 0x24, 0x2d, 0x66,
+	0x57, 0x66, 0xcb, 0x94, 0x5d, 0xd9, 0x6a, 0xd7, 0xcb, 0x5b, 0x1a, 0x36, 0x1c, 0x7d, 0x5f, 0xc7,
+	0xd6, 0x7a, 0xee, 0xe4, 0xb8, 0x30, 0x01, 0x80, 0x92, 0x36, 0xb6, 0x74, 0xb5, 0xb9, 0x28, 0x29,
+	0x31, 0x5d, 0x43, 0x08, 0xe2, 0x86, 0xda, 0xc2, 0xf9, 0x58, 0x49, 0x5a, 0xcc, 0x28, 0xf4, 0x37,
+	0x9a, 0x81, 0x84, 0x61, 0x3a, 0xd8, 0xce, 0x8f, 0xd3, 0x46, 0xf6, 0x80, 0x6e, 0x41, 0xda, 0x75,
+	0xcf, 0x7c, 0xbc, 0x34, 0xce, 0x04, 0x05, 0x7c, 0xb6, 0xbc, 0xc1, 0x7e, 0x28, 0x1e, 0x19, 0xba,
+	0x01, 0xc9, 0x86, 0x65, 0x76, 0xda, 0x76, 0x3e, 0x41, 0x3b, 0x9c, 0x0f, 0x77, 0x78, 0x8d, 0xbc,
+	0x53, 0x38, 0x09, 0x2a, 0x42, 0x1c, 0x3b, 0x6a, 0x23, 0x9f, 0x24, 0x42, 0xd7, 0x93, 0x27, 0xc7,
+	0x85, 0x58, 0x4e, 0x52, 0x68, 0x1b, 0xba, 0x0b, 0x50, 0xb7, 0xb0, 0xea, 0x60, 0xad, 0xa6, 0x3a,
+	0xf9, 0x14, 0x1d, 0x66, 0xb1, 0xcc, 0x1c, 0xbc, 0xec, 0xae, 0x80, 0xf2, 0x63, 0x77, 0x05, 0x28,
+	0x19, 0x4e, 0xbd, 0xe6, 0x90, 0xae, 0x9d, 0xb6, 0xe6, 0x76, 0x4d, 0x0f, 0xee, 0xca, 0xa9, 0xd7,
+	0x9c, 0xd5, 0x3b, 0x27, 0xc7, 0x85, 0x9b, 0x69, 0x09, 0xe5, 0x01, 0x96, 0xc8, 0xea, 0xa2, 0x54,
+	0x08, 0x34, 0xdc, 0xc4, 0x8c, 0x1d, 0xca, 0x41, 0x42, 0x37, 0x9c, 0x97, 0xef, 0xa0, 0xd4, 0x13,
+	0x6c, 0xd9, 0xba, 0x69, 0x94, 0x24, 0xf9, 0x35, 0x98, 0xd9, 0xa0, 0xd2, 0xf9, 0xdc, 0x28, 0xf8,
+	0x83, 0x0e, 0xb6, 0x1d, 0x54, 0x81, 0x54, 0x5b, 0x3d, 0x6a, 0x9a, 0x6a, 0x60, 0x9e, 0x82, 0xd6,
+	0x70, 0xc9, 0x5d, 0x2a, 0xf9, 0x3e, 0xcc, 0x0a, 0x8c, 0xec, 0xb6, 0x69, 0xd8, 0x18, 0x2d, 0x43,
+	0xd2, 0xc2, 0x76, 0xa7, 0xe9, 0xf4, 0x67, 0xc4, 0x89, 0xe4, 0x7b, 0x80, 0x14, 0xac, 0x6a, 0x82,
+	0x3a, 0xd7, 0x06, 0x7a, 0x0c, 0xf1, 0x0f, 0x79, 0x13, 0xce, 0x87, 0x3a, 0x8f, 0xa6, 0xc2, 0x6b,
+	0x30, 0xf3, 0x26, 0x35, 0xeb, 0xcf, 0xc0, 0x26, 0x02, 0xa3, 0xd1, 0x14, 0xfa, 0x26, 0xcc, 0x6c,
+	0xd2, 0x69, 0x1c, 0xcd, 0x2a, 0xf3, 0x30, 0x2b, 0x74, 0x67, 0x6a, 0xc8, 0xaf, 0xc2, 0xdc, 0x9b,
+	0x86, 0x76, 0x06, 0xce, 0xdf, 0x86, 0xf9, 0x2e, 0x06, 0xa3, 0x0d, 0xf1, 0x93, 0x18, 0xa0, 0x07,
+	0xba, 0xed, 0x74, 0x99, 0x3c, 0xb9, 0xaf, 0x37, 0x1d, 0x6c, 0x71, 0x2e, 0xf3, 0x65, 0x37, 0x12,
+	0x51, 0x76, 0xf7, 0xe9, 0x3b, 0xdd, 0x68, 0x28, 0x9c, 0x0c, 0xdd, 0x84, 0xb4, 0x69, 0x69, 0xd8,
+	0xaa, 0xed, 0x1d, 0xd1, 0x28, 0x41, 0x04, 0x87, 0xba, 0xec, 0x9a, 0x96, 0x43, 0x3a, 0xa4, 0x28,
+	0xd9, 0xfa, 0x11, 0xba, 0x43, 0x44, 0xe0, 0xa6, 0xc6, 0x02, 0x48, 0x76, 0xe5, 0x05, 0x51, 0x04,
+	0x6e, 0x6a, 0xbb, 0x98, 0xc7, 0x5a, 0x85, 0xd3, 0xa2, 0x9b, 0x90, 0x6c, 0xab, 0x0d, 0xdd, 0x68,
+	0xe4, 0xe3, 0xb4, 0x57, 0x3e, 0xdc, 0xeb, 0x11, 0x79, 0xa7, 0xb2, 0x1e, 0x8c, 0x0e, 0x2d, 0xc0,
+	0x84, 0x7d, 0x60, 0x3e, 0xad, 0xf1, 0x05, 0x99, 0x4f, 0x94, 0xa4, 0xc5, 0xb4, 0x92, 0x25, 0x6d,
+	0x6c, 0x76, 0x34, 0xe2, 0x78, 0x01, 0x1b, 0xd8, 0x9e, 0x2d, 0x2b, 0x90, 0x62, 0x66, 0xb2, 0xf3,
+	0x52, 0x54, 0x2c, 0xf3, 0x1c, 0x8f, 0x53, 0xc9, 0x3f, 0x8c, 0x41, 0x31, 0xc0, 0x89, 0xc7, 0x3a,
+	0xfb, 0x74, 0xb3, 0x1b, 0x30, 0x7e, 0xec, 0xf4, 0xc6, 0x1f, 0x3f, 0xa5, 0xf1, 0xe3, 0x23, 0x19,
+	0x3f, 0x31, 0x9c, 0xf1, 0xe5, 0x3f, 0x8c, 0x41, 0x3e, 0x60, 0x10, 0x1a, 0xcb, 0xbf, 0xc2, 0xe6,
+	0xe8, 0xc0, 0x85, 0x50, 0xb0, 0x76, 0x37, 0xc3, 0xd3, 0x1a, 0xc4, 0x8b, 0x87, 0xb1, 0xa8, 0x35,
+	0xee, 0x72, 0xf5, 0xe2, 0xe1, 0x8f, 0xc6, 0x21, 0x41, 0x4d, 0xff, 0x8b, 0x40, 0x00, 0x77, 0x00,
+	0xda, 0x6c, 0x74, 0x35, 0x5d, 0xe3, 0xf6, 0xec, 0x31, 0x98, 0x0c, 0x27, 0xdc, 0xd2, 0xd0, 0xdd,
+	0x00, 0x6e, 0x48, 0xf4, 0xc1, 0x0d, 0x6c, 0xcb, 0x5f, 0x19, 0x0b, 0xe0, 0x87, 0x2f, 0x1c, 0x24,
+	0x40, 0x57, 0x60, 0xb2, 0x85, 0x5b, 0x7b, 0xd8, 0xaa, 0x71, 0xc7, 0xcd, 0x50, 0x03, 0x4d, 0xb0,
+	0x46, 0xe6, 0xb0, 0x23, 0xe2, 0x86, 0x0d, 0x40, 0xcc, 0x83, 0x18, 0x2c, 0xe2, 0x8e, 0xb3, 0x2c,
+	0xee, 0x90, 0x91, 0x18, 0xca, 0xf3, 0x87, 0x75, 0x38, 0x1f, 0x62, 0xc2, 0xc3, 0xdd, 0x0d, 0x61,
+	0xeb, 0x88, 0x06, 0x62, 0x7c, 0xe3, 0xb8, 0x0b, 0x39, 0xb2, 0xe5, 0x87, 0xd4, 0x18, 0x72, 0xf7,
+	0xfa, 0x16, 0x9c, 0x0b, 0x74, 0x1d, 0x45, 0xf8, 0x06, 0x20, 0xb6, 0xc1, 0x9f, 0xd1, 0x0a, 0x21,
+	0x26, 0xa3, 0x28, 0x72, 0x0f, 0x10, 0xdb, 0x44, 0x46, 0xb1, 0xc3, 0x2c, 0x9c, 0x0f, 0x75, 0xe6,
+	0xe8, 0xe0, 0x9b, 0x30, 0xe3, 0x6e, 0xee, 0xa3, 0x70, 0xdd, 0x84, 0x59, 0xa1, 0xfb, 0x28, 0x03,
+	0xfb, 0x38, 0x06, 0x39, 0x12, 0xb8, 0x43, 0x1a, 0x7c, 0xb5, 0x50, 0xc1, 0x06, 0x43, 0x46, 0xee,
+	0x9e, 0xe5, 0xe1, 0x2b, 0x01, 0x13, 0x44, 0x3b, 0x99, 0x8b, 0x08, 0x3e, 0x95, 0x60, 0x7e, 0x4d,
+	0x63, 0xbe, 0x3e, 0x22, 0x1c, 0xa8, 0x42, 0x96, 0x73, 0xaf, 0xe9, 0x9a, 0x9d, 0x8f, 0xb9, 0xd1,
+	0x31, 0x2a, 0x78, 0x4f, 0x9e, 0xfc, 0xc7, 0xe7, 0xe3, 0xe9, 0xe7, 0x52, 0x22, 0x2d, 0xe5, 0x7e,
+	0x9a, 0x52, 0x80, 0x77, 0xdc, 0xd2, 0x6c, 0xb9, 0x08, 0xf9, 0x6e, 0x45, 0xb8, 0xcb, 0xfd, 0x40,
+	0x82, 0xa2, 0x82, 0x5b, 0xe6, 0x13, 0xfc, 0x05, 0x50, 0xf4, 0x22, 0x5c, 0x88, 0xd4, 0x85, 0xeb,
+	0xfa, 0x07, 0x31, 0x98, 0xf7, 0xe6, 0x65, 0x9b, 0x46, 0xd4, 0xaf, 0x30, 0xa2, 0x78, 0x83, 0xe1,
+	0xab, 0xb0, 0x31, 0x86, 0x84, 0xaf, 0x1e, 0x4e, 0x70, 0x9d, 0xf5, 0x6f, 0x53, 0x90, 0xe2, 0x8d,
+	0xa3, 0x23, 0x85, 0x8b, 0x00, 0xfb, 0xba, 0x65, 0x3b, 0xb5, 0x00, 0x5e, 0xc8, 0xd0, 0x96, 0x87,
+	0x04, 0x34, 0x5c, 0x86, 0x6c, 0x4b, 0xd7, 0xb4, 0x26, 0x66, 0xef, 0x19, 0x74, 0x00, 0xd6, 0x44,
+	0x09, 0x2e, 0x40, 0xa6, 0xa9, 0xba, 0xdd, 0xe3, 0xf4, 0x75, 0x9a, 0x34, 0xd0, 0x97, 0x77, 0x60,
+	0xb2, 0x6d, 0xe9, 0x2d, 0xd5, 0x3a, 0xaa, 0xe1, 0x96, 0xaa, 0x37, 0xa9, 0x9d, 0x32, 0xeb, 0xd3,
+	0x6c, 0xd3, 0x27, 0x3e, 0x15, 0xb7, 0x62, 0xbf, 0x21, 0x29, 0x13, 0x9c, 0xaa, 0x4a, 0x88, 0x7c,
+	0xa0, 0x92, 0x0c, 0x02, 0x95, 0x1b, 0x90, 0xa4, 0x3c, 0xec, 0x7c, 0x2a, 0x6a, 0x21, 0xd3, 0xae,
+	0x0a, 0x27, 0x41, 0xaf, 0xc0, 0xc4, 0x81, 0xd9, 0xc2, 0x35, 0x55, 0xd3, 0x2c, 0x6c, 0xdb, 0x1c,
+	0x0f, 0x08, 0x06, 0x5d, 0x63, 0x2f, 0x95, 0x2c, 0x21, 0xe5, 0x0f, 0xa4, 0xe7, 0x53, 0xd3, 0x3a,
+	0xf4, 0x7a, 0x66, 0xfa, 0xf6, 0x24, 0xa4, 0x6e, 0xcf, 0x30, 0x92, 0x82, 0x21, 0x91, 0xd4, 0x86,
+	0x97, 0x4e, 0xc9, 0xf6, 0x8c, 0x4f, 0xeb, 0x73, 0x27, 0xc7, 0x05, 0xb4, 0x92, 0x83, 0x29, 0x4a,
+	0x5a, 0x73, 0xdf, 0x7a, 0x69, 0x96, 0xdb, 0x90, 0x31, 0xf4, 0xfa, 0x21, 0x99, 0x03, 0x3b, 0x3f,
+	0xc1, 0x25, 0xd3, 0x8c, 0x1b, 0x4b, 0x9e, 0xbd, 0xbe, 0xbb, 0xf3, 0xf0, 0x2d, 0xb5, 0xd9, 0xc1,
+	0x8a, 0x4f, 0x87, 0x7e, 0x19, 0x26, 0xdb, 0x07, 0xa6, 0x81, 0x6b, 0x46, 0x87, 0x3a, 0x62, 0x7e,
+	0x92, 0x2a, 0x50, 0x10, 0x0e, 0x4d, 0x84, 0xe4, 0x21, 0xa5, 0x50, 0x26, 0xda, 0xfe, 0x83, 0x8d,
+	0xde, 0xf0, 0x27, 0x97, 0xb6, 0xe7, 0xa7, 0xe8, 0xe4, 0x5e, 0xf7, 0x27, 0xf7, 0x82, 0x55, 0x58,
+	0x99, 0x7f, 0x6f, 0xf1, 0x3b, 0x37, 0xde, 0xb9, 0xb5, 0x7c, 0xf7, 0xdd, 0x77, 0x6e, 0x2e, 0xdf,
+	0x7d, 0xf7, 0xa3, 0x5b, 0x2f, 0xdd, 0xba, 0xf3, 0xec, 0xc5, 0x57, 0xaf, 0x7a, 0x73, 0x4e, 0x05,
+	0x78, 0xa8, 0x70, 0x7a, 0x20, 0x2a, 0xcc, 0x8d, 0x8e, 0x0a, 0xcf, 0x9d, 0x06, 0x15, 0x2e, 0xc0,
+	0x44, 0x5d, 0xb5, 0x34, 0x4d, 0x7d, 0xc2, 0x7c, 0x1b, 0x51, 0x67, 0xcc, 0xf2, 0x36, 0xe2, 0xde,
+	0x23, 0x62, 0xc2, 0x77, 0x20, 0xc1, 0xfc, 0x7c, 0xca, 0x5b, 0xb3, 0x71, 0xba, 0x14, 0xaf, 0x40,
+	0xca, 0xf5, 0x3a, 0xba, 0x0e, 0xd7, 0x33, 0xfe, 0x0a, 0x71, 0xdf, 0xac, 0x5e, 0x3c, 0x39, 0x2e,
+	0x14, 0xd2, 0x12, 0x3a, 0x0f, 0x89, 0xa5, 0x3d, 0xd3, 0x6c, 0x22, 0xd0, 0xed, 0x1a, 0x37, 0x65,
+	0x49, 0x92, 0xff, 0x41, 0x82, 0x6c, 0x60, 0xca, 0xba, 0x64, 0x7c, 0x0d, 0x92, 0x6c, 0xba, 0xb9,
+	0x88, 0x8b, 0x44, 0x44, 0xde, 0x9a, 0x5b, 0x99, 0x79, 0xaf, 0x7b, 0x9a, 0xae, 0x2a, 0x9c, 0x18,
+	0xad, 0x40, 0x9c, 0x78, 0x12, 0x5d, 0xff, 0x53, 0x2b, 0x97, 0x7a, 0xba, 0x48, 0xf9, 0xf1, 0x51,
+	0x1b, 0x2b, 0x94, 0x56, 0xbe, 0x0e, 0x71, 0xf2, 0x84, 0x00, 0x92, 0xdb, 0x3b, 0xeb, 0x5b, 0x0f,
+	0xaa, 0xb9, 0x31, 0x94, 0x86, 0xf8, 0xb7, 0x77, 0xb6, 0xab, 0x39, 0x89, 0xfc, 0x7a, 0x7b, 0x47,
+	0x79, 0x23, 0x17, 0x1b, 0x34, 0xa2, 0xdf, 0x96, 0x20, 0xe5, 0x2e, 0xb1, 0xbc, 0x6f, 0x21, 0x89,
+	0x4e, 0x87, 0xfb, 0x48, 0x0e, 0x3c, 0x75, 0xdd, 0x39, 0x72, 0x0f, 0x3c, 0xe4, 0x37, 0x89, 0x23,
+	0xb6, 0xa3, 0x3a, 0x6e, 0xd4, 0x62, 0x0f, 0x28, 0x07, 0xe3, 0xdf, 0xd5, 0xdb, 0x3c, 0x54, 0x91,
+	0x9f, 0x84, 0x6b, 0xdd, 0xec, 0x18, 0x8e, 0x75, 0xc4, 0xe2, 0x93, 0xe2, 0x3e, 0xae, 0xa6, 0x4f,
+	0x8e, 0x0b, 0xf1, 0xb4, 0x14, 0x4c, 0x00, 0x0a, 0x67, 0xc0, 0x41, 0xc9, 0xae, 0xae, 0xc3, 0x9d,
+	0x97, 0x00, 0xf4, 0x18, 0x0d, 0x97, 0x09, 0x72, 0xc9, 0x85, 0x04, 0xe0, 0x48, 0x47, 0x52, 0x37,
+	0x01, 0x78, 0x46, 0x15, 0x3e, 0x72, 0x13, 0x80, 0x67, 0xb4, 0x09, 0x5a, 0xf1, 0x76, 0xdf, 0x58,
+	0x8f, 0x15, 0x4a, 0x37, 0xe0, 0x6d, 0xd5, 0x3e, 0x74, 0xf7, 0x5e, 0x3f, 0x69, 0x78, 0xc6, 0x41,
+	0x78, 0x49, 0xc3, 0xd1, 0x2c, 0xe9, 0x25, 0x0d, 0x05, 0x35, 0x82, 0x49, 0xc3, 0xd1, 0x38, 0x07,
+	0x92, 0x86, 0x67, 0x1c, 0x22, 0xcf, 0x97, 0x89, 0xd0, 0xec, 0xf4, 0x80, 0x63, 0x07, 0x60, 0x77,
+	0x7b, 0xd7, 0x1d, 0x47, 0xc1, 0x0f, 0x2d, 0x3c, 0x52, 0xad, 0xc4, 0x4a, 0x63, 0x34, 0xca, 0x5c,
+	0x87, 0x54, 0x0b, 0xdb, 0xb6, 0xda, 0xe0, 0x88, 0x62, 0x7d, 0x82, 0xbc, 0x4f, 0x59, 0x89, 0x9c,
+	0x94, 0xff, 0x7c, 0x42, 0x71, 0x5f, 0xca, 0xf7, 0x21, 0x4b, 0x19, 0x72, 0x85, 0x2e, 0x43, 0x56,
+	0xc3, 0x4d, 0xfd, 0x09, 0xb6, 0x8e, 0x6a, 0x9c, 0x75, 0x46, 0x01, 0xb7, 0x69, 0x4b, 0x43, 0x73,
+	0x90, 0x24, 0x8b, 0xb8, 0xc3, 0x03, 0xa4, 0xc2, 0x9f, 0x64, 0x0b, 0xce, 0xef, 0x1e, 0x19, 0x75,
+	0x11, 0x08, 0x5f, 0x04, 0xb0, 0x8f, 0x8c, 0x7a, 0xcd, 0x31, 0x0f, 0xb1, 0xc1, 0xd9, 0x65, 0x48,
+	0xcb, 0x63, 0xd2, 0x80, 0x64, 0x48, 0x34, 0xf5, 0x96, 0xee, 0x50, 0x66, 0x09, 0xae, 0x63, 0x31,
+	0x91, 0xff, 0x69, 0x6a, 0x71, 0x4c, 0x61, 0xaf, 0x68, 0x6c, 0xe8, 0x58, 0x16, 0x36, 0x1c, 0x1a,
+	0x45, 0xd2, 0x8a, 0xfb, 0x28, 0xff, 0xb5, 0x04, 0x33, 0x61, 0xa1, 0x23, 0x9a, 0x95, 0x74, 0x70,
+	0xcf, 0x35, 0xfd, 0x40, 0xb8, 0xe2, 0x52, 0x09, 0xe3, 0x1a, 0x17, 0xc7, 0x55, 0x80, 0xf4, 0x81,
+	0x6a, 0xd7, 0x5a, 0xa6, 0xc5, 0x10, 0x59, 0x5a, 0x49, 0x1d, 0xa8, 0xf6, 0xb6, 0x69, 0x61, 0xb9,
+	0x0a, 0x33, 0x6f, 0xab, 0x4e, 0xfd, 0x40, 0xb4, 0xd4, 0x32, 0x4c, 0x52, 0x14, 0x87, 0x9f, 0x60,
+	0xc3, 0x71, 0x6d, 0x3f, 0xce, 0xa7, 0x55, 0x8e, 0x2d, 0x8e, 0x29, 0x59, 0xf2, 0xbe, 0x4a, 0x5e,
+	0x6f, 0x69, 0xf2, 0x7f, 0x4b, 0x30, 0xc1, 0x59, 0xd0, 0xa6, 0xc0, 0x36, 0x33, 0x4e, 0x1d, 0xe0,
+	0x36, 0xdf, 0x2f, 0x62, 0x74, 0xbf, 0xb8, 0x1c, 0x69, 0x00, 0xda, 0x33, 0xb0, 0x61, 0x10, 0x3b,
+	0x70, 0x1a, 0x0f, 0xb5, 0x47, 0x1b, 0x8e, 0xb7, 0x08, 0xc0, 0x20, 0x7e, 0x0a, 0x60, 0x20, 0x2f,
+	0xf3, 0xcd, 0x29, 0x0b, 0xa9, 0x0d, 0xa5, 0xba, 0xf6, 0xb8, 0xba, 0x99, 0x1b, 0x23, 0x0f, 0x6f,
+	0x3e, 0xda, 0xa4, 0x0f, 0x12, 0x79, 0xd8, 0xac, 0x3e, 0xa8, 0x92, 0x87, 0x98, 0x8c, 0x61, 0x76,
+	0x17, 0xab, 0x56, 0xb7, 0xe1, 0x8a, 0x20, 0x7d, 0xc0, 0x3c, 0x2b, 0xe8, 0xe3, 0x1f, 0xa7, 0x15,
+	0xe9, 0x83, 0xc0, 0xf1, 0x20, 0x36, 0xe4, 0xf1, 0xc0, 0x81, 0xf3, 0x5c, 0x00, 0x93, 0xa6, 0x50,
+	0x0f, 0x09, 0x1a, 0x46, 0x1a, 0xca, 0x30, 0x08, 0xe2, 0x96, 0x6a, 0x1c, 0x52, 0xb9, 0x31, 0x85,
+	0xfe, 0x26, 0x9e, 0x6c, 0x1b, 0x7a, 0xbb, 0x8d, 0x1d, 0xee, 0x31, 0xee, 0xa3, 0xfc, 0x26, 0xcc,
+	0x89, 0x83, 0xe3, 0xae, 0x7c, 0x4f, 0x74, 0xe5, 0x85, 0x48, 0xc1, 0x41, 0x65, 0xfd, 0x68, 0xf1,
+	0x36, 0xcc, 0xed, 0x76, 0x1a, 0x0d, 0x1c, 0x8c, 0x3c, 0xfd, 0x8c, 0xf6, 0x7f, 0x12, 0x31, 0xda,
+	0x42, 0x78, 0x51, 0x66, 0xc9, 0xfb, 0x64, 0x31, 0x9e, 0xd7, 0xbc, 0x35, 0x29, 0x6b, 0x70, 0xce,
+	0x15, 0xcc, 0xf8, 0xeb, 0xa6, 0x71, 0x7a, 0x1b, 0x5d, 0x02, 0xb0, 0xf5, 0x96, 0xde, 0x54, 0x2d,
+	0x17, 0x37, 0xc4, 0x94, 0x40, 0x8b, 0xfc, 0x18, 0xe6, 0xbb, 0xd4, 0xe7, 0x66, 0xb9, 0x2b, 0x9a,
+	0x25, 0xda, 0xc1, 0x7d, 0xed, 0x7c, 0xa3, 0xfc, 0x0a, 0xcc, 0xde, 0xd7, 0x0d, 0x6d, 0xb3, 0xd3,
+	0x6e, 0xea, 0x75, 0xd5, 0xc1, 0x9e, 0x4d, 0x5e, 0x81, 0xa9, 0x96, 0x6e, 0x10, 0x68, 0xbf, 0xaf,
+	0x6b, 0xd8, 0xa8, 0x63, 0x3a, 0x8c, 0xd8, 0xfa, 0x39, 0x62, 0x80, 0x09, 0x80, 0x8b, 0x63, 0x63,
+	0x1f, 0xbf, 0xba, 0x3c, 0x36, 0x36, 0x36, 0xa6, 0x4c, 0xb6, 0x74, 0x63, 0xc3, 0xa3, 0x93, 0x9f,
+	0xc1, 0x94, 0xc7, 0x8e, 0xa5, 0x8d, 0x83, 0xb7, 0xba, 0xd2, 0x70, 0xb7, 0xba, 0x97, 0x00, 0x02,
+	0xa2, 0xb9, 0x35, 0xfc, 0x16, 0xe2, 0x3d, 0x16, 0x56, 0x6d, 0xd3, 0xb0, 0xf3, 0xe3, 0xa5, 0x71,
+	0xe2, 0x3d, 0xfc, 0x51, 0x7e, 0x04, 0x73, 0xe2, 0x88, 0xb8, 0x99, 0x5e, 0x16, 0xcd, 0xf4, 0x42,
+	0x58, 0x8b, 0xb0, 0xd6, 0xbe, 0x8d, 0x4e, 0x24, 0x98, 0xd9, 0xc6, 0x56, 0x63, 0xd4, 0x0b, 0x99,
+	0x75, 0x80, 0x16, 0xe9, 0xae, 0x0d, 0xce, 0x6b, 0x30, 0xf7, 0x7b, 0x2e, 0xc5, 0xd3, 0x52, 0x4e,
+	0x53, 0x32, 0xac, 0xdb, 0x96, 0x66, 0xa3, 0x2b, 0xe2, 0xc9, 0x95, 0xad, 0x99, 0xf0, 0x41, 0x15,
+	0xf1, 0x43, 0x0b, 0xc3, 0x92, 0xf4, 0x37, 0xc1, 0x25, 0x82, 0xee, 0xa3, 0x6d, 0xda, 0xee, 0x4d,
+	0x5f, 0x17, 0xb6, 0xfa, 0x2a, 0xe5, 0xf4, 0xfe, 0x48, 0x82, 0xe2, 0x3a, 0xdd, 0xaf, 0x82, 0x90,
+	0xd9, 0xf3, 0x87, 0x6a, 0x10, 0x68, 0xf6, 0xb9, 0x84, 0x98, 0x21, 0xb3, 0x3c, 0xfd, 0x5c, 0x9a,
+	0xa0, 0xd9, 0x2b, 0x39, 0xf1, 0x03, 0x29, 0x96, 0x96, 0x7c, 0xf8, 0x79, 0x03, 0xe2, 0x2d, 0x53,
+	0x73, 0x37, 0xab, 0xf9, 0x30, 0x0f, 0x2a, 0x7e, 0xdb, 0xd4, 0xb0, 0x42, 0x89, 0xe4, 0x67, 0x70,
+	0x21, 0x52, 0xa3, 0x51, 0x37, 0xff, 0x65, 0x48, 0xd2, 0x3a, 0x90, 0x28, 0x47, 0x7d, 0xac, 0x5a,
+	0x0d, 0xec, 0x6c, 0x19, 0xfb, 0xa6, 0xc2, 0x89, 0x7c, 0x8b, 0x84, 0xc0, 0xef, 0x17, 0xc2, 0x22,
+	0xa2, 0x46, 0xbf, 0x20, 0x8b, 0x7c, 0xcf, 0xb5, 0x48, 0x08, 0x87, 0x7b, 0x16, 0x79, 0x19, 0xc6,
+	0x49, 0x14, 0x90, 0x4e, 0x91, 0xdd, 0x24, 0x1d, 0x4e, 0x67, 0x82, 0x07, 0xdc, 0x04, 0xa2, 0x0a,
+	0xfe, 0xd2, 0xe7, 0x23, 0x92, 0x86, 0x19, 0xd1, 0x7f, 0x49, 0x30, 0x5b, 0xfd, 0xb0, 0x6d, 0x5a,
+	0x5d, 0x1b, 0xe7, 0x17, 0x76, 0xf5, 0xcf, 0x41, 0x72, 0xdf, 0xb4, 0x5a, 0x1c, 0x85, 0x65, 0x14,
+	0xfe, 0x44, 0xc2, 0xe8, 0x93, 0xba, 0x6a, 0x69, 0x35, 0x9e, 0xfe, 0xe0, 0x07, 0xec, 0x09, 0xda,
+	0xf8, 0x16, 0x6b, 0x93, 0x77, 0xd9, 0xdd, 0xd4, 0x5b, 0x1b, 0xaa, 0xa5, 0x9d, 0x32, 0xd4, 0xe7,
+	0xc1, 0x4d, 0xac, 0xf0, 0x13, 0x81, 0xfb, 0x28, 0xaf, 0x43, 0xe6, 0xbe, 0xde, 0xc4, 0x1b, 0x07,
+	0x1d, 0xe3, 0x90, 0xe6, 0x72, 0x4c, 0xc3, 0x21, 0xd8, 0x96, 0xc2, 0x52, 0x89, 0xe7, 0x72, 0x58,
+	0x1b, 0x05, 0x82, 0x08, 0xe2, 0x9a, 0xea, 0xa8, 0x94, 0xcd, 0x84, 0x42, 0x7f, 0xcb, 0x9f, 0x4b,
+	0x30, 0xb9, 0xd5, 0x22, 0x13, 0xb1, 0xc3, 0xaa, 0xd8, 0xd0, 0x3c, 0xa4, 0x34, 0xeb, 0xa8, 0x66,
+	0x75, 0xd8, 0x71, 0x22, 0xad, 0x24, 0x35, 0xeb, 0x48, 0xe9, 0x18, 0x68, 0x1d, 0x52, 0x2d, 0xb5,
+	0xdd, 0x66, 0x60, 0x8f, 0xcc, 0xf1, 0x62, 0xd8, 0x63, 0x42, 0x6c, 0xca, 0xdb, 0x8c, 0xb4, 0x6a,
+	0x38, 0xd6, 0x91, 0xe2, 0x76, 0x0c, 0x18, 0x71, 0x3c, 0x68, 0xc4, 0xe2, 0x2a, 0x4c, 0x04, 0x3b,
+	0xa0, 0x1c, 0x8c, 0x1f, 0xe2, 0x23, 0x3e, 0x08, 0xf2, 0x13, 0xcd, 0x40, 0xe2, 0x89, 0xda, 0xec,
+	0xb8, 0xf9, 0x5b, 0xf6, 0xb0, 0x1a, 0x7b, 0x45, 0x92, 0xf7, 0x60, 0x96, 0x89, 0x16, 0x5d, 0xe9,
+	0x6b, 0x90, 0xe2, 0xa5, 0x79, 0xdc, 0xca, 0x17, 0xfa, 0x28, 0xac, 0xb8, 0xb4, 0x91, 0x66, 0x52,
+	0x61, 0x4e, 0x94, 0xc1, 0x1d, 0x9f, 0x9c, 0x9e, 0x18, 0xe4, 0xa6, 0x42, 0x12, 0x8a, 0xfb, 0x78,
+	0xda, 0x45, 0xfe, 0x57, 0x31, 0x80, 0xb5, 0x8e, 0xa6, 0xf3, 0xe3, 0xc6, 0xc8, 0xd9, 0x6e, 0x02,
+	0x82, 0x3b, 0x7b, 0xef, 0xe3, 0xba, 0xe3, 0xfa, 0x0b, 0x7f, 0x24, 0x67, 0x2a, 0x8b, 0x99, 0x86,
+	0x1c, 0x7f, 0xf8, 0x99, 0x8a, 0xb7, 0xb0, 0x93, 0x67, 0x0b, 0x3b, 0x07, 0xa6, 0xe6, 0x3a, 0x38,
+	0x7b, 0x22, 0x47, 0x56, 0xb7, 0x1e, 0x90, 0xf4, 0x63, 0xee, 0x0d, 0x6e, 0xd3, 0x96, 0x86, 0x5e,
+	0x84, 0xb8, 0xa6, 0xef, 0xef, 0xd3, 0x5c, 0x76, 0xcf, 0xac, 0x2c, 0x25, 0x39, 0xc3, 0xed, 0x77,
+	0x20, 0x51, 0xf5, 0x13, 0x09, 0x66, 0x09, 0x6e, 0xf0, 0xad, 0xf5, 0xe5, 0x83, 0x0e, 0xf2, 0x36,
+	0xbb, 0x53, 0xf2, 0xc7, 0xe8, 0xbb, 0xdc, 0x8a, 0xb8, 0xdd, 0xe4, 0x85, 0xd4, 0xbd, 0x6f, 0x17,
+	0x0f, 0x70, 0xfe, 0x68, 0x1c, 0xce, 0xbf, 0x8d, 0xf7, 0x0e, 0x4c, 0xf3, 0x70, 0xb7, 0xb3, 0x67,
+	0xd7, 0x2d, 0x9d, 0x7a, 0xfb, 0xe8, 0x6e, 0xf6, 0x02, 0x8c, 0x77, 0xac, 0x26, 0xcf, 0x7d, 0x00,
+	0xd9, 0x5d, 0x12, 0xd6, 0xf8, 0xef, 0x49, 0x92, 0x42, 0x9a, 0xd1, 0xf7, 0x63, 0x90, 0x65, 0x07,
+	0x6d, 0xea, 0x07, 0x0c, 0x50, 0xaf, 0xff, 0x44, 0xf2, 0x13, 0xe7, 0xff, 0x24, 0x3d, 0x97, 0x7e,
+	0x2c, 0xe5, 0x25, 0xf9, 0xef, 0x25, 0xeb, 0xef, 0x24, 0x65, 0x9a, 0x5f, 0x17, 0x94, 0xf9, 0x84,
+	0xfb, 0x0d, 0x3c, 0x5d, 0xed, 0x37, 0x70, 0x30, 0xa5, 0x9c, 0xf3, 0x28, 0x0c, 0xb7, 0x69, 0x92,
+	0x5e, 0x16, 0x78, 0x3c, 0xf8, 0xa3, 0xcb, 0x81, 0x3f, 0xba, 0xc4, 0xd3, 0xfc, 0xad, 0xd7, 0x7b,
+	0x9a, 0x5b, 0xd0, 0xd7, 0xc1, 0x6d, 0xf0, 0x74, 0x70, 0x1b, 0x3c, 0x1d, 0x3c, 0x0a, 0x8f, 0x0b,
+	0xd0, 0x71, 0x93, 0x80, 0x4b, 0xf7, 0x0c, 0x1b, 0xd7, 0x2d, 0xec, 0xed, 0x19, 0xec, 0x09, 0x15,
+	0x21, 0xad, 0xe9, 0xb6, 0xba, 0xd7, 0xf4, 0x30, 0xa1, 0xf7, 0xbc, 0x7a, 0xf9, 0xe4, 0xb8, 0x70,
+	0x21, 0x2d, 0xa1, 0x59, 0x48, 0xda, 0x0e, 0x71, 0x56, 0x14, 0xb4, 0x64, 0x49, 0x92, 0xff, 0x39,
+	0x0e, 0xd3, 0x7c, 0x2a, 0x37, 0x79, 0xde, 0x68, 0xf4, 0x69, 0xbc, 0x0f, 0xd3, 0x76, 0xc0, 0x1f,
+	0xc8, 0x02, 0x8f, 0xf5, 0xe3, 0xe2, 0x5e, 0x5b, 0x4c, 0x05, 0x7b, 0x6d, 0xd1, 0x7c, 0x8d, 0xaf,
+	0xa5, 0x1b, 0x5b, 0x3c, 0x4b, 0x04, 0xf3, 0xa5, 0xf1, 0x7e, 0x51, 0xc2, 0x83, 0x67, 0xdf, 0xf0,
+	0xd2, 0x60, 0x09, 0x8a, 0x4e, 0xae, 0x86, 0x5d, 0x5c, 0x18, 0x7f, 0x79, 0x97, 0xd2, 0xba, 0xc9,
+	0x32, 0x62, 0x5f, 0xd5, 0x71, 0x70, 0xab, 0xed, 0xb0, 0x1b, 0xb6, 0x84, 0xe2, 0x3d, 0xa3, 0x45,
+	0xc8, 0xd1, 0x3c, 0x10, 0x23, 0xad, 0xd5, 0x09, 0x02, 0x4a, 0x51, 0x9a, 0x29, 0xd2, 0xce, 0x38,
+	0x6d, 0x98, 0x1a, 0x26, 0x63, 0x62, 0x19, 0x23, 0x12, 0xa0, 0xe9, 0xfd, 0x5a, 0x46, 0xa1, 0x37,
+	0x81, 0x55, 0xd2, 0x80, 0xd6, 0x61, 0xda, 0xc0, 0x1f, 0x3a, 0x35, 0xce, 0x99, 0x04, 0xb4, 0xcc,
+	0xc0, 0x80, 0x36, 0x49, 0xba, 0xac, 0xb1, 0x1e, 0x6b, 0x62, 0x7a, 0x07, 0x4e, 0x93, 0xde, 0xb9,
+	0x09, 0x49, 0xa6, 0x2b, 0xca, 0x42, 0xea, 0x51, 0xf5, 0xe1, 0xe6, 0xd6, 0xc3, 0xd7, 0x72, 0x63,
+	0x68, 0x12, 0x32, 0xbb, 0x6f, 0x6e, 0x6c, 0x54, 0xab, 0x9b, 0x34, 0xc5, 0x03, 0x90, 0xbc, 0xbf,
+	0xb6, 0xf5, 0xa0, 0xba, 0x99, 0x8b, 0xad, 0xca, 0x27, 0xc7, 0x85, 0x4b, 0xf4, 0x2e, 0x87, 0xdf,
+	0xd8, 0x88, 0x53, 0x5f, 0x92, 0xe4, 0x1a, 0x94, 0x18, 0xec, 0x8f, 0x08, 0x16, 0x6e, 0x94, 0xbd,
+	0x27, 0x26, 0xbf, 0x17, 0x22, 0x27, 0x27, 0xd4, 0xd5, 0xbb, 0x1c, 0x78, 0x0f, 0x16, 0xfa, 0x08,
+	0xf0, 0xb2, 0x0f, 0xe1, 0x83, 0xe4, 0x10, 0x02, 0xfc, 0x4c, 0xf0, 0x25, 0x82, 0xb4, 0xfa, 0xa8,
+	0x3f, 0x64, 0x72, 0xfa, 0x3b, 0x70, 0xb9, 0x27, 0xa3, 0xb3, 0xab, 0x59, 0x83, 0x12, 0x3b, 0x4c,
+	0xfc, 0x1c, 0xed, 0xdc, 0x47, 0xc0, 0xd9, 0x07, 0xb0, 0x05, 0x25, 0x76, 0x14, 0x38, 0xbb, 0xa5,
+	0xaf, 0xc0, 0x42, 0x1f, 0x56, 0xfc, 0xb2, 0xe1, 0x3f, 0x25, 0xb8, 0x44, 0x36, 0xc4, 0x3e, 0xe2,
+	0xbe, 0x44, 0xbb, 0x7f, 0x0d, 0x4a, 0x3d, 0x06, 0x3b, 0x7c, 0xe6, 0x32, 0xd2, 0x3d, 0x5c, 0x3c,
+	0xf0, 0xef, 0x12, 0xab, 0x0b, 0x16, 0x02, 0xe9, 0x97, 0xd0, 0x94, 0xbf, 0x0a, 0x17, 0xbb, 0x07,
+	0xaa, 0x07, 0x72, 0x78, 0x5f, 0x17, 0xed, 0x78, 0xb1, 0xef, 0x5e, 0xe3, 0xdb, 0xf0, 0x8f, 0x63,
+	0x90, 0xdd, 0x55, 0x9f, 0x60, 0x8d, 0x25, 0x87, 0x47, 0xdf, 0x84, 0x4b, 0xc1, 0x52, 0x56, 0x21,
+	0x5f, 0xcc, 0x0a, 0x5b, 0xbf, 0x11, 0x2a, 0xbc, 0x18, 0x1f, 0x66, 0x87, 0x0e, 0x14, 0x60, 0xcc,
+	0x79, 0xb3, 0xe9, 0x1e, 0x5d, 0xd9, 0xa4, 0x15, 0x02, 0x93, 0xc6, 0xaf, 0x85, 0xdd, 0xd9, 0x99,
+	0xf3, 0x66, 0x27, 0xe9, 0x76, 0x21, 0x4f, 0xab, 0x97, 0x4e, 0x8e, 0x0b, 0xc5, 0xb4, 0x84, 0x66,
+	0x20, 0xb9, 0xc4, 0x36, 0x91, 0x80, 0x62, 0x25, 0x49, 0xde, 0x81, 0x3c, 0x0b, 0xef, 0x01, 0xc3,
+	0xb8, 0x4e, 0x75, 0x5b, 0x8c, 0x67, 0x42, 0x1d, 0x46, 0xb0, 0x8b, 0x17, 0xc7, 0x1e, 0x42, 0x21,
+	0x82, 0x21, 0x9f, 0xba, 0x5b, 0x42, 0xfc, 0xea, 0xc3, 0xd0, 0x8d, 0x5b, 0xaf, 0xc2, 0x1c, 0x09,
+	0xeb, 0x11, 0xea, 0x0d, 0x19, 0xad, 0x1e, 0xc0, 0x7c, 0x17, 0x83, 0xd1, 0xd5, 0xd9, 0x81, 0x3c,
+	0x0b, 0xd3, 0x3f, 0x43, 0x7b, 0x45, 0x30, 0x1c, 0x5d, 0xc1, 0x35, 0xc8, 0xb3, 0xe0, 0x3c, 0xba,
+	0xc5, 0x2e, 0x40, 0x21, 0x82, 0x05, 0x8f, 0xeb, 0xff, 0x2a, 0xc1, 0x1c, 0x59, 0x9f, 0x11, 0xec,
+	0xbf, 0x44, 0x41, 0xe8, 0x11, 0x14, 0x84, 0x41, 0x06, 0x02, 0xd0, 0x6d, 0x31, 0x00, 0xf5, 0x9b,
+	0x67, 0x37, 0xf8, 0xb4, 0x61, 0x56, 0xe9, 0x18, 0x23, 0x4f, 0xca, 0xe9, 0x6f, 0xee, 0x96, 0x16,
+	0x21, 0xe3, 0x25, 0x05, 0x09, 0xae, 0x5c, 0x7b, 0xbc, 0xb3, 0xbd, 0xb5, 0x91, 0x1b, 0x43, 0xd3,
+	0x90, 0x5d, 0xaf, 0xee, 0x3e, 0xae, 0x55, 0xef, 0xdf, 0xdf, 0x51, 0x1e, 0xe7, 0xa4, 0x95, 0xe7,
+	0x19, 0x48, 0xbb, 0x9f, 0xae, 0xa0, 0x16, 0x24, 0xd9, 0x02, 0x46, 0xb2, 0x90, 0x15, 0x8d, 0xf8,
+	0xda, 0xac, 0x78, 0xa5, 0x2f, 0x0d, 0xf7, 0x99, 0xe2, 0x27, 0xff, 0xf8, 0x6f, 0x7f, 0x12, 0x9b,
+	0x91, 0x33, 0x15, 0x1e, 0x7b, 0xec, 0x55, 0xef, 0xe0, 0x60, 0x42, 0x9c, 0x2c, 0x4f, 0x54, 0x0a,
+	0x33, 0xea, 0xfe, 0x92, 0xac, 0xb8, 0xd0, 0x87, 0x82, 0x0b, 0x92, 0xa9, 0xa0, 0x17, 0x50, 0xd1,
+	0x13, 0x54, 0xf9, 0x48, 0xd7, 0xca, 0x81, 0x74, 0xc8, 0x33, 0xf4, 0xbb, 0x12, 0x24, 0xd9, 0x8a,
+	0x13, 0x07, 0x18, 0xf5, 0xe9, 0x98, 0x38, 0xc0, 0xc8, 0xaf, 0xc2, 0xe4, 0xdb, 0x54, 0xee, 0x72,
+	0x51, 0x0e, 0xc8, 0xe5, 0x03, 0x2c, 0x0b, 0xf2, 0xfd, 0x91, 0x7f, 0x22, 0x41, 0x92, 0xad, 0x33,
+	0x51, 0x91, 0xa8, 0x4f, 0xc6, 0x44, 0x45, 0xa2, 0xbf, 0x0b, 0xab, 0x9c, 0x1c, 0x17, 0x32, 0xde,
+	0xe7, 0x9a, 0xcc, 0x1a, 0x4b, 0xfd, 0xac, 0xf1, 0x7d, 0x09, 0xd2, 0x6e, 0x4d, 0x07, 0x12, 0x0e,
+	0x6d, 0xd1, 0x5f, 0x98, 0x15, 0xaf, 0x0d, 0xa0, 0xe2, 0xaa, 0xdc, 0xa0, 0xd2, 0xaf, 0xc9, 0x57,
+	0x7a, 0x4b, 0x5f, 0x75, 0x4f, 0xe1, 0xa8, 0x06, 0x71, 0xb2, 0xde, 0x44, 0x2f, 0xe8, 0xfe, 0xae,
+	0xac, 0x28, 0xf7, 0xa4, 0xf0, 0x0b, 0x7c, 0xcf, 0x51, 0xd1, 0x59, 0xe4, 0xfb, 0x1b, 0xfa, 0x7d,
+	0x09, 0x26, 0x82, 0x15, 0x27, 0x68, 0xb1, 0x27, 0x1f, 0x21, 0x2d, 0x19, 0x25, 0xb1, 0xab, 0xa4,
+	0x98, 0x0f, 0x16, 0xf5, 0x19, 0xac, 0xf7, 0x21, 0x36, 0xfa, 0x9e, 0x04, 0xe0, 0xd7, 0x85, 0xa3,
+	0xeb, 0x3d, 0x35, 0x09, 0x7d, 0xec, 0x54, 0x8c, 0xb0, 0x4d, 0xb8, 0xb2, 0x5c, 0x7e, 0x91, 0x6a,
+	0x71, 0x05, 0x2d, 0xf4, 0xd1, 0x82, 0x97, 0x67, 0xfe, 0xa9, 0x04, 0x93, 0xa1, 0xfb, 0x22, 0xf4,
+	0x62, 0x9f, 0x95, 0x1c, 0xbe, 0xf1, 0x8b, 0x5e, 0xf4, 0x62, 0xb5, 0xd1, 0x1d, 0xaa, 0x4c, 0x59,
+	0x1e, 0xc6, 0x24, 0xde, 0xa2, 0x28, 0xf2, 0xac, 0x61, 0x4e, 0x5a, 0xf9, 0xdf, 0x34, 0x24, 0xb9,
+	0x85, 0x1a, 0x5e, 0x48, 0x2a, 0x45, 0x49, 0x0e, 0x7e, 0x61, 0x20, 0x46, 0x89, 0x88, 0xaf, 0x54,
+	0xe4, 0x3c, 0xd5, 0x0c, 0xc9, 0x29, 0x6e, 0x0c, 0x7f, 0x49, 0xea, 0x3c, 0x18, 0x5d, 0xea, 0x0e,
+	0x35, 0x21, 0x21, 0x97, 0x7b, 0xbe, 0xe7, 0x22, 0x4a, 0x54, 0x44, 0x11, 0xe5, 0xb9, 0x88, 0xee,
+	0x85, 0xf7, 0xb1, 0x1f, 0x86, 0x4a, 0x51, 0x21, 0xa6, 0xdf, 0xa0, 0x22, 0x3e, 0x3a, 0x91, 0x6f,
+	0x51, 0x89, 0x37, 0x8a, 0x25, 0x4f, 0xe2, 0xc0, 0x00, 0xf4, 0x5d, 0x2f, 0xfe, 0x94, 0xa2, 0x62,
+	0x4b, 0x3f, 0x0d, 0xa2, 0xbe, 0x3a, 0xb9, 0x71, 0x72, 0x5c, 0x48, 0xf1, 0xcf, 0xc4, 0xd8, 0xf0,
+	0x97, 0x7a, 0x0f, 0xff, 0x37, 0x03, 0x61, 0x47, 0x8e, 0x0e, 0x28, 0x21, 0xf9, 0x57, 0xfa, 0xd2,
+	0x84, 0xfd, 0x5f, 0x5e, 0xe8, 0x25, 0xd6, 0x0f, 0x38, 0xbf, 0xc6, 0x03, 0xce, 0xa5, 0x1e, 0x8b,
+	0x6a, 0xf8, 0x45, 0x37, 0x4d, 0x85, 0x66, 0x90, 0xeb, 0x4d, 0xe8, 0x87, 0x12, 0x64, 0xd7, 0x34,
+	0xcd, 0x8b, 0x34, 0xd7, 0xba, 0x0a, 0xb5, 0xa3, 0x3e, 0x91, 0x28, 0x5e, 0x1f, 0x44, 0xc6, 0xe5,
+	0xdd, 0xa4, 0xf2, 0x96, 0xe4, 0x6b, 0xbd, 0x06, 0xe9, 0xaf, 0x2a, 0x55, 0xd3, 0x56, 0xa5, 0x25,
+	0xf4, 0xe7, 0x12, 0x4c, 0xb1, 0xef, 0x21, 0x7a, 0x45, 0xbf, 0xde, 0x5f, 0x6e, 0x14, 0x5f, 0x1c,
+	0x82, 0x32, 0xbc, 0x0b, 0xca, 0x8b, 0x83, 0x35, 0xb3, 0x28, 0x1b, 0xa2, 0xdc, 0xa7, 0x12, 0x64,
+	0x89, 0x55, 0xf9, 0xa7, 0x07, 0xa2, 0xb5, 0x7a, 0x7c, 0xa7, 0x51, 0xbc, 0x3e, 0x88, 0xac, 0x2b,
+	0x24, 0x0e, 0xd2, 0x29, 0x10, 0x7a, 0xfe, 0x05, 0x41, 0xda, 0x33, 0xd5, 0x00, 0x3c, 0x34, 0x4a,
+	0x68, 0xf4, 0xf1, 0x50, 0x57, 0x00, 0xec, 0x87, 0x87, 0x04, 0x51, 0x0b, 0x7d, 0x28, 0xba, 0xf0,
+	0x90, 0x4b, 0x76, 0x7a, 0x3c, 0xd4, 0x7f, 0x80, 0x91, 0x05, 0xaf, 0x01, 0x3c, 0xe4, 0xcb, 0x3d,
+	0x33, 0x1e, 0xea, 0xaf, 0x48, 0x74, 0xc9, 0x2b, 0xc7, 0x43, 0xbc, 0xd9, 0xc3, 0x43, 0xbd, 0xad,
+	0x31, 0x0c, 0x1e, 0x12, 0x14, 0xb9, 0x36, 0x80, 0xaa, 0x0b, 0x0f, 0xf5, 0x94, 0x3e, 0x14, 0x1e,
+	0x12, 0xa4, 0x0f, 0x83, 0x4e, 0x7c, 0x3c, 0xe4, 0x61, 0x90, 0x77, 0x21, 0xb5, 0x8b, 0x0d, 0x6d,
+	0x77, 0x7b, 0x17, 0x09, 0xb7, 0x51, 0x7e, 0x39, 0x6d, 0xb1, 0x10, 0xf1, 0x86, 0xb3, 0xbc, 0x48,
+	0x59, 0xce, 0xcb, 0x28, 0x34, 0x9a, 0x67, 0x15, 0xbb, 0x65, 0x93, 0x55, 0x8d, 0x21, 0xbe, 0x7b,
+	0x64, 0xd4, 0x91, 0xe0, 0xa3, 0x11, 0x15, 0xb1, 0xe2, 0x00, 0xa2, 0xea, 0x57, 0xe5, 0x39, 0x2a,
+	0x2d, 0x87, 0xa6, 0xfc, 0x05, 0x63, 0x13, 0xf6, 0x2d, 0x48, 0xf2, 0x5c, 0x8e, 0xe0, 0x0d, 0x91,
+	0x95, 0x91, 0xc5, 0xab, 0xfd, 0x89, 0xc2, 0xf0, 0x00, 0xe5, 0x02, 0xc2, 0x98, 0x90, 0x0f, 0x20,
+	0xc5, 0x0b, 0xe8, 0x44, 0xd7, 0x88, 0xae, 0x2a, 0x14, 0x5d, 0xa3, 0x47, 0xf1, 0x9e, 0x5c, 0xa0,
+	0x12, 0xcf, 0xa3, 0x73, 0x01, 0x89, 0x5c, 0xce, 0x33, 0x98, 0x0a, 0x97, 0xb2, 0x89, 0x23, 0x8d,
+	0x2c, 0xdd, 0x13, 0x47, 0x1a, 0x5d, 0x0d, 0x17, 0x40, 0x29, 0x9e, 0xdc, 0xfd, 0xb0, 0xb0, 0x67,
+	0x90, 0xa0, 0xa5, 0x63, 0xe2, 0x8a, 0x8c, 0xaa, 0x85, 0x13, 0x57, 0x64, 0x64, 0xcd, 0x99, 0xb7,
+	0x0c, 0x4a, 0x7d, 0x96, 0x01, 0xad, 0x79, 0xe3, 0x9b, 0xc3, 0x54, 0xf8, 0xd4, 0x2c, 0x0e, 0x3f,
+	0xf2, 0x4c, 0x3d, 0xd4, 0xa2, 0x78, 0x89, 0x2a, 0x72, 0x1d, 0x5d, 0xad, 0xd8, 0x84, 0x41, 0xcd,
+	0xe6, 0xc7, 0xfc, 0x6e, 0x75, 0xac, 0x8e, 0x81, 0xf6, 0x20, 0x41, 0xab, 0x94, 0x45, 0x43, 0x44,
+	0x95, 0x2e, 0x17, 0x8b, 0xbd, 0x8b, 0x8b, 0xe5, 0x79, 0x2a, 0xf6, 0x1c, 0x9a, 0xf6, 0x6d, 0xfe,
+	0x94, 0xf0, 0xb8, 0x29, 0x91, 0xd8, 0x93, 0x0d, 0x14, 0x72, 0x89, 0x9b, 0x74, 0xef, 0xaa, 0x33,
+	0x71, 0x93, 0xee, 0x53, 0x0d, 0xe6, 0xce, 0xb9, 0x3c, 0xeb, 0xcb, 0xdf, 0xf3, 0xc9, 0x89, 0xd1,
+	0x3d, 0x35, 0xf8, 0xae, 0x10, 0xa5, 0x46, 0x64, 0xa9, 0x57, 0xa4, 0x1a, 0xd1, 0x25, 0x58, 0x3d,
+	0xd5, 0x60, 0xe4, 0x21, 0x35, 0xf8, 0x9e, 0x10, 0xa5, 0x46, 0x64, 0x7d, 0x55, 0xa4, 0x1a, 0xd1,
+	0x65, 0x50, 0x3d, 0xd5, 0x60, 0xe4, 0x44, 0x8d, 0x3d, 0x48, 0xb2, 0xc2, 0x27, 0xd1, 0xf3, 0x22,
+	0xcb, 0xa1, 0x8a, 0xf3, 0xe2, 0xc2, 0xe3, 0xf5, 0x3e, 0x51, 0x51, 0x05, 0x53, 0x0e, 0x37, 0x25,
+	0x64, 0x41, 0x92, 0x55, 0xab, 0x88, 0x32, 0x22, 0xeb, 0x64, 0xc4, 0xc5, 0x1d, 0x5d, 0xe8, 0x22,
+	0x5f, 0xa0, 0x02, 0x67, 0xe5, 0x80, 0x40, 0x9d, 0x52, 0xae, 0x4a, 0x4b, 0x8b, 0x12, 0x32, 0x20,
+	0xe3, 0x55, 0x38, 0x45, 0x9d, 0x77, 0x82, 0xa5, 0x4f, 0xbd, 0x47, 0xb5, 0x48, 0x85, 0xc8, 0xa8,
+	0xdf, 0x6a, 0xa6, 0x85, 0x55, 0x01, 0x74, 0xf5, 0x5b, 0x90, 0xa6, 0x15, 0x0f, 0x0f, 0xcc, 0x06,
+	0x39, 0x70, 0xd1, 0x7d, 0xee, 0x4a, 0xf7, 0x82, 0xed, 0xaa, 0x16, 0x29, 0x5e, 0xeb, 0x47, 0xe4,
+	0x0f, 0x7c, 0x96, 0xea, 0x34, 0x8d, 0x26, 0x2b, 0x2a, 0x79, 0xcb, 0x3e, 0x20, 0x08, 0xc2, 0xbb,
+	0xff, 0x49, 0x42, 0x9a, 0x5f, 0x12, 0xd8, 0xe8, 0x77, 0x24, 0x0f, 0xdf, 0x95, 0xa3, 0xb0, 0x5b,
+	0xef, 0xfb, 0xab, 0x62, 0x65, 0x68, 0xfa, 0x2e, 0xdc, 0xf7, 0x94, 0x8b, 0xf7, 0xd1, 0xcf, 0xa7,
+	0x12, 0x07, 0x7e, 0x2f, 0x75, 0xcf, 0x45, 0x1f, 0x1d, 0x96, 0x87, 0xa4, 0xee, 0x02, 0x84, 0xae,
+	0x06, 0xdd, 0x10, 0xe8, 0x2f, 0x7c, 0x40, 0x58, 0x8e, 0x02, 0x7b, 0xc3, 0x5b, 0x64, 0xe0, 0x85,
+	0x66, 0x00, 0x28, 0xfa, 0xfa, 0x0c, 0x04, 0x8a, 0x7f, 0xe9, 0x03, 0xc5, 0x72, 0x14, 0x08, 0x1c,
+	0x5e, 0xc1, 0xc1, 0xd7, 0x98, 0xf7, 0x4e, 0x8e, 0x0b, 0xb3, 0x91, 0xa5, 0x38, 0x1e, 0x98, 0xec,
+	0x6d, 0xc9, 0xa7, 0xdc, 0xbb, 0x5f, 0xea, 0x76, 0xdc, 0x3e, 0x3a, 0x96, 0x87, 0xa2, 0x8e, 0x42,
+	0x77, 0xae, 0x26, 0x24, 0x76, 0x4e, 0x91, 0x7e, 0xfe, 0xed, 0x59, 0x54, 0xbe, 0x2b, 0xfa, 0x2e,
+	0xb1, 0x78, 0x63, 0x10, 0x65, 0xe0, 0x32, 0xce, 0x8d, 0x32, 0xe8, 0xbc, 0x2b, 0xbc, 0xa6, 0x79,
+	0x44, 0x81, 0x25, 0xf7, 0x37, 0x09, 0x98, 0x0c, 0x25, 0xd3, 0xd1, 0x47, 0xde, 0xb2, 0xbb, 0x1e,
+	0xb5, 0x8c, 0x22, 0x36, 0xf5, 0x5f, 0x1a, 0x48, 0x27, 0x04, 0xf5, 0x69, 0x61, 0x67, 0xf7, 0x3d,
+	0xe8, 0x19, 0x5f, 0x6b, 0x57, 0xbb, 0x57, 0x4f, 0x84, 0xe0, 0x6b, 0x03, 0xa8, 0xb8, 0x58, 0x3f,
+	0x16, 0x0e, 0x00, 0x14, 0xe8, 0x33, 0x7f, 0x85, 0x5d, 0x8f, 0x5a, 0x31, 0x83, 0x07, 0xdf, 0xf3,
+	0xca, 0x48, 0xbe, 0x4b, 0xb5, 0xb8, 0x5d, 0x5c, 0xec, 0xd2, 0x62, 0xe0, 0xba, 0xfa, 0xcc, 0x5f,
+	0x57, 0xd7, 0xa3, 0xd6, 0xc9, 0x60, 0xb5, 0x7a, 0x5f, 0x1b, 0xdd, 0x3d, 0x39, 0x2e, 0x4c, 0x86,
+	0xae, 0x5f, 0x99, 0xb5, 0x96, 0x06, 0x5b, 0xcb, 0xe0, 0xab, 0xe8, 0x6a, 0xb7, 0x5f, 0x0e, 0xd6,
+	0xa8, 0xe7, 0x2d, 0x4e, 0x00, 0x88, 0x85, 0x15, 0xf0, 0xbd, 0x76, 0xfd, 0xc7, 0xd2, 0xf3, 0xb5,
+	0x3f, 0x23, 0x5b, 0xa5, 0x97, 0x0d, 0x90, 0xdf, 0x85, 0xa9, 0xd7, 0xcd, 0x03, 0xa3, 0xb4, 0x8e,
+	0x9b, 0x6a, 0x4b, 0xb5, 0xf4, 0x3a, 0x5a, 0x39, 0x70, 0x9c, 0xb6, 0xbd, 0x5a, 0xa9, 0xf4, 0xff,
+	0xa7, 0x8b, 0xae, 0x46, 0xcb, 0x6a, 0xbb, 0x5d, 0x9c, 0x7f, 0x7f, 0xcf, 0xed, 0xff, 0x2d, 0xef,
+	0x1a, 0xa7, 0x6e, 0xb6, 0x56, 0xc6, 0x6f, 0x95, 0x6f, 0x2e, 0xc5, 0xa4, 0xd8, 0x4a, 0x4e, 0x6d,
+	0x33, 0x10, 0xae, 0x9b, 0x46, 0xe5, 0x7d, 0xdb, 0x34, 0x56, 0xbb, 0x5a, 0x7e, 0xfd, 0xce, 0xf0,
+	0x12, 0x2b, 0xec, 0x9f, 0x81, 0xde, 0x6b, 0xef, 0xed, 0x25, 0x69, 0x99, 0xd2, 0xed, 0xff, 0x0f,
+	0x00, 0x00, 0xff, 0xff, 0x9b, 0xb6, 0xf9, 0x92, 0x20, 0x54, 0x00, 0x00,
 }
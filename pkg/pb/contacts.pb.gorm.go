@@ -17,8 +17,13 @@ It has these top-level messages:
 	UpdateProfileResponse
 	DeleteProfileRequest
 	DeleteProfileResponse
+	UndeleteProfileRequest
+	UndeleteProfileResponse
 	ListProfileRequest
 	ListProfilesResponse
+	ListProfileContactsRequest
+	ListProfileGroupsRequest
+	CreateProfileContactRequest
 	Group
 	CreateGroupRequest
 	CreateGroupResponse
@@ -28,10 +33,19 @@ It has these top-level messages:
 	UpdateGroupResponse
 	DeleteGroupRequest
 	DeleteGroupResponse
+	UndeleteGroupRequest
+	UndeleteGroupResponse
 	ListGroupRequest
 	ListGroupsResponse
+	AddGroupContactsRequest
+	AddGroupContactsResponse
+	RemoveGroupContactsRequest
+	RemoveGroupContactsResponse
+	ListGroupMembersRequest
+	ListGroupMembersResponse
 	Contact
 	Email
+	PhoneNumber
 	Address
 	CreateContactRequest
 	CreateContactResponse
@@ -41,15 +55,74 @@ It has these top-level messages:
 	UpdateContactResponse
 	DeleteContactRequest
 	DeleteContactResponse
+	UndeleteContactRequest
+	UndeleteContactResponse
 	ListContactsResponse
 	SMSRequest
 	SMSResponse
+	SyncContactsRequest
+	SyncContactsResponse
+	WatchContactsRequest
+	ContactEvent
+	SearchContactsRequest
+	ContactSearchResult
+	SearchContactsResponse
+	SuggestContactsRequest
+	ContactSuggestion
+	SuggestContactsResponse
+	FindDuplicatesRequest
+	DuplicateGroup
+	FindDuplicatesResponse
+	MergeContactsRequest
+	MergeContactsResponse
 	ListContactRequest
+	BatchCreateContactsRequest
+	BatchCreateContactsResponse
+	BatchUpdateContactsRequest
+	BatchUpdateContactsResponse
+	BatchDeleteContactsRequest
+	BatchDeleteContactsResponse
+	ExportContactsRequest
+	ReadVCardRequest
+	FileChunk
+	ImportOptions
+	ImportContactsRequest
+	ImportContactsResponse
+	AuditEvent
+	ListAuditEventRequest
+	ListAuditEventsResponse
+	WebhookSubscription
+	WebhookDelivery
+	CreateWebhookSubscriptionRequest
+	CreateWebhookSubscriptionResponse
+	ReadWebhookSubscriptionRequest
+	ReadWebhookSubscriptionResponse
+	UpdateWebhookSubscriptionRequest
+	UpdateWebhookSubscriptionResponse
+	DeleteWebhookSubscriptionRequest
+	DeleteWebhookSubscriptionResponse
+	ListWebhookSubscriptionRequest
+	ListWebhookSubscriptionsResponse
+	ListWebhookDeliveryRequest
+	ListWebhookDeliveriesResponse
+	SavedSearch
+	CreateSavedSearchRequest
+	CreateSavedSearchResponse
+	ReadSavedSearchRequest
+	ReadSavedSearchResponse
+	UpdateSavedSearchRequest
+	UpdateSavedSearchResponse
+	DeleteSavedSearchRequest
+	DeleteSavedSearchResponse
+	ListSavedSearchRequest
+	ListSavedSearchesResponse
+	RunSavedSearchRequest
 */
 package pb
 
 import context "context"
 import errors "errors"
+import time "time"
 
 import auth1 "github.com/infobloxopen/atlas-app-toolkit/auth"
 import field_mask1 "google.golang.org/genproto/protobuf/field_mask"
@@ -57,6 +130,7 @@ import gateway1 "github.com/infobloxopen/atlas-app-toolkit/gateway"
 import gorm1 "github.com/jinzhu/gorm"
 import gorm2 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 import postgres1 "github.com/jinzhu/gorm/dialects/postgres"
+import ptypes1 "github.com/golang/protobuf/ptypes"
 import query1 "github.com/infobloxopen/atlas-app-toolkit/query"
 import resource1 "github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
 import types1 "github.com/infobloxopen/protoc-gen-gorm/types"
@@ -64,11 +138,13 @@ import types1 "github.com/infobloxopen/protoc-gen-gorm/types"
 import fmt "fmt"
 import math "math"
 import _ "google.golang.org/genproto/protobuf/field_mask"
+import _ "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 import _ "github.com/lyft/protoc-gen-validate/validate"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 import _ "github.com/infobloxopen/atlas-app-toolkit/query"
 import _ "github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+import _ "github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = fmt.Errorf
@@ -77,10 +153,14 @@ var _ = math.Inf
 type ProfileORM struct {
 	AccountID string
 	Contacts  []*ContactORM `gorm:"foreignkey:ProfileId;association_foreignkey:Id"`
-	Groups    []*GroupORM   `gorm:"foreignkey:ProfileId;association_foreignkey:Id"`
-	Id        int64         `gorm:"type:serial;primary_key"`
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	Groups    []*GroupORM `gorm:"foreignkey:ProfileId;association_foreignkey:Id"`
+	Id        int64       `gorm:"type:serial;primary_key"`
 	Name      string
 	Notes     string
+	UpdatedAt time.Time
+	Version   int64
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -127,6 +207,16 @@ func (m *Profile) ToORM(ctx context.Context) (ProfileORM, error) {
 			to.Groups = append(to.Groups, nil)
 		}
 	}
+	if m.CreatedAt != nil {
+		if to.CreatedAt, err = ptypes1.Timestamp(m.CreatedAt); err != nil {
+			return to, err
+		}
+	}
+	if m.UpdatedAt != nil {
+		if to.UpdatedAt, err = ptypes1.Timestamp(m.UpdatedAt); err != nil {
+			return to, err
+		}
+	}
 	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
 		return to, err
@@ -177,6 +267,12 @@ func (m *ProfileORM) ToPB(ctx context.Context) (Profile, error) {
 			to.Groups = append(to.Groups, nil)
 		}
 	}
+	if to.CreatedAt, err = ptypes1.TimestampProto(m.CreatedAt); err != nil {
+		return to, err
+	}
+	if to.UpdatedAt, err = ptypes1.TimestampProto(m.UpdatedAt); err != nil {
+		return to, err
+	}
 	if posthook, ok := interface{}(m).(ProfileWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -207,12 +303,17 @@ type ProfileWithAfterToPB interface {
 }
 
 type GroupORM struct {
-	AccountID string
-	Contacts  []*ContactORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:group_contacts;jointable_foreignkey:group_id;association_jointable_foreignkey:contact_id"`
-	Id        int64         `gorm:"type:serial;primary_key"`
-	Name      string
-	Notes     string
-	ProfileId *int64
+	AccountID    string
+	Contacts     []*ContactORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:group_contacts;jointable_foreignkey:group_id;association_jointable_foreignkey:contact_id"`
+	CreatedAt    time.Time
+	DeletedAt    *time.Time
+	Id           int64 `gorm:"type:serial;primary_key"`
+	MemberFilter string
+	Name         string
+	Notes        string
+	ProfileId    *int64
+	UpdatedAt    time.Time
+	Version      int64
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -255,6 +356,17 @@ func (m *Group) ToORM(ctx context.Context) (GroupORM, error) {
 			to.Contacts = append(to.Contacts, nil)
 		}
 	}
+	if m.CreatedAt != nil {
+		if to.CreatedAt, err = ptypes1.Timestamp(m.CreatedAt); err != nil {
+			return to, err
+		}
+	}
+	if m.UpdatedAt != nil {
+		if to.UpdatedAt, err = ptypes1.Timestamp(m.UpdatedAt); err != nil {
+			return to, err
+		}
+	}
+	to.MemberFilter = m.MemberFilter
 	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
 		return to, err
@@ -301,6 +413,13 @@ func (m *GroupORM) ToPB(ctx context.Context) (Group, error) {
 			to.Contacts = append(to.Contacts, nil)
 		}
 	}
+	if to.CreatedAt, err = ptypes1.TimestampProto(m.CreatedAt); err != nil {
+		return to, err
+	}
+	if to.UpdatedAt, err = ptypes1.TimestampProto(m.UpdatedAt); err != nil {
+		return to, err
+	}
+	to.MemberFilter = m.MemberFilter
 	if posthook, ok := interface{}(m).(GroupWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -331,18 +450,24 @@ type GroupWithAfterToPB interface {
 }
 
 type ContactORM struct {
-	AccountID   string
-	Emails      []*EmailORM `gorm:"foreignkey:ContactId;association_foreignkey:Id"`
-	FirstName   string
-	Groups      []*GroupORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:group_contacts;jointable_foreignkey:contact_id;association_jointable_foreignkey:group_id"`
-	HomeAddress *AddressORM `gorm:"foreignkey:HomeAddressContactId;association_foreignkey:Id"`
-	Id          int64       `gorm:"type:serial;primary_key"`
-	LastName    string
-	MiddleName  string
-	Nicknames   *postgres1.Jsonb `gorm:"type:jsonb"`
-	Notes       string
-	ProfileId   *int64
-	WorkAddress *AddressORM `gorm:"foreignkey:WorkAddressContactId;association_foreignkey:Id"`
+	AccountID    string
+	CarddavName  string
+	CreatedAt    time.Time
+	DeletedAt    *time.Time
+	Emails       []*EmailORM `gorm:"foreignkey:ContactId;association_foreignkey:Id"`
+	FirstName    string
+	Groups       []*GroupORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:group_contacts;jointable_foreignkey:contact_id;association_jointable_foreignkey:group_id"`
+	HomeAddress  *AddressORM `gorm:"foreignkey:HomeAddressContactId;association_foreignkey:Id"`
+	Id           int64       `gorm:"type:serial;primary_key"`
+	LastName     string
+	MiddleName   string
+	Nicknames    *postgres1.Jsonb `gorm:"type:jsonb"`
+	Notes        string
+	PhoneNumbers []*PhoneNumberORM `gorm:"foreignkey:ContactId;association_foreignkey:Id"`
+	ProfileId    *int64
+	UpdatedAt    time.Time
+	Version      int64
+	WorkAddress  *AddressORM `gorm:"foreignkey:WorkAddressContactId;association_foreignkey:Id"`
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -415,6 +540,28 @@ func (m *Contact) ToORM(ctx context.Context) (ContactORM, error) {
 	if m.Nicknames != nil {
 		to.Nicknames = &postgres1.Jsonb{[]byte(m.Nicknames.Value)}
 	}
+	for _, v := range m.PhoneNumbers {
+		if v != nil {
+			if tempPhoneNumbers, cErr := v.ToORM(ctx); cErr == nil {
+				to.PhoneNumbers = append(to.PhoneNumbers, &tempPhoneNumbers)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.PhoneNumbers = append(to.PhoneNumbers, nil)
+		}
+	}
+	if m.CreatedAt != nil {
+		if to.CreatedAt, err = ptypes1.Timestamp(m.CreatedAt); err != nil {
+			return to, err
+		}
+	}
+	if m.UpdatedAt != nil {
+		if to.UpdatedAt, err = ptypes1.Timestamp(m.UpdatedAt); err != nil {
+			return to, err
+		}
+	}
+	to.CarddavName = m.CarddavName
 	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
 		return to, err
@@ -491,6 +638,24 @@ func (m *ContactORM) ToPB(ctx context.Context) (Contact, error) {
 	if m.Nicknames != nil {
 		to.Nicknames = &types1.JSONValue{Value: string(m.Nicknames.RawMessage)}
 	}
+	for _, v := range m.PhoneNumbers {
+		if v != nil {
+			if tempPhoneNumbers, cErr := v.ToPB(ctx); cErr == nil {
+				to.PhoneNumbers = append(to.PhoneNumbers, &tempPhoneNumbers)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.PhoneNumbers = append(to.PhoneNumbers, nil)
+		}
+	}
+	if to.CreatedAt, err = ptypes1.TimestampProto(m.CreatedAt); err != nil {
+		return to, err
+	}
+	if to.UpdatedAt, err = ptypes1.TimestampProto(m.UpdatedAt); err != nil {
+		return to, err
+	}
+	to.CarddavName = m.CarddavName
 	if posthook, ok := interface{}(m).(ContactWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -522,7 +687,7 @@ type ContactWithAfterToPB interface {
 
 type EmailORM struct {
 	AccountID string
-	Address   string `gorm:"unique"`
+	Address   string
 	ContactId *int64
 	Id        uint64
 	IsPrimary *bool
@@ -597,6 +762,86 @@ type EmailWithAfterToPB interface {
 	AfterToPB(context.Context, *Email) error
 }
 
+type PhoneNumberORM struct {
+	AccountID string
+	ContactId *int64
+	Id        uint64
+	IsPrimary *bool
+	Number    string
+	Type      int32
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PhoneNumberORM) TableName() string {
+	return "phone_numbers"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *PhoneNumber) ToORM(ctx context.Context) (PhoneNumberORM, error) {
+	to := PhoneNumberORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PhoneNumberWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Number = m.Number
+	to.Type = int32(m.Type)
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return to, err
+	}
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(PhoneNumberWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PhoneNumberORM) ToPB(ctx context.Context) (PhoneNumber, error) {
+	to := PhoneNumber{}
+	var err error
+	if prehook, ok := interface{}(m).(PhoneNumberWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Number = m.Number
+	to.Type = PhoneNumber_Type(m.Type)
+	if posthook, ok := interface{}(m).(PhoneNumberWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type PhoneNumber the arg will be the target, the caller the one being converted from
+
+// PhoneNumberBeforeToORM called before default ToORM code
+type PhoneNumberWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PhoneNumberORM) error
+}
+
+// PhoneNumberAfterToORM called after default ToORM code
+type PhoneNumberWithAfterToORM interface {
+	AfterToORM(context.Context, *PhoneNumberORM) error
+}
+
+// PhoneNumberBeforeToPB called before default ToPB code
+type PhoneNumberWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PhoneNumber) error
+}
+
+// PhoneNumberAfterToPB called after default ToPB code
+type PhoneNumberWithAfterToPB interface {
+	AfterToPB(context.Context, *PhoneNumber) error
+}
+
 type AddressORM struct {
 	AccountID            string
 	Address              string
@@ -683,118 +928,530 @@ type AddressWithAfterToPB interface {
 	AfterToPB(context.Context, *Address) error
 }
 
-// DefaultCreateProfile executes a basic gorm create call
-func DefaultCreateProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
-	if in == nil {
-		return nil, errors.New("Nil argument to DefaultCreateProfile")
+type AuditEventORM struct {
+	AccountID  string
+	CreatedAt  time.Time
+	Diff       *postgres1.Jsonb `gorm:"type:jsonb"`
+	Id         int64            `gorm:"type:serial;primary_key"`
+	Method     string
+	RequestId  string
+	ResourceId string
+	Subject    string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AuditEventORM) TableName() string {
+	return "audit_events"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *AuditEvent) ToORM(ctx context.Context) (AuditEventORM, error) {
+	to := AuditEventORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AuditEventWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if v, err := resource1.DecodeInt64(&AuditEvent{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	to.Subject = m.Subject
+	to.RequestId = m.RequestId
+	to.Method = m.Method
+	to.ResourceId = m.ResourceId
+	if m.Diff != nil {
+		to.Diff = &postgres1.Jsonb{[]byte(m.Diff.Value)}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-// DefaultReadProfile executes a basic gorm read call
-func DefaultReadProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
-	if in == nil {
-		return nil, errors.New("Nil argument to DefaultReadProfile")
+	if m.CreatedAt != nil {
+		if to.CreatedAt, err = ptypes1.Timestamp(m.CreatedAt); err != nil {
+			return to, err
+		}
 	}
-	db = db.Set("gorm:auto_preload", true)
-	ormParams, err := in.ToORM(ctx)
+	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
-		return nil, err
+		return to, err
 	}
-	ormResponse := ProfileORM{}
-	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
-		return nil, err
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(AuditEventWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return to, err
 }
 
-// DefaultUpdateProfile executes a basic gorm update call
-func DefaultUpdateProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
-	if in == nil {
-		return nil, errors.New("Nil argument to DefaultUpdateProfile")
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AuditEventORM) ToPB(ctx context.Context) (AuditEvent, error) {
+	to := AuditEvent{}
+	var err error
+	if prehook, ok := interface{}(m).(AuditEventWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	accountID, err := auth1.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
+	if v, err := resource1.Encode(&AuditEvent{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
 	}
-	if exists, err := DefaultReadProfile(ctx, &Profile{Id: in.GetId()}, db); err != nil {
-		return nil, err
-	} else if exists == nil {
-		return nil, errors.New("Profile not found")
+	to.Subject = m.Subject
+	to.RequestId = m.RequestId
+	to.Method = m.Method
+	to.ResourceId = m.ResourceId
+	if m.Diff != nil {
+		to.Diff = &types1.JSONValue{Value: string(m.Diff.RawMessage)}
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if to.CreatedAt, err = ptypes1.TimestampProto(m.CreatedAt); err != nil {
+		return to, err
 	}
-	ormObj.AccountID = accountID
-	db = db.Where(&ProfileORM{AccountID: accountID})
-	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+	if posthook, ok := interface{}(m).(AuditEventWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return to, err
 }
 
-func DefaultDeleteProfile(ctx context.Context, in *Profile, db *gorm1.DB) error {
-	if in == nil {
-		return errors.New("Nil argument to DefaultDeleteProfile")
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == 0 {
-		return errors.New("A non-zero ID value is required for a delete call")
-	}
-	err = db.Where(&ormObj).Delete(&ProfileORM{}).Error
-	return err
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type AuditEvent the arg will be the target, the caller the one being converted from
+
+// AuditEventBeforeToORM called before default ToORM code
+type AuditEventWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AuditEventORM) error
 }
 
-// DefaultStrictUpdateProfile clears first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateProfile")
+// AuditEventAfterToORM called after default ToORM code
+type AuditEventWithAfterToORM interface {
+	AfterToORM(context.Context, *AuditEventORM) error
+}
+
+// AuditEventBeforeToPB called before default ToPB code
+type AuditEventWithBeforeToPB interface {
+	BeforeToPB(context.Context, *AuditEvent) error
+}
+
+// AuditEventAfterToPB called after default ToPB code
+type AuditEventWithAfterToPB interface {
+	AfterToPB(context.Context, *AuditEvent) error
+}
+
+type WebhookSubscriptionORM struct {
+	AccountID  string
+	Disabled   bool
+	EventTypes string
+	Id         int64 `gorm:"type:serial;primary_key"`
+	Secret     string
+	Url        string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WebhookSubscriptionORM) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WebhookSubscription) ToORM(ctx context.Context) (WebhookSubscriptionORM, error) {
+	to := WebhookSubscriptionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookSubscriptionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if v, err := resource1.DecodeInt64(&WebhookSubscription{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
 	}
-	count := 1
-	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	to.Url = m.Url
+	to.Secret = m.Secret
+	to.Disabled = m.Disabled
+	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
-		return nil, err
+		return to, err
 	}
-	filterContacts := ContactORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.New("Can't do overwriting update with no Id value for ProfileORM")
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(WebhookSubscriptionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
 	}
-	filterContacts.ProfileId = new(int64)
-	*filterContacts.ProfileId = ormObj.Id
-	filterContacts.AccountID = ormObj.AccountID
-	if err = db.Where(filterContacts).Delete(ContactORM{}).Error; err != nil {
-		return nil, err
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WebhookSubscriptionORM) ToPB(ctx context.Context) (WebhookSubscription, error) {
+	to := WebhookSubscription{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookSubscriptionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
 	}
-	filterGroups := GroupORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.New("Can't do overwriting update with no Id value for ProfileORM")
+	if v, err := resource1.Encode(&WebhookSubscription{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
 	}
-	filterGroups.ProfileId = new(int64)
-	*filterGroups.ProfileId = ormObj.Id
-	filterGroups.AccountID = ormObj.AccountID
-	if err = db.Where(filterGroups).Delete(GroupORM{}).Error; err != nil {
-		return nil, err
+	to.Url = m.Url
+	to.Secret = m.Secret
+	to.Disabled = m.Disabled
+	if posthook, ok := interface{}(m).(WebhookSubscriptionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
 	}
-	db = db.Where(&ProfileORM{AccountID: ormObj.AccountID})
-	if err = db.Save(&ormObj).Error; err != nil {
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WebhookSubscription the arg will be the target, the caller the one being converted from
+
+// WebhookSubscriptionBeforeToORM called before default ToORM code
+type WebhookSubscriptionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WebhookSubscriptionORM) error
+}
+
+// WebhookSubscriptionAfterToORM called after default ToORM code
+type WebhookSubscriptionWithAfterToORM interface {
+	AfterToORM(context.Context, *WebhookSubscriptionORM) error
+}
+
+// WebhookSubscriptionBeforeToPB called before default ToPB code
+type WebhookSubscriptionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WebhookSubscription) error
+}
+
+// WebhookSubscriptionAfterToPB called after default ToPB code
+type WebhookSubscriptionWithAfterToPB interface {
+	AfterToPB(context.Context, *WebhookSubscription) error
+}
+
+type WebhookDeliveryORM struct {
+	AccountID      string
+	Attempts       int32
+	CreatedAt      time.Time
+	EventType      string
+	Id             int64 `gorm:"type:serial;primary_key"`
+	LastError      string
+	LastStatusCode int32
+	NextAttemptAt  time.Time
+	Payload        *postgres1.Jsonb `gorm:"type:jsonb"`
+	Status         int32
+	SubscriptionId int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WebhookDeliveryORM) TableName() string {
+	return "webhook_deliveries"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WebhookDelivery) ToORM(ctx context.Context) (WebhookDeliveryORM, error) {
+	to := WebhookDeliveryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookDeliveryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if v, err := resource1.DecodeInt64(&WebhookDelivery{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
+	}
+	to.EventType = m.EventType
+	if m.Payload != nil {
+		to.Payload = &postgres1.Jsonb{[]byte(m.Payload.Value)}
+	}
+	to.Status = int32(m.Status)
+	to.Attempts = m.Attempts
+	to.LastStatusCode = m.LastStatusCode
+	to.LastError = m.LastError
+	if m.NextAttemptAt != nil {
+		if to.NextAttemptAt, err = ptypes1.Timestamp(m.NextAttemptAt); err != nil {
+			return to, err
+		}
+	}
+	if m.CreatedAt != nil {
+		if to.CreatedAt, err = ptypes1.Timestamp(m.CreatedAt); err != nil {
+			return to, err
+		}
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return to, err
+	}
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(WebhookDeliveryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WebhookDeliveryORM) ToPB(ctx context.Context) (WebhookDelivery, error) {
+	to := WebhookDelivery{}
+	var err error
+	if prehook, ok := interface{}(m).(WebhookDeliveryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if v, err := resource1.Encode(&WebhookDelivery{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
+	}
+	to.EventType = m.EventType
+	if m.Payload != nil {
+		to.Payload = &types1.JSONValue{Value: string(m.Payload.RawMessage)}
+	}
+	to.Status = WebhookDelivery_Status(m.Status)
+	to.Attempts = m.Attempts
+	to.LastStatusCode = m.LastStatusCode
+	to.LastError = m.LastError
+	if to.NextAttemptAt, err = ptypes1.TimestampProto(m.NextAttemptAt); err != nil {
+		return to, err
+	}
+	if to.CreatedAt, err = ptypes1.TimestampProto(m.CreatedAt); err != nil {
+		return to, err
+	}
+	if posthook, ok := interface{}(m).(WebhookDeliveryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WebhookDelivery the arg will be the target, the caller the one being converted from
+
+// WebhookDeliveryBeforeToORM called before default ToORM code
+type WebhookDeliveryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WebhookDeliveryORM) error
+}
+
+// WebhookDeliveryAfterToORM called after default ToORM code
+type WebhookDeliveryWithAfterToORM interface {
+	AfterToORM(context.Context, *WebhookDeliveryORM) error
+}
+
+// WebhookDeliveryBeforeToPB called before default ToPB code
+type WebhookDeliveryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WebhookDelivery) error
+}
+
+// WebhookDeliveryAfterToPB called after default ToPB code
+type WebhookDeliveryWithAfterToPB interface {
+	AfterToPB(context.Context, *WebhookDelivery) error
+}
+
+type SavedSearchORM struct {
+	AccountID string
+	Fields    string
+	Filter    string
+	Id        int64 `gorm:"type:serial;primary_key"`
+	Name      string
+	OrderBy   string
+	ProfileId *int64
+}
+
+// TableName overrides the default tablename generated by GORM
+func (SavedSearchORM) TableName() string {
+	return "saved_searches"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *SavedSearch) ToORM(ctx context.Context) (SavedSearchORM, error) {
+	to := SavedSearchORM{}
+	var err error
+	if prehook, ok := interface{}(m).(SavedSearchWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if v, err := resource1.DecodeInt64(&SavedSearch{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
+	}
+	to.Name = m.Name
+	to.Filter = m.Filter
+	to.OrderBy = m.OrderBy
+	to.Fields = m.Fields
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return to, err
+	}
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(SavedSearchWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SavedSearchORM) ToPB(ctx context.Context) (SavedSearch, error) {
+	to := SavedSearch{}
+	var err error
+	if prehook, ok := interface{}(m).(SavedSearchWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if v, err := resource1.Encode(&SavedSearch{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
+	}
+	to.Name = m.Name
+	to.Filter = m.Filter
+	to.OrderBy = m.OrderBy
+	to.Fields = m.Fields
+	if posthook, ok := interface{}(m).(SavedSearchWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type SavedSearch the arg will be the target, the caller the one being converted from
+
+// SavedSearchBeforeToORM called before default ToORM code
+type SavedSearchWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SavedSearchORM) error
+}
+
+// SavedSearchAfterToORM called after default ToORM code
+type SavedSearchWithAfterToORM interface {
+	AfterToORM(context.Context, *SavedSearchORM) error
+}
+
+// SavedSearchBeforeToPB called before default ToPB code
+type SavedSearchWithBeforeToPB interface {
+	BeforeToPB(context.Context, *SavedSearch) error
+}
+
+// SavedSearchAfterToPB called after default ToPB code
+type SavedSearchWithAfterToPB interface {
+	AfterToPB(context.Context, *SavedSearch) error
+}
+
+// DefaultCreateProfile executes a basic gorm create call
+func DefaultCreateProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultCreateProfile")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultReadProfile executes a basic gorm read call
+func DefaultReadProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultReadProfile")
+	}
+	db = db.Set("gorm:auto_preload", true)
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormResponse := ProfileORM{}
+	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultUpdateProfile executes a basic gorm update call
+func DefaultUpdateProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultUpdateProfile")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists, err := DefaultReadProfile(ctx, &Profile{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	} else if exists == nil {
+		return nil, errors.New("Profile not found")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.AccountID = accountID
+	db = db.Where(&ProfileORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteProfile(ctx context.Context, in *Profile, db *gorm1.DB) error {
+	if in == nil {
+		return errors.New("Nil argument to DefaultDeleteProfile")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.New("A non-zero ID value is required for a delete call")
+	}
+	err = db.Where(&ormObj).Delete(&ProfileORM{}).Error
+	return err
+}
+
+// DefaultStrictUpdateProfile clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateProfile(ctx context.Context, in *Profile, db *gorm1.DB) (*Profile, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateProfile")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := 1
+	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	if err != nil {
+		return nil, err
+	}
+	filterContacts := ContactORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.New("Can't do overwriting update with no Id value for ProfileORM")
+	}
+	filterContacts.ProfileId = new(int64)
+	*filterContacts.ProfileId = ormObj.Id
+	filterContacts.AccountID = ormObj.AccountID
+	if err = db.Where(filterContacts).Delete(ContactORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterGroups := GroupORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.New("Can't do overwriting update with no Id value for ProfileORM")
+	}
+	filterGroups.ProfileId = new(int64)
+	*filterGroups.ProfileId = ormObj.Id
+	filterGroups.AccountID = ormObj.AccountID
+	if err = db.Where(filterGroups).Delete(GroupORM{}).Error; err != nil {
+		return nil, err
+	}
+	db = db.Where(&ProfileORM{AccountID: ormObj.AccountID})
+	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
@@ -891,6 +1548,15 @@ func DefaultApplyFieldMaskProfile(ctx context.Context, patchee *Profile, ormObj
 				return nil, err
 			}
 		}
+		if f == "Etag" {
+			patchee.Etag = patcher.Etag
+		}
+		if f == "CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+		}
+		if f == "UpdatedAt" {
+			patchee.UpdatedAt = patcher.UpdatedAt
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -1126,6 +1792,18 @@ func DefaultApplyFieldMaskGroup(ctx context.Context, patchee *Group, ormObj *Gro
 		if f == "Contacts" {
 			patchee.Contacts = patcher.Contacts
 		}
+		if f == "Etag" {
+			patchee.Etag = patcher.Etag
+		}
+		if f == "CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+		}
+		if f == "UpdatedAt" {
+			patchee.UpdatedAt = patcher.UpdatedAt
+		}
+		if f == "MemberFilter" {
+			patchee.MemberFilter = patcher.MemberFilter
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -1278,12 +1956,22 @@ func DefaultStrictUpdateContact(ctx context.Context, in *Contact, db *gorm1.DB)
 	if err = db.Where(filterHomeAddress).Delete(AddressORM{}).Error; err != nil {
 		return nil, err
 	}
-	filterWorkAddress := AddressORM{}
+	filterPhoneNumbers := PhoneNumberORM{}
 	if ormObj.Id == 0 {
 		return nil, errors.New("Can't do overwriting update with no Id value for ContactORM")
 	}
-	filterWorkAddress.WorkAddressContactId = new(int64)
-	*filterWorkAddress.WorkAddressContactId = ormObj.Id
+	filterPhoneNumbers.ContactId = new(int64)
+	*filterPhoneNumbers.ContactId = ormObj.Id
+	filterPhoneNumbers.AccountID = ormObj.AccountID
+	if err = db.Where(filterPhoneNumbers).Delete(PhoneNumberORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterWorkAddress := AddressORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.New("Can't do overwriting update with no Id value for ContactORM")
+	}
+	filterWorkAddress.WorkAddressContactId = new(int64)
+	*filterWorkAddress.WorkAddressContactId = ormObj.Id
 	filterWorkAddress.AccountID = ormObj.AccountID
 	if err = db.Where(filterWorkAddress).Delete(AddressORM{}).Error; err != nil {
 		return nil, err
@@ -1417,6 +2105,34 @@ func DefaultApplyFieldMaskContact(ctx context.Context, patchee *Contact, ormObj
 		if f == "Nicknames" {
 			patchee.Nicknames = patcher.Nicknames
 		}
+		if f == "PhoneNumbers" {
+			patchee.PhoneNumbers = patcher.PhoneNumbers
+			filterPhoneNumbers := PhoneNumberORM{}
+			if ormObj.Id == 0 {
+				return nil, errors.New("Can't do overwriting update with no Id value for ContactORM")
+			}
+			filterPhoneNumbers.ContactId = new(int64)
+			*filterPhoneNumbers.ContactId = ormObj.Id
+			filterPhoneNumbers.AccountID = ormObj.AccountID
+			if err = db.Where(filterPhoneNumbers).Delete(PhoneNumberORM{}).Error; err != nil {
+				return nil, err
+			}
+		}
+		if f == "PrimaryPhone" {
+			patchee.PrimaryPhone = patcher.PrimaryPhone
+		}
+		if f == "Etag" {
+			patchee.Etag = patcher.Etag
+		}
+		if f == "CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+		}
+		if f == "UpdatedAt" {
+			patchee.UpdatedAt = patcher.UpdatedAt
+		}
+		if f == "CarddavName" {
+			patchee.CarddavName = patcher.CarddavName
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -1660,10 +2376,10 @@ func DefaultListEmail(ctx context.Context, db *gorm1.DB, req interface{}) ([]*Em
 	return pbResponse, nil
 }
 
-// DefaultCreateAddress executes a basic gorm create call
-func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm1.DB) (*Address, error) {
+// DefaultCreatePhoneNumber executes a basic gorm create call
+func DefaultCreatePhoneNumber(ctx context.Context, in *PhoneNumber, db *gorm1.DB) (*PhoneNumber, error) {
 	if in == nil {
-		return nil, errors.New("Nil argument to DefaultCreateAddress")
+		return nil, errors.New("Nil argument to DefaultCreatePhoneNumber")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1676,244 +2392,1477 @@ func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm1.DB) (*Addr
 	return &pbResponse, err
 }
 
-// DefaultListAddress executes a gorm list call
-func DefaultListAddress(ctx context.Context, db *gorm1.DB, req interface{}) ([]*Address, error) {
-	ormResponse := []AddressORM{}
-	f, s, p, fs, err := getCollectionOperators(req)
+// DefaultReadPhoneNumber executes a basic gorm read call
+func DefaultReadPhoneNumber(ctx context.Context, in *PhoneNumber, db *gorm1.DB) (*PhoneNumber, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultReadPhoneNumber")
+	}
+	db = db.Set("gorm:auto_preload", true)
+	ormParams, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	db, err = gorm2.ApplyCollectionOperators(db, &AddressORM{}, f, s, p, fs)
-	if err != nil {
+	ormResponse := PhoneNumberORM{}
+	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if fs.GetFields() == nil {
-		db = db.Set("gorm:auto_preload", true)
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultUpdatePhoneNumber executes a basic gorm update call
+func DefaultUpdatePhoneNumber(ctx context.Context, in *PhoneNumber, db *gorm1.DB) (*PhoneNumber, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultUpdatePhoneNumber")
 	}
-	in := Address{}
-	ormParams, err := in.ToORM(ctx)
+	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(&ormParams)
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if exists, err := DefaultReadPhoneNumber(ctx, &PhoneNumber{Id: in.GetId()}, db); err != nil {
 		return nil, err
+	} else if exists == nil {
+		return nil, errors.New("PhoneNumber not found")
 	}
-	pbResponse := []*Address{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
-		}
-		pbResponse = append(pbResponse, &temp)
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type ProfilesDefaultServer struct {
-	DB *gorm1.DB
+	ormObj.AccountID = accountID
+	db = db.Where(&PhoneNumberORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-// Create ...
-func (m *ProfilesDefaultServer) Create(ctx context.Context, in *CreateProfileRequest) (*CreateProfileResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeCreate); ok {
-		var err error
-		ctx, db, err = custom.BeforeCreate(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+func DefaultDeletePhoneNumber(ctx context.Context, in *PhoneNumber, db *gorm1.DB) error {
+	if in == nil {
+		return errors.New("Nil argument to DefaultDeletePhoneNumber")
 	}
-	res, err := DefaultCreateProfile(ctx, in.GetPayload(), db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return &CreateProfileResponse{Result: res}, nil
-}
-
-// ProfilesProfileWithBeforeCreate called before DefaultCreateProfile in the default Create handler
-type ProfilesProfileWithBeforeCreate interface {
-	BeforeCreate(context.Context, *CreateProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+	if ormObj.Id == 0 {
+		return errors.New("A non-zero ID value is required for a delete call")
+	}
+	err = db.Where(&ormObj).Delete(&PhoneNumberORM{}).Error
+	return err
 }
 
-// Read ...
-func (m *ProfilesDefaultServer) Read(ctx context.Context, in *ReadProfileRequest) (*ReadProfileResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeRead); ok {
-		var err error
-		ctx, db, err = custom.BeforeRead(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+// DefaultStrictUpdatePhoneNumber clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePhoneNumber(ctx context.Context, in *PhoneNumber, db *gorm1.DB) (*PhoneNumber, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePhoneNumber")
 	}
-	res, err := DefaultReadProfile(ctx, &Profile{Id: in.GetId()}, db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &ReadProfileResponse{Result: res}, nil
-}
-
-// ProfilesProfileWithBeforeRead called before DefaultReadProfile in the default Read handler
-type ProfilesProfileWithBeforeRead interface {
-	BeforeRead(context.Context, *ReadProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
-}
-
-// Update ...
-func (m *ProfilesDefaultServer) Update(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
-	var err error
-	var res *Profile
-	db := m.DB
-	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeUpdate); ok {
-		var err error
-		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+	count := 1
+	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	if err != nil {
+		return nil, err
 	}
-	res, err = DefaultStrictUpdateProfile(ctx, in.GetPayload(), db)
+	db = db.Where(&PhoneNumberORM{AccountID: ormObj.AccountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &UpdateProfileResponse{Result: res}, nil
-}
-
-// ProfilesProfileWithBeforeUpdate called before DefaultUpdateProfile in the default Update handler
-type ProfilesProfileWithBeforeUpdate interface {
-	BeforeUpdate(context.Context, *UpdateProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
-}
-
-// Delete ...
-func (m *ProfilesDefaultServer) Delete(ctx context.Context, in *DeleteProfileRequest) (*DeleteProfileResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeDelete); ok {
-		var err error
-		ctx, db, err = custom.BeforeDelete(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+	if count == 0 {
+		err = gateway1.SetCreated(ctx, "")
 	}
-	return &DeleteProfileResponse{}, DefaultDeleteProfile(ctx, &Profile{Id: in.GetId()}, db)
-}
-
-// ProfilesProfileWithBeforeDelete called before DefaultDeleteProfile in the default Delete handler
-type ProfilesProfileWithBeforeDelete interface {
-	BeforeDelete(context.Context, *DeleteProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+	return &pbResponse, err
 }
 
-// List ...
-func (m *ProfilesDefaultServer) List(ctx context.Context, in *ListProfileRequest) (*ListProfilesResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeList); ok {
-		var err error
-		ctx, db, err = custom.BeforeList(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+// DefaultPatchPhoneNumber executes a basic gorm update call with patch behavior
+func DefaultPatchPhoneNumber(ctx context.Context, in *PhoneNumber, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*PhoneNumber, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultPatchPhoneNumber")
 	}
-	res, err := DefaultListProfile(ctx, db, in)
+	accountID, err := auth1.GetAccountID(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ListProfilesResponse{Results: res}, nil
-}
-
-// ProfilesProfileWithBeforeList called before DefaultListProfile in the default List handler
-type ProfilesProfileWithBeforeList interface {
-	BeforeList(context.Context, *ListProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
-}
-type GroupsDefaultServer struct {
-	DB *gorm1.DB
-}
-
-// Create ...
-func (m *GroupsDefaultServer) Create(ctx context.Context, in *CreateGroupRequest) (*CreateGroupResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(GroupsGroupWithBeforeCreate); ok {
-		var err error
-		ctx, db, err = custom.BeforeCreate(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+	pbReadRes, err := DefaultReadPhoneNumber(ctx, &PhoneNumber{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
 	}
-	res, err := DefaultCreateGroup(ctx, in.GetPayload(), db)
+	pbObj := *pbReadRes
+	ormObj, err := pbObj.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &CreateGroupResponse{Result: res}, nil
-}
-
-// GroupsGroupWithBeforeCreate called before DefaultCreateGroup in the default Create handler
-type GroupsGroupWithBeforeCreate interface {
-	BeforeCreate(context.Context, *CreateGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
-}
-
-// Read ...
-func (m *GroupsDefaultServer) Read(ctx context.Context, in *ReadGroupRequest) (*ReadGroupResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(GroupsGroupWithBeforeRead); ok {
-		var err error
-		ctx, db, err = custom.BeforeRead(ctx, in, db)
-		if err != nil {
+	if _, err := DefaultApplyFieldMaskPhoneNumber(ctx, &pbObj, &ormObj, in, updateMask, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PhoneNumberWithBeforePatchSave); ok {
+		if ctx, db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	res, err := DefaultReadGroup(ctx, &Group{Id: in.GetId()}, db)
+	ormObj, err = pbObj.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &ReadGroupResponse{Result: res}, nil
+	db = db.Where(&PhoneNumberORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbObj, err = ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbObj, err
 }
 
-// GroupsGroupWithBeforeRead called before DefaultReadGroup in the default Read handler
-type GroupsGroupWithBeforeRead interface {
-	BeforeRead(context.Context, *ReadGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+type PhoneNumberWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PhoneNumber, *field_mask1.FieldMask, *gorm1.DB) (context.Context, *gorm1.DB, error)
 }
 
-// Update ...
-func (m *GroupsDefaultServer) Update(ctx context.Context, in *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+// DefaultApplyFieldMaskPhoneNumber patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPhoneNumber(ctx context.Context, patchee *PhoneNumber, ormObj *PhoneNumberORM, patcher *PhoneNumber, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*PhoneNumber, error) {
 	var err error
-	var res *Group
-	db := m.DB
-	if custom, ok := interface{}(in).(GroupsGroupWithBeforeUpdate); ok {
-		var err error
-		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
-		if err != nil {
-			return nil, err
+	for _, f := range updateMask.GetPaths() {
+		if f == "Id" {
+			patchee.Id = patcher.Id
+		}
+		if f == "Number" {
+			patchee.Number = patcher.Number
+		}
+		if f == "Type" {
+			patchee.Type = patcher.Type
 		}
 	}
-	res, err = DefaultStrictUpdateGroup(ctx, in.GetPayload(), db)
 	if err != nil {
 		return nil, err
 	}
-	return &UpdateGroupResponse{Result: res}, nil
-}
-
-// GroupsGroupWithBeforeUpdate called before DefaultUpdateGroup in the default Update handler
-type GroupsGroupWithBeforeUpdate interface {
-	BeforeUpdate(context.Context, *UpdateGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+	return patchee, nil
 }
 
-// Delete ...
-func (m *GroupsDefaultServer) Delete(ctx context.Context, in *DeleteGroupRequest) (*DeleteGroupResponse, error) {
-	db := m.DB
-	if custom, ok := interface{}(in).(GroupsGroupWithBeforeDelete); ok {
-		var err error
-		ctx, db, err = custom.BeforeDelete(ctx, in, db)
-		if err != nil {
-			return nil, err
-		}
+// DefaultListPhoneNumber executes a gorm list call
+func DefaultListPhoneNumber(ctx context.Context, db *gorm1.DB, req interface{}) ([]*PhoneNumber, error) {
+	ormResponse := []PhoneNumberORM{}
+	f, s, p, fs, err := getCollectionOperators(req)
+	if err != nil {
+		return nil, err
 	}
-	return &DeleteGroupResponse{}, DefaultDeleteGroup(ctx, &Group{Id: in.GetId()}, db)
-}
-
-// GroupsGroupWithBeforeDelete called before DefaultDeleteGroup in the default Delete handler
-type GroupsGroupWithBeforeDelete interface {
-	BeforeDelete(context.Context, *DeleteGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
-}
+	db, err = gorm2.ApplyCollectionOperators(db, &PhoneNumberORM{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if fs.GetFields() == nil {
+		db = db.Set("gorm:auto_preload", true)
+	}
+	in := PhoneNumber{}
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&ormParams)
+	db = db.Order("id")
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*PhoneNumber{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultCreateAddress executes a basic gorm create call
+func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm1.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultCreateAddress")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultListAddress executes a gorm list call
+func DefaultListAddress(ctx context.Context, db *gorm1.DB, req interface{}) ([]*Address, error) {
+	ormResponse := []AddressORM{}
+	f, s, p, fs, err := getCollectionOperators(req)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm2.ApplyCollectionOperators(db, &AddressORM{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if fs.GetFields() == nil {
+		db = db.Set("gorm:auto_preload", true)
+	}
+	in := Address{}
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&ormParams)
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*Address{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultCreateAuditEvent executes a basic gorm create call
+func DefaultCreateAuditEvent(ctx context.Context, in *AuditEvent, db *gorm1.DB) (*AuditEvent, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultCreateAuditEvent")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultReadAuditEvent executes a basic gorm read call
+func DefaultReadAuditEvent(ctx context.Context, in *AuditEvent, db *gorm1.DB) (*AuditEvent, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultReadAuditEvent")
+	}
+	db = db.Set("gorm:auto_preload", true)
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormResponse := AuditEventORM{}
+	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultUpdateAuditEvent executes a basic gorm update call
+func DefaultUpdateAuditEvent(ctx context.Context, in *AuditEvent, db *gorm1.DB) (*AuditEvent, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultUpdateAuditEvent")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists, err := DefaultReadAuditEvent(ctx, &AuditEvent{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	} else if exists == nil {
+		return nil, errors.New("AuditEvent not found")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.AccountID = accountID
+	db = db.Where(&AuditEventORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteAuditEvent(ctx context.Context, in *AuditEvent, db *gorm1.DB) error {
+	if in == nil {
+		return errors.New("Nil argument to DefaultDeleteAuditEvent")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.New("A non-zero ID value is required for a delete call")
+	}
+	err = db.Where(&ormObj).Delete(&AuditEventORM{}).Error
+	return err
+}
+
+// DefaultStrictUpdateAuditEvent clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAuditEvent(ctx context.Context, in *AuditEvent, db *gorm1.DB) (*AuditEvent, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAuditEvent")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := 1
+	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&AuditEventORM{AccountID: ormObj.AccountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway1.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+// DefaultPatchAuditEvent executes a basic gorm update call with patch behavior
+func DefaultPatchAuditEvent(ctx context.Context, in *AuditEvent, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*AuditEvent, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultPatchAuditEvent")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	pbReadRes, err := DefaultReadAuditEvent(ctx, &AuditEvent{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj := *pbReadRes
+	ormObj, err := pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := DefaultApplyFieldMaskAuditEvent(ctx, &pbObj, &ormObj, in, updateMask, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AuditEventWithBeforePatchSave); ok {
+		if ctx, db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	ormObj, err = pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&AuditEventORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbObj, err = ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbObj, err
+}
+
+type AuditEventWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *AuditEvent, *field_mask1.FieldMask, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// DefaultApplyFieldMaskAuditEvent patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAuditEvent(ctx context.Context, patchee *AuditEvent, ormObj *AuditEventORM, patcher *AuditEvent, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*AuditEvent, error) {
+	var err error
+	for _, f := range updateMask.GetPaths() {
+		if f == "Id" {
+			patchee.Id = patcher.Id
+		}
+		if f == "Subject" {
+			patchee.Subject = patcher.Subject
+		}
+		if f == "RequestId" {
+			patchee.RequestId = patcher.RequestId
+		}
+		if f == "Method" {
+			patchee.Method = patcher.Method
+		}
+		if f == "ResourceId" {
+			patchee.ResourceId = patcher.ResourceId
+		}
+		if f == "Diff" {
+			patchee.Diff = patcher.Diff
+		}
+		if f == "CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAuditEvent executes a gorm list call
+func DefaultListAuditEvent(ctx context.Context, db *gorm1.DB, req interface{}) ([]*AuditEvent, error) {
+	ormResponse := []AuditEventORM{}
+	f, s, p, fs, err := getCollectionOperators(req)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm2.ApplyCollectionOperators(db, &AuditEventORM{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if fs.GetFields() == nil {
+		db = db.Set("gorm:auto_preload", true)
+	}
+	in := AuditEvent{}
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&ormParams)
+	db = db.Order("id")
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*AuditEvent{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultCreateWebhookSubscription executes a basic gorm create call
+func DefaultCreateWebhookSubscription(ctx context.Context, in *WebhookSubscription, db *gorm1.DB) (*WebhookSubscription, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultCreateWebhookSubscription")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultReadWebhookSubscription executes a basic gorm read call
+func DefaultReadWebhookSubscription(ctx context.Context, in *WebhookSubscription, db *gorm1.DB) (*WebhookSubscription, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultReadWebhookSubscription")
+	}
+	db = db.Set("gorm:auto_preload", true)
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormResponse := WebhookSubscriptionORM{}
+	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultUpdateWebhookSubscription executes a basic gorm update call
+func DefaultUpdateWebhookSubscription(ctx context.Context, in *WebhookSubscription, db *gorm1.DB) (*WebhookSubscription, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultUpdateWebhookSubscription")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists, err := DefaultReadWebhookSubscription(ctx, &WebhookSubscription{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	} else if exists == nil {
+		return nil, errors.New("WebhookSubscription not found")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.AccountID = accountID
+	db = db.Where(&WebhookSubscriptionORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteWebhookSubscription(ctx context.Context, in *WebhookSubscription, db *gorm1.DB) error {
+	if in == nil {
+		return errors.New("Nil argument to DefaultDeleteWebhookSubscription")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.New("A non-zero ID value is required for a delete call")
+	}
+	err = db.Where(&ormObj).Delete(&WebhookSubscriptionORM{}).Error
+	return err
+}
+
+// DefaultStrictUpdateWebhookSubscription clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWebhookSubscription(ctx context.Context, in *WebhookSubscription, db *gorm1.DB) (*WebhookSubscription, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWebhookSubscription")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := 1
+	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&WebhookSubscriptionORM{AccountID: ormObj.AccountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway1.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+// DefaultPatchWebhookSubscription executes a basic gorm update call with patch behavior
+func DefaultPatchWebhookSubscription(ctx context.Context, in *WebhookSubscription, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*WebhookSubscription, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultPatchWebhookSubscription")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	pbReadRes, err := DefaultReadWebhookSubscription(ctx, &WebhookSubscription{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj := *pbReadRes
+	ormObj, err := pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := DefaultApplyFieldMaskWebhookSubscription(ctx, &pbObj, &ormObj, in, updateMask, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(WebhookSubscriptionWithBeforePatchSave); ok {
+		if ctx, db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	ormObj, err = pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&WebhookSubscriptionORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbObj, err = ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbObj, err
+}
+
+type WebhookSubscriptionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WebhookSubscription, *field_mask1.FieldMask, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// DefaultApplyFieldMaskWebhookSubscription patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWebhookSubscription(ctx context.Context, patchee *WebhookSubscription, ormObj *WebhookSubscriptionORM, patcher *WebhookSubscription, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*WebhookSubscription, error) {
+	var err error
+	for _, f := range updateMask.GetPaths() {
+		if f == "Id" {
+			patchee.Id = patcher.Id
+		}
+		if f == "Url" {
+			patchee.Url = patcher.Url
+		}
+		if f == "EventTypes" {
+			patchee.EventTypes = patcher.EventTypes
+		}
+		if f == "Secret" {
+			patchee.Secret = patcher.Secret
+		}
+		if f == "Disabled" {
+			patchee.Disabled = patcher.Disabled
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListWebhookSubscription executes a gorm list call
+func DefaultListWebhookSubscription(ctx context.Context, db *gorm1.DB, req interface{}) ([]*WebhookSubscription, error) {
+	ormResponse := []WebhookSubscriptionORM{}
+	f, s, p, fs, err := getCollectionOperators(req)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm2.ApplyCollectionOperators(db, &WebhookSubscriptionORM{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if fs.GetFields() == nil {
+		db = db.Set("gorm:auto_preload", true)
+	}
+	in := WebhookSubscription{}
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&ormParams)
+	db = db.Order("id")
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*WebhookSubscription{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultCreateWebhookDelivery executes a basic gorm create call
+func DefaultCreateWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm1.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultCreateWebhookDelivery")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultReadWebhookDelivery executes a basic gorm read call
+func DefaultReadWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm1.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultReadWebhookDelivery")
+	}
+	db = db.Set("gorm:auto_preload", true)
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormResponse := WebhookDeliveryORM{}
+	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultUpdateWebhookDelivery executes a basic gorm update call
+func DefaultUpdateWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm1.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultUpdateWebhookDelivery")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists, err := DefaultReadWebhookDelivery(ctx, &WebhookDelivery{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	} else if exists == nil {
+		return nil, errors.New("WebhookDelivery not found")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.AccountID = accountID
+	db = db.Where(&WebhookDeliveryORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm1.DB) error {
+	if in == nil {
+		return errors.New("Nil argument to DefaultDeleteWebhookDelivery")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.New("A non-zero ID value is required for a delete call")
+	}
+	err = db.Where(&ormObj).Delete(&WebhookDeliveryORM{}).Error
+	return err
+}
+
+// DefaultStrictUpdateWebhookDelivery clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWebhookDelivery(ctx context.Context, in *WebhookDelivery, db *gorm1.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWebhookDelivery")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := 1
+	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&WebhookDeliveryORM{AccountID: ormObj.AccountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway1.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+// DefaultPatchWebhookDelivery executes a basic gorm update call with patch behavior
+func DefaultPatchWebhookDelivery(ctx context.Context, in *WebhookDelivery, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*WebhookDelivery, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultPatchWebhookDelivery")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	pbReadRes, err := DefaultReadWebhookDelivery(ctx, &WebhookDelivery{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj := *pbReadRes
+	ormObj, err := pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := DefaultApplyFieldMaskWebhookDelivery(ctx, &pbObj, &ormObj, in, updateMask, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(WebhookDeliveryWithBeforePatchSave); ok {
+		if ctx, db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	ormObj, err = pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&WebhookDeliveryORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbObj, err = ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbObj, err
+}
+
+type WebhookDeliveryWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WebhookDelivery, *field_mask1.FieldMask, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// DefaultApplyFieldMaskWebhookDelivery patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWebhookDelivery(ctx context.Context, patchee *WebhookDelivery, ormObj *WebhookDeliveryORM, patcher *WebhookDelivery, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*WebhookDelivery, error) {
+	var err error
+	for _, f := range updateMask.GetPaths() {
+		if f == "Id" {
+			patchee.Id = patcher.Id
+		}
+		if f == "SubscriptionId" {
+			patchee.SubscriptionId = patcher.SubscriptionId
+		}
+		if f == "EventType" {
+			patchee.EventType = patcher.EventType
+		}
+		if f == "Payload" {
+			patchee.Payload = patcher.Payload
+		}
+		if f == "Status" {
+			patchee.Status = patcher.Status
+		}
+		if f == "Attempts" {
+			patchee.Attempts = patcher.Attempts
+		}
+		if f == "LastStatusCode" {
+			patchee.LastStatusCode = patcher.LastStatusCode
+		}
+		if f == "LastError" {
+			patchee.LastError = patcher.LastError
+		}
+		if f == "NextAttemptAt" {
+			patchee.NextAttemptAt = patcher.NextAttemptAt
+		}
+		if f == "CreatedAt" {
+			patchee.CreatedAt = patcher.CreatedAt
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListWebhookDelivery executes a gorm list call
+func DefaultListWebhookDelivery(ctx context.Context, db *gorm1.DB, req interface{}) ([]*WebhookDelivery, error) {
+	ormResponse := []WebhookDeliveryORM{}
+	f, s, p, fs, err := getCollectionOperators(req)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm2.ApplyCollectionOperators(db, &WebhookDeliveryORM{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if fs.GetFields() == nil {
+		db = db.Set("gorm:auto_preload", true)
+	}
+	in := WebhookDelivery{}
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&ormParams)
+	db = db.Order("id")
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*WebhookDelivery{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultCreateSavedSearch executes a basic gorm create call
+func DefaultCreateSavedSearch(ctx context.Context, in *SavedSearch, db *gorm1.DB) (*SavedSearch, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultCreateSavedSearch")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultReadSavedSearch executes a basic gorm read call
+func DefaultReadSavedSearch(ctx context.Context, in *SavedSearch, db *gorm1.DB) (*SavedSearch, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultReadSavedSearch")
+	}
+	db = db.Set("gorm:auto_preload", true)
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormResponse := SavedSearchORM{}
+	if err = db.Where(&ormParams).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+// DefaultUpdateSavedSearch executes a basic gorm update call
+func DefaultUpdateSavedSearch(ctx context.Context, in *SavedSearch, db *gorm1.DB) (*SavedSearch, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultUpdateSavedSearch")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if exists, err := DefaultReadSavedSearch(ctx, &SavedSearch{Id: in.GetId()}, db); err != nil {
+		return nil, err
+	} else if exists == nil {
+		return nil, errors.New("SavedSearch not found")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.AccountID = accountID
+	db = db.Where(&SavedSearchORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteSavedSearch(ctx context.Context, in *SavedSearch, db *gorm1.DB) error {
+	if in == nil {
+		return errors.New("Nil argument to DefaultDeleteSavedSearch")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.New("A non-zero ID value is required for a delete call")
+	}
+	err = db.Where(&ormObj).Delete(&SavedSearchORM{}).Error
+	return err
+}
+
+// DefaultStrictUpdateSavedSearch clears first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSavedSearch(ctx context.Context, in *SavedSearch, db *gorm1.DB) (*SavedSearch, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateSavedSearch")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := 1
+	err = db.Model(&ormObj).Where("id=?", ormObj.Id).Count(&count).Error
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&SavedSearchORM{AccountID: ormObj.AccountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway1.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+// DefaultPatchSavedSearch executes a basic gorm update call with patch behavior
+func DefaultPatchSavedSearch(ctx context.Context, in *SavedSearch, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*SavedSearch, error) {
+	if in == nil {
+		return nil, errors.New("Nil argument to DefaultPatchSavedSearch")
+	}
+	accountID, err := auth1.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	pbReadRes, err := DefaultReadSavedSearch(ctx, &SavedSearch{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj := *pbReadRes
+	ormObj, err := pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := DefaultApplyFieldMaskSavedSearch(ctx, &pbObj, &ormObj, in, updateMask, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SavedSearchWithBeforePatchSave); ok {
+		if ctx, db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	ormObj, err = pbObj.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&SavedSearchORM{AccountID: accountID})
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	pbObj, err = ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbObj, err
+}
+
+type SavedSearchWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *SavedSearch, *field_mask1.FieldMask, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// DefaultApplyFieldMaskSavedSearch patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSavedSearch(ctx context.Context, patchee *SavedSearch, ormObj *SavedSearchORM, patcher *SavedSearch, updateMask *field_mask1.FieldMask, db *gorm1.DB) (*SavedSearch, error) {
+	var err error
+	for _, f := range updateMask.GetPaths() {
+		if f == "Id" {
+			patchee.Id = patcher.Id
+		}
+		if f == "Name" {
+			patchee.Name = patcher.Name
+		}
+		if f == "ProfileId" {
+			patchee.ProfileId = patcher.ProfileId
+		}
+		if f == "Filter" {
+			patchee.Filter = patcher.Filter
+		}
+		if f == "OrderBy" {
+			patchee.OrderBy = patcher.OrderBy
+		}
+		if f == "Fields" {
+			patchee.Fields = patcher.Fields
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSavedSearch executes a gorm list call
+func DefaultListSavedSearch(ctx context.Context, db *gorm1.DB, req interface{}) ([]*SavedSearch, error) {
+	ormResponse := []SavedSearchORM{}
+	f, s, p, fs, err := getCollectionOperators(req)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm2.ApplyCollectionOperators(db, &SavedSearchORM{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if fs.GetFields() == nil {
+		db = db.Set("gorm:auto_preload", true)
+	}
+	in := SavedSearch{}
+	ormParams, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(&ormParams)
+	db = db.Order("id")
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse := []*SavedSearch{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ProfilesDefaultServer struct {
+}
+
+// Create ...
+func (m *ProfilesDefaultServer) Create(ctx context.Context, in *CreateProfileRequest) (*CreateProfileResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeCreate); ok {
+		var err error
+		ctx, db, err = custom.BeforeCreate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateProfile(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateProfileResponse{Result: res}, nil
+}
+
+// ProfilesProfileWithBeforeCreate called before DefaultCreateProfile in the default Create handler
+type ProfilesProfileWithBeforeCreate interface {
+	BeforeCreate(context.Context, *CreateProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Read ...
+func (m *ProfilesDefaultServer) Read(ctx context.Context, in *ReadProfileRequest) (*ReadProfileResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeRead); ok {
+		var err error
+		ctx, db, err = custom.BeforeRead(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadProfile(ctx, &Profile{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadProfileResponse{Result: res}, nil
+}
+
+// ProfilesProfileWithBeforeRead called before DefaultReadProfile in the default Read handler
+type ProfilesProfileWithBeforeRead interface {
+	BeforeRead(context.Context, *ReadProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Update ...
+func (m *ProfilesDefaultServer) Update(ctx context.Context, in *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	var err error
+	var res *Profile
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeUpdate); ok {
+		var err error
+		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err = DefaultStrictUpdateProfile(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateProfileResponse{Result: res}, nil
+}
+
+// ProfilesProfileWithBeforeUpdate called before DefaultUpdateProfile in the default Update handler
+type ProfilesProfileWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *UpdateProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Delete ...
+func (m *ProfilesDefaultServer) Delete(ctx context.Context, in *DeleteProfileRequest) (*DeleteProfileResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeDelete); ok {
+		var err error
+		ctx, db, err = custom.BeforeDelete(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &DeleteProfileResponse{}, DefaultDeleteProfile(ctx, &Profile{Id: in.GetId()}, db)
+}
+
+// ProfilesProfileWithBeforeDelete called before DefaultDeleteProfile in the default Delete handler
+type ProfilesProfileWithBeforeDelete interface {
+	BeforeDelete(context.Context, *DeleteProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Undelete ...
+func (m *ProfilesDefaultServer) Undelete(ctx context.Context, in *UndeleteProfileRequest) (*UndeleteProfileResponse, error) {
+	return &UndeleteProfileResponse{}, nil
+}
+
+// List ...
+func (m *ProfilesDefaultServer) List(ctx context.Context, in *ListProfileRequest) (*ListProfilesResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesProfileWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListProfile(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListProfilesResponse{Results: res}, nil
+}
+
+// ProfilesProfileWithBeforeList called before DefaultListProfile in the default List handler
+type ProfilesProfileWithBeforeList interface {
+	BeforeList(context.Context, *ListProfileRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// ListContacts ...
+func (m *ProfilesDefaultServer) ListContacts(ctx context.Context, in *ListProfileContactsRequest) (*ListContactsResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesContactWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListContact(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListContactsResponse{Results: res}, nil
+}
+
+// ProfilesContactWithBeforeList called before DefaultListContact in the default List handler
+type ProfilesContactWithBeforeList interface {
+	BeforeList(context.Context, *ListProfileContactsRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// ListGroups ...
+func (m *ProfilesDefaultServer) ListGroups(ctx context.Context, in *ListProfileGroupsRequest) (*ListGroupsResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesGroupWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListGroup(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListGroupsResponse{Results: res}, nil
+}
+
+// ProfilesGroupWithBeforeList called before DefaultListGroup in the default List handler
+type ProfilesGroupWithBeforeList interface {
+	BeforeList(context.Context, *ListProfileGroupsRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// CreateContact ...
+func (m *ProfilesDefaultServer) CreateContact(ctx context.Context, in *CreateProfileContactRequest) (*CreateContactResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(ProfilesContactWithBeforeCreate); ok {
+		var err error
+		ctx, db, err = custom.BeforeCreate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateContact(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateContactResponse{Result: res}, nil
+}
+
+// ProfilesContactWithBeforeCreate called before DefaultCreateContact in the default Create handler
+type ProfilesContactWithBeforeCreate interface {
+	BeforeCreate(context.Context, *CreateProfileContactRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+type GroupsDefaultServer struct {
+}
+
+// Create ...
+func (m *GroupsDefaultServer) Create(ctx context.Context, in *CreateGroupRequest) (*CreateGroupResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(GroupsGroupWithBeforeCreate); ok {
+		var err error
+		ctx, db, err = custom.BeforeCreate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateGroup(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateGroupResponse{Result: res}, nil
+}
+
+// GroupsGroupWithBeforeCreate called before DefaultCreateGroup in the default Create handler
+type GroupsGroupWithBeforeCreate interface {
+	BeforeCreate(context.Context, *CreateGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Read ...
+func (m *GroupsDefaultServer) Read(ctx context.Context, in *ReadGroupRequest) (*ReadGroupResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(GroupsGroupWithBeforeRead); ok {
+		var err error
+		ctx, db, err = custom.BeforeRead(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadGroup(ctx, &Group{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadGroupResponse{Result: res}, nil
+}
+
+// GroupsGroupWithBeforeRead called before DefaultReadGroup in the default Read handler
+type GroupsGroupWithBeforeRead interface {
+	BeforeRead(context.Context, *ReadGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Update ...
+func (m *GroupsDefaultServer) Update(ctx context.Context, in *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+	var err error
+	var res *Group
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(GroupsGroupWithBeforeUpdate); ok {
+		var err error
+		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err = DefaultStrictUpdateGroup(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateGroupResponse{Result: res}, nil
+}
+
+// GroupsGroupWithBeforeUpdate called before DefaultUpdateGroup in the default Update handler
+type GroupsGroupWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *UpdateGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Delete ...
+func (m *GroupsDefaultServer) Delete(ctx context.Context, in *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(GroupsGroupWithBeforeDelete); ok {
+		var err error
+		ctx, db, err = custom.BeforeDelete(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &DeleteGroupResponse{}, DefaultDeleteGroup(ctx, &Group{Id: in.GetId()}, db)
+}
+
+// GroupsGroupWithBeforeDelete called before DefaultDeleteGroup in the default Delete handler
+type GroupsGroupWithBeforeDelete interface {
+	BeforeDelete(context.Context, *DeleteGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Undelete ...
+func (m *GroupsDefaultServer) Undelete(ctx context.Context, in *UndeleteGroupRequest) (*UndeleteGroupResponse, error) {
+	return &UndeleteGroupResponse{}, nil
+}
 
 // List ...
 func (m *GroupsDefaultServer) List(ctx context.Context, in *ListGroupRequest) (*ListGroupsResponse, error) {
-	db := m.DB
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
 	if custom, ok := interface{}(in).(GroupsGroupWithBeforeList); ok {
 		var err error
 		ctx, db, err = custom.BeforeList(ctx, in, db)
@@ -1932,13 +3881,58 @@ func (m *GroupsDefaultServer) List(ctx context.Context, in *ListGroupRequest) (*
 type GroupsGroupWithBeforeList interface {
 	BeforeList(context.Context, *ListGroupRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
 }
+
+// AddContacts ...
+func (m *GroupsDefaultServer) AddContacts(ctx context.Context, in *AddGroupContactsRequest) (*AddGroupContactsResponse, error) {
+	return &AddGroupContactsResponse{}, nil
+}
+
+// RemoveContacts ...
+func (m *GroupsDefaultServer) RemoveContacts(ctx context.Context, in *RemoveGroupContactsRequest) (*RemoveGroupContactsResponse, error) {
+	return &RemoveGroupContactsResponse{}, nil
+}
+
+// ListMembers ...
+func (m *GroupsDefaultServer) ListMembers(ctx context.Context, in *ListGroupMembersRequest) (*ListGroupMembersResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(GroupsContactWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListContact(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListGroupMembersResponse{Results: res}, nil
+}
+
+// GroupsContactWithBeforeList called before DefaultListContact in the default List handler
+type GroupsContactWithBeforeList interface {
+	BeforeList(context.Context, *ListGroupMembersRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
 type ContactsDefaultServer struct {
-	DB *gorm1.DB
 }
 
 // Create ...
 func (m *ContactsDefaultServer) Create(ctx context.Context, in *CreateContactRequest) (*CreateContactResponse, error) {
-	db := m.DB
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
 	if custom, ok := interface{}(in).(ContactsContactWithBeforeCreate); ok {
 		var err error
 		ctx, db, err = custom.BeforeCreate(ctx, in, db)
@@ -1960,7 +3954,14 @@ type ContactsContactWithBeforeCreate interface {
 
 // Read ...
 func (m *ContactsDefaultServer) Read(ctx context.Context, in *ReadContactRequest) (*ReadContactResponse, error) {
-	db := m.DB
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
 	if custom, ok := interface{}(in).(ContactsContactWithBeforeRead); ok {
 		var err error
 		ctx, db, err = custom.BeforeRead(ctx, in, db)
@@ -1984,7 +3985,14 @@ type ContactsContactWithBeforeRead interface {
 func (m *ContactsDefaultServer) Update(ctx context.Context, in *UpdateContactRequest) (*UpdateContactResponse, error) {
 	var err error
 	var res *Contact
-	db := m.DB
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
 	if custom, ok := interface{}(in).(ContactsContactWithBeforeUpdate); ok {
 		var err error
 		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
@@ -2010,7 +4018,14 @@ type ContactsContactWithBeforeUpdate interface {
 
 // Delete ...
 func (m *ContactsDefaultServer) Delete(ctx context.Context, in *DeleteContactRequest) (*DeleteContactResponse, error) {
-	db := m.DB
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
 	if custom, ok := interface{}(in).(ContactsContactWithBeforeDelete); ok {
 		var err error
 		ctx, db, err = custom.BeforeDelete(ctx, in, db)
@@ -2026,9 +4041,21 @@ type ContactsContactWithBeforeDelete interface {
 	BeforeDelete(context.Context, *DeleteContactRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
 }
 
+// Undelete ...
+func (m *ContactsDefaultServer) Undelete(ctx context.Context, in *UndeleteContactRequest) (*UndeleteContactResponse, error) {
+	return &UndeleteContactResponse{}, nil
+}
+
 // List ...
 func (m *ContactsDefaultServer) List(ctx context.Context, in *ListContactRequest) (*ListContactsResponse, error) {
-	db := m.DB
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
 	if custom, ok := interface{}(in).(ContactsContactWithBeforeList); ok {
 		var err error
 		ctx, db, err = custom.BeforeList(ctx, in, db)
@@ -2052,3 +4079,419 @@ type ContactsContactWithBeforeList interface {
 func (m *ContactsDefaultServer) SendSMS(ctx context.Context, in *SMSRequest) (*SMSResponse, error) {
 	return &SMSResponse{}, nil
 }
+
+// Sync ...
+func (m *ContactsDefaultServer) Sync(ctx context.Context, in *SyncContactsRequest) (*SyncContactsResponse, error) {
+	return &SyncContactsResponse{}, nil
+}
+
+// Search ...
+func (m *ContactsDefaultServer) Search(ctx context.Context, in *SearchContactsRequest) (*SearchContactsResponse, error) {
+	return &SearchContactsResponse{}, nil
+}
+
+// Suggest ...
+func (m *ContactsDefaultServer) Suggest(ctx context.Context, in *SuggestContactsRequest) (*SuggestContactsResponse, error) {
+	return &SuggestContactsResponse{}, nil
+}
+
+// FindDuplicates ...
+func (m *ContactsDefaultServer) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest) (*FindDuplicatesResponse, error) {
+	return &FindDuplicatesResponse{}, nil
+}
+
+// Merge ...
+func (m *ContactsDefaultServer) Merge(ctx context.Context, in *MergeContactsRequest) (*MergeContactsResponse, error) {
+	return &MergeContactsResponse{}, nil
+}
+
+// RunSavedSearch ...
+func (m *ContactsDefaultServer) RunSavedSearch(ctx context.Context, in *RunSavedSearchRequest) (*ListContactsResponse, error) {
+	return &ListContactsResponse{}, nil
+}
+
+// Watch ...
+func (m *ContactsDefaultServer) Watch(ctx context.Context, in *WatchContactsRequest) (*ContactEvent, error) {
+	return &ContactEvent{}, nil
+}
+
+// BatchCreate ...
+func (m *ContactsDefaultServer) BatchCreate(ctx context.Context, in *BatchCreateContactsRequest) (*BatchCreateContactsResponse, error) {
+	return &BatchCreateContactsResponse{}, nil
+}
+
+// BatchUpdate ...
+func (m *ContactsDefaultServer) BatchUpdate(ctx context.Context, in *BatchUpdateContactsRequest) (*BatchUpdateContactsResponse, error) {
+	return &BatchUpdateContactsResponse{}, nil
+}
+
+// BatchDelete ...
+func (m *ContactsDefaultServer) BatchDelete(ctx context.Context, in *BatchDeleteContactsRequest) (*BatchDeleteContactsResponse, error) {
+	return &BatchDeleteContactsResponse{}, nil
+}
+
+// Export ...
+func (m *ContactsDefaultServer) Export(ctx context.Context, in *ExportContactsRequest) (*FileChunk, error) {
+	return &FileChunk{}, nil
+}
+
+// Import ...
+func (m *ContactsDefaultServer) Import(ctx context.Context, in *ImportContactsRequest) (*ImportContactsResponse, error) {
+	return &ImportContactsResponse{}, nil
+}
+
+// ReadVCard ...
+func (m *ContactsDefaultServer) ReadVCard(ctx context.Context, in *ReadVCardRequest) (*FileChunk, error) {
+	return &FileChunk{}, nil
+}
+
+type AuditLogDefaultServer struct {
+}
+
+// List ...
+func (m *AuditLogDefaultServer) List(ctx context.Context, in *ListAuditEventRequest) (*ListAuditEventsResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(AuditLogAuditEventWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListAuditEvent(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListAuditEventsResponse{Results: res}, nil
+}
+
+// AuditLogAuditEventWithBeforeList called before DefaultListAuditEvent in the default List handler
+type AuditLogAuditEventWithBeforeList interface {
+	BeforeList(context.Context, *ListAuditEventRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+type WebhooksDefaultServer struct {
+}
+
+// Create ...
+func (m *WebhooksDefaultServer) Create(ctx context.Context, in *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(WebhooksWebhookSubscriptionWithBeforeCreate); ok {
+		var err error
+		ctx, db, err = custom.BeforeCreate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateWebhookSubscription(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateWebhookSubscriptionResponse{Result: res}, nil
+}
+
+// WebhooksWebhookSubscriptionWithBeforeCreate called before DefaultCreateWebhookSubscription in the default Create handler
+type WebhooksWebhookSubscriptionWithBeforeCreate interface {
+	BeforeCreate(context.Context, *CreateWebhookSubscriptionRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Read ...
+func (m *WebhooksDefaultServer) Read(ctx context.Context, in *ReadWebhookSubscriptionRequest) (*ReadWebhookSubscriptionResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(WebhooksWebhookSubscriptionWithBeforeRead); ok {
+		var err error
+		ctx, db, err = custom.BeforeRead(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadWebhookSubscription(ctx, &WebhookSubscription{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadWebhookSubscriptionResponse{Result: res}, nil
+}
+
+// WebhooksWebhookSubscriptionWithBeforeRead called before DefaultReadWebhookSubscription in the default Read handler
+type WebhooksWebhookSubscriptionWithBeforeRead interface {
+	BeforeRead(context.Context, *ReadWebhookSubscriptionRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Update ...
+func (m *WebhooksDefaultServer) Update(ctx context.Context, in *UpdateWebhookSubscriptionRequest) (*UpdateWebhookSubscriptionResponse, error) {
+	var err error
+	var res *WebhookSubscription
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(WebhooksWebhookSubscriptionWithBeforeUpdate); ok {
+		var err error
+		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err = DefaultStrictUpdateWebhookSubscription(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateWebhookSubscriptionResponse{Result: res}, nil
+}
+
+// WebhooksWebhookSubscriptionWithBeforeUpdate called before DefaultUpdateWebhookSubscription in the default Update handler
+type WebhooksWebhookSubscriptionWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *UpdateWebhookSubscriptionRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Delete ...
+func (m *WebhooksDefaultServer) Delete(ctx context.Context, in *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(WebhooksWebhookSubscriptionWithBeforeDelete); ok {
+		var err error
+		ctx, db, err = custom.BeforeDelete(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &DeleteWebhookSubscriptionResponse{}, DefaultDeleteWebhookSubscription(ctx, &WebhookSubscription{Id: in.GetId()}, db)
+}
+
+// WebhooksWebhookSubscriptionWithBeforeDelete called before DefaultDeleteWebhookSubscription in the default Delete handler
+type WebhooksWebhookSubscriptionWithBeforeDelete interface {
+	BeforeDelete(context.Context, *DeleteWebhookSubscriptionRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// List ...
+func (m *WebhooksDefaultServer) List(ctx context.Context, in *ListWebhookSubscriptionRequest) (*ListWebhookSubscriptionsResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(WebhooksWebhookSubscriptionWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListWebhookSubscription(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListWebhookSubscriptionsResponse{Results: res}, nil
+}
+
+// WebhooksWebhookSubscriptionWithBeforeList called before DefaultListWebhookSubscription in the default List handler
+type WebhooksWebhookSubscriptionWithBeforeList interface {
+	BeforeList(context.Context, *ListWebhookSubscriptionRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// ListDeliveries ...
+func (m *WebhooksDefaultServer) ListDeliveries(ctx context.Context, in *ListWebhookDeliveryRequest) (*ListWebhookDeliveriesResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(WebhooksWebhookDeliveryWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListWebhookDelivery(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListWebhookDeliveriesResponse{Results: res}, nil
+}
+
+// WebhooksWebhookDeliveryWithBeforeList called before DefaultListWebhookDelivery in the default List handler
+type WebhooksWebhookDeliveryWithBeforeList interface {
+	BeforeList(context.Context, *ListWebhookDeliveryRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+type SavedSearchesDefaultServer struct {
+}
+
+// Create ...
+func (m *SavedSearchesDefaultServer) Create(ctx context.Context, in *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(SavedSearchesSavedSearchWithBeforeCreate); ok {
+		var err error
+		ctx, db, err = custom.BeforeCreate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateSavedSearch(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateSavedSearchResponse{Result: res}, nil
+}
+
+// SavedSearchesSavedSearchWithBeforeCreate called before DefaultCreateSavedSearch in the default Create handler
+type SavedSearchesSavedSearchWithBeforeCreate interface {
+	BeforeCreate(context.Context, *CreateSavedSearchRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Read ...
+func (m *SavedSearchesDefaultServer) Read(ctx context.Context, in *ReadSavedSearchRequest) (*ReadSavedSearchResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(SavedSearchesSavedSearchWithBeforeRead); ok {
+		var err error
+		ctx, db, err = custom.BeforeRead(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadSavedSearch(ctx, &SavedSearch{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadSavedSearchResponse{Result: res}, nil
+}
+
+// SavedSearchesSavedSearchWithBeforeRead called before DefaultReadSavedSearch in the default Read handler
+type SavedSearchesSavedSearchWithBeforeRead interface {
+	BeforeRead(context.Context, *ReadSavedSearchRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Update ...
+func (m *SavedSearchesDefaultServer) Update(ctx context.Context, in *UpdateSavedSearchRequest) (*UpdateSavedSearchResponse, error) {
+	var err error
+	var res *SavedSearch
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(SavedSearchesSavedSearchWithBeforeUpdate); ok {
+		var err error
+		ctx, db, err = custom.BeforeUpdate(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err = DefaultStrictUpdateSavedSearch(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateSavedSearchResponse{Result: res}, nil
+}
+
+// SavedSearchesSavedSearchWithBeforeUpdate called before DefaultUpdateSavedSearch in the default Update handler
+type SavedSearchesSavedSearchWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *UpdateSavedSearchRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// Delete ...
+func (m *SavedSearchesDefaultServer) Delete(ctx context.Context, in *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(SavedSearchesSavedSearchWithBeforeDelete); ok {
+		var err error
+		ctx, db, err = custom.BeforeDelete(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &DeleteSavedSearchResponse{}, DefaultDeleteSavedSearch(ctx, &SavedSearch{Id: in.GetId()}, db)
+}
+
+// SavedSearchesSavedSearchWithBeforeDelete called before DefaultDeleteSavedSearch in the default Delete handler
+type SavedSearchesSavedSearchWithBeforeDelete interface {
+	BeforeDelete(context.Context, *DeleteSavedSearchRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
+
+// List ...
+func (m *SavedSearchesDefaultServer) List(ctx context.Context, in *ListSavedSearchRequest) (*ListSavedSearchesResponse, error) {
+	txn, ok := gorm2.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("Database Transaction For Request Missing")
+	}
+	db := txn.Begin()
+	if db.Error != nil {
+		return nil, db.Error
+	}
+	if custom, ok := interface{}(in).(SavedSearchesSavedSearchWithBeforeList); ok {
+		var err error
+		ctx, db, err = custom.BeforeList(ctx, in, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListSavedSearch(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &ListSavedSearchesResponse{Results: res}, nil
+}
+
+// SavedSearchesSavedSearchWithBeforeList called before DefaultListSavedSearch in the default List handler
+type SavedSearchesSavedSearchWithBeforeList interface {
+	BeforeList(context.Context, *ListSavedSearchRequest, *gorm1.DB) (context.Context, *gorm1.DB, error)
+}
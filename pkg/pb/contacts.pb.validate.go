@@ -91,6 +91,32 @@ func (m *Profile) Validate() error {
 
 	}
 
+	// no validation rules for Etag
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ProfileValidationError{
+				Field:  "CreatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ProfileValidationError{
+				Field:  "UpdatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -768,6 +794,164 @@ var _ interface {
 	GetErrorName() string
 } = DeleteProfileResponseValidationError{}
 
+// Validate checks the field values on UndeleteProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UndeleteProfileRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteProfileRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeleteProfileRequestValidationError is the validation error returned by
+// UndeleteProfileRequest.Validate if the designated constraints aren't met.
+type UndeleteProfileRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UndeleteProfileRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UndeleteProfileRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UndeleteProfileRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UndeleteProfileRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UndeleteProfileRequestValidationError) GetErrorName() string {
+	return "UndeleteProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteProfileRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UndeleteProfileRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UndeleteProfileRequestValidationError{}
+
+// Validate checks the field values on UndeleteProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UndeleteProfileResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteProfileResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeleteProfileResponseValidationError is the validation error returned by
+// UndeleteProfileResponse.Validate if the designated constraints aren't met.
+type UndeleteProfileResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UndeleteProfileResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UndeleteProfileResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UndeleteProfileResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UndeleteProfileResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UndeleteProfileResponseValidationError) GetErrorName() string {
+	return "UndeleteProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteProfileResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UndeleteProfileResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UndeleteProfileResponseValidationError{}
+
 // Validate checks the field values on ListProfileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -824,6 +1008,8 @@ func (m *ListProfileRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for ShowDeleted
+
 	return nil
 }
 
@@ -967,9 +1153,10 @@ var _ interface {
 	GetErrorName() string
 } = ListProfilesResponseValidationError{}
 
-// Validate checks the field values on Group with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Group) Validate() error {
+// Validate checks the field values on ListProfileContactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListProfileContactsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -978,7 +1165,7 @@ func (m *Group) Validate() error {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return GroupValidationError{
+			return ListProfileContactsRequestValidationError{
 				Field:  "Id",
 				Reason: "embedded message failed validation",
 				Cause:  err,
@@ -986,45 +1173,60 @@ func (m *Group) Validate() error {
 		}
 	}
 
-	// no validation rules for Name
-
-	// no validation rules for Notes
-
-	if v, ok := interface{}(m.GetProfileId()).(interface {
+	if v, ok := interface{}(m.GetFilter()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return GroupValidationError{
-				Field:  "ProfileId",
+			return ListProfileContactsRequestValidationError{
+				Field:  "Filter",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetContacts() {
-		_, _ = idx, item
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileContactsRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
 
-		if v, ok := interface{}(item).(interface {
-			Validate() error
-		}); ok {
-			if err := v.Validate(); err != nil {
-				return GroupValidationError{
-					Field:  fmt.Sprintf("Contacts[%v]", idx),
-					Reason: "embedded message failed validation",
-					Cause:  err,
-				}
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileContactsRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
 			}
 		}
+	}
 
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileContactsRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
 	}
 
 	return nil
 }
 
-// GroupValidationError is the validation error returned by Group.Validate if
-// the designated constraints aren't met.
-type GroupValidationError struct {
+// ListProfileContactsRequestValidationError is the validation error returned
+// by ListProfileContactsRequest.Validate if the designated constraints aren't met.
+type ListProfileContactsRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1032,22 +1234,24 @@ type GroupValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e GroupValidationError) GetField() string { return e.Field }
+func (e ListProfileContactsRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e GroupValidationError) GetReason() string { return e.Reason }
+func (e ListProfileContactsRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e GroupValidationError) GetCause() error { return e.Cause }
+func (e ListProfileContactsRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e GroupValidationError) GetKey() bool { return e.Key }
+func (e ListProfileContactsRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e GroupValidationError) GetErrorName() string { return "GroupValidationError" }
+func (e ListProfileContactsRequestValidationError) GetErrorName() string {
+	return "ListProfileContactsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GroupValidationError) Error() string {
+func (e ListProfileContactsRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1059,14 +1263,14 @@ func (e GroupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGroup.%s: %s%s",
+		"invalid %sListProfileContactsRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = GroupValidationError{}
+var _ error = ListProfileContactsRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1074,59 +1278,107 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = GroupValidationError{}
+} = ListProfileContactsRequestValidationError{}
 
-// Validate checks the field values on CreateGroupRequest with the rules
+// Validate checks the field values on ListProfileGroupsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateGroupRequest) Validate() error {
+func (m *ListProfileGroupsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPayload()).(interface {
+	if v, ok := interface{}(m.GetId()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return CreateGroupRequestValidationError{
-				Field:  "Payload",
+			return ListProfileGroupsRequestValidationError{
+				Field:  "Id",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
 		}
 	}
 
-	return nil
-}
-
-// CreateGroupRequestValidationError is the validation error returned by
-// CreateGroupRequest.Validate if the designated constraints aren't met.
-type CreateGroupRequestValidationError struct {
-	Field  string
-	Reason string
-	Cause  error
-	Key    bool
-}
-
-// GetField function returns Field value.
-func (e CreateGroupRequestValidationError) GetField() string { return e.Field }
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileGroupsRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
 
-// GetReason function returns Reason value.
-func (e CreateGroupRequestValidationError) GetReason() string { return e.Reason }
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileGroupsRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileGroupsRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListProfileGroupsRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListProfileGroupsRequestValidationError is the validation error returned by
+// ListProfileGroupsRequest.Validate if the designated constraints aren't met.
+type ListProfileGroupsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListProfileGroupsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListProfileGroupsRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e CreateGroupRequestValidationError) GetCause() error { return e.Cause }
+func (e ListProfileGroupsRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e CreateGroupRequestValidationError) GetKey() bool { return e.Key }
+func (e ListProfileGroupsRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e CreateGroupRequestValidationError) GetErrorName() string {
-	return "CreateGroupRequestValidationError"
+func (e ListProfileGroupsRequestValidationError) GetErrorName() string {
+	return "ListProfileGroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateGroupRequestValidationError) Error() string {
+func (e ListProfileGroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1138,14 +1390,14 @@ func (e CreateGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateGroupRequest.%s: %s%s",
+		"invalid %sListProfileGroupsRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = CreateGroupRequestValidationError{}
+var _ error = ListProfileGroupsRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1153,22 +1405,34 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = CreateGroupRequestValidationError{}
+} = ListProfileGroupsRequestValidationError{}
 
-// Validate checks the field values on CreateGroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateProfileContactRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateGroupResponse) Validate() error {
+func (m *CreateProfileContactRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface {
+	if v, ok := interface{}(m.GetId()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return CreateGroupResponseValidationError{
-				Field:  "Result",
+			return CreateProfileContactRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProfileContactRequestValidationError{
+				Field:  "Payload",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -1178,9 +1442,10 @@ func (m *CreateGroupResponse) Validate() error {
 	return nil
 }
 
-// CreateGroupResponseValidationError is the validation error returned by
-// CreateGroupResponse.Validate if the designated constraints aren't met.
-type CreateGroupResponseValidationError struct {
+// CreateProfileContactRequestValidationError is the validation error returned
+// by CreateProfileContactRequest.Validate if the designated constraints
+// aren't met.
+type CreateProfileContactRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1188,24 +1453,24 @@ type CreateGroupResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e CreateGroupResponseValidationError) GetField() string { return e.Field }
+func (e CreateProfileContactRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e CreateGroupResponseValidationError) GetReason() string { return e.Reason }
+func (e CreateProfileContactRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e CreateGroupResponseValidationError) GetCause() error { return e.Cause }
+func (e CreateProfileContactRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e CreateGroupResponseValidationError) GetKey() bool { return e.Key }
+func (e CreateProfileContactRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e CreateGroupResponseValidationError) GetErrorName() string {
-	return "CreateGroupResponseValidationError"
+func (e CreateProfileContactRequestValidationError) GetErrorName() string {
+	return "CreateProfileContactRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateGroupResponseValidationError) Error() string {
+func (e CreateProfileContactRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1217,14 +1482,14 @@ func (e CreateGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateGroupResponse.%s: %s%s",
+		"invalid %sCreateProfileContactRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = CreateGroupResponseValidationError{}
+var _ error = CreateProfileContactRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1232,12 +1497,11 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = CreateGroupResponseValidationError{}
+} = CreateProfileContactRequestValidationError{}
 
-// Validate checks the field values on ReadGroupRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ReadGroupRequest) Validate() error {
+// Validate checks the field values on Group with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Group) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -1246,7 +1510,7 @@ func (m *ReadGroupRequest) Validate() error {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ReadGroupRequestValidationError{
+			return GroupValidationError{
 				Field:  "Id",
 				Reason: "embedded message failed validation",
 				Cause:  err,
@@ -1254,12 +1518,73 @@ func (m *ReadGroupRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for Name
+
+	// no validation rules for Notes
+
+	if v, ok := interface{}(m.GetProfileId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return GroupValidationError{
+				Field:  "ProfileId",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetContacts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return GroupValidationError{
+					Field:  fmt.Sprintf("Contacts[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Etag
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return GroupValidationError{
+				Field:  "CreatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return GroupValidationError{
+				Field:  "UpdatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MemberFilter
+
 	return nil
 }
 
-// ReadGroupRequestValidationError is the validation error returned by
-// ReadGroupRequest.Validate if the designated constraints aren't met.
-type ReadGroupRequestValidationError struct {
+// GroupValidationError is the validation error returned by Group.Validate if
+// the designated constraints aren't met.
+type GroupValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1267,24 +1592,22 @@ type ReadGroupRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ReadGroupRequestValidationError) GetField() string { return e.Field }
+func (e GroupValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ReadGroupRequestValidationError) GetReason() string { return e.Reason }
+func (e GroupValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ReadGroupRequestValidationError) GetCause() error { return e.Cause }
+func (e GroupValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ReadGroupRequestValidationError) GetKey() bool { return e.Key }
+func (e GroupValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ReadGroupRequestValidationError) GetErrorName() string {
-	return "ReadGroupRequestValidationError"
-}
+func (e GroupValidationError) GetErrorName() string { return "GroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReadGroupRequestValidationError) Error() string {
+func (e GroupValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1296,14 +1619,14 @@ func (e ReadGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadGroupRequest.%s: %s%s",
+		"invalid %sGroup.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ReadGroupRequestValidationError{}
+var _ error = GroupValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1311,22 +1634,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ReadGroupRequestValidationError{}
+} = GroupValidationError{}
 
-// Validate checks the field values on ReadGroupResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ReadGroupResponse) Validate() error {
+// Validate checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateGroupRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface {
+	if v, ok := interface{}(m.GetPayload()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ReadGroupResponseValidationError{
-				Field:  "Result",
+			return CreateGroupRequestValidationError{
+				Field:  "Payload",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -1336,9 +1659,9 @@ func (m *ReadGroupResponse) Validate() error {
 	return nil
 }
 
-// ReadGroupResponseValidationError is the validation error returned by
-// ReadGroupResponse.Validate if the designated constraints aren't met.
-type ReadGroupResponseValidationError struct {
+// CreateGroupRequestValidationError is the validation error returned by
+// CreateGroupRequest.Validate if the designated constraints aren't met.
+type CreateGroupRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1346,24 +1669,24 @@ type ReadGroupResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ReadGroupResponseValidationError) GetField() string { return e.Field }
+func (e CreateGroupRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ReadGroupResponseValidationError) GetReason() string { return e.Reason }
+func (e CreateGroupRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ReadGroupResponseValidationError) GetCause() error { return e.Cause }
+func (e CreateGroupRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ReadGroupResponseValidationError) GetKey() bool { return e.Key }
+func (e CreateGroupRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ReadGroupResponseValidationError) GetErrorName() string {
-	return "ReadGroupResponseValidationError"
+func (e CreateGroupRequestValidationError) GetErrorName() string {
+	return "CreateGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadGroupResponseValidationError) Error() string {
+func (e CreateGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1375,14 +1698,14 @@ func (e ReadGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadGroupResponse.%s: %s%s",
+		"invalid %sCreateGroupRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ReadGroupResponseValidationError{}
+var _ error = CreateGroupRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1390,22 +1713,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ReadGroupResponseValidationError{}
+} = CreateGroupRequestValidationError{}
 
-// Validate checks the field values on UpdateGroupRequest with the rules
+// Validate checks the field values on CreateGroupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateGroupRequest) Validate() error {
+func (m *CreateGroupResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPayload()).(interface {
+	if v, ok := interface{}(m.GetResult()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateGroupRequestValidationError{
-				Field:  "Payload",
+			return CreateGroupResponseValidationError{
+				Field:  "Result",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -1415,9 +1738,9 @@ func (m *UpdateGroupRequest) Validate() error {
 	return nil
 }
 
-// UpdateGroupRequestValidationError is the validation error returned by
-// UpdateGroupRequest.Validate if the designated constraints aren't met.
-type UpdateGroupRequestValidationError struct {
+// CreateGroupResponseValidationError is the validation error returned by
+// CreateGroupResponse.Validate if the designated constraints aren't met.
+type CreateGroupResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1425,24 +1748,24 @@ type UpdateGroupRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e UpdateGroupRequestValidationError) GetField() string { return e.Field }
+func (e CreateGroupResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e UpdateGroupRequestValidationError) GetReason() string { return e.Reason }
+func (e CreateGroupResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e UpdateGroupRequestValidationError) GetCause() error { return e.Cause }
+func (e CreateGroupResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e UpdateGroupRequestValidationError) GetKey() bool { return e.Key }
+func (e CreateGroupResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e UpdateGroupRequestValidationError) GetErrorName() string {
-	return "UpdateGroupRequestValidationError"
+func (e CreateGroupResponseValidationError) GetErrorName() string {
+	return "CreateGroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateGroupRequestValidationError) Error() string {
+func (e CreateGroupResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1454,14 +1777,14 @@ func (e UpdateGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateGroupRequest.%s: %s%s",
+		"invalid %sCreateGroupResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = UpdateGroupRequestValidationError{}
+var _ error = CreateGroupResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1469,22 +1792,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = UpdateGroupRequestValidationError{}
+} = CreateGroupResponseValidationError{}
 
-// Validate checks the field values on UpdateGroupResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpdateGroupResponse) Validate() error {
+// Validate checks the field values on ReadGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadGroupRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface {
+	if v, ok := interface{}(m.GetId()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateGroupResponseValidationError{
-				Field:  "Result",
+			return ReadGroupRequestValidationError{
+				Field:  "Id",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -1494,9 +1817,9 @@ func (m *UpdateGroupResponse) Validate() error {
 	return nil
 }
 
-// UpdateGroupResponseValidationError is the validation error returned by
-// UpdateGroupResponse.Validate if the designated constraints aren't met.
-type UpdateGroupResponseValidationError struct {
+// ReadGroupRequestValidationError is the validation error returned by
+// ReadGroupRequest.Validate if the designated constraints aren't met.
+type ReadGroupRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1504,24 +1827,24 @@ type UpdateGroupResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e UpdateGroupResponseValidationError) GetField() string { return e.Field }
+func (e ReadGroupRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e UpdateGroupResponseValidationError) GetReason() string { return e.Reason }
+func (e ReadGroupRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e UpdateGroupResponseValidationError) GetCause() error { return e.Cause }
+func (e ReadGroupRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e UpdateGroupResponseValidationError) GetKey() bool { return e.Key }
+func (e ReadGroupRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e UpdateGroupResponseValidationError) GetErrorName() string {
-	return "UpdateGroupResponseValidationError"
+func (e ReadGroupRequestValidationError) GetErrorName() string {
+	return "ReadGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateGroupResponseValidationError) Error() string {
+func (e ReadGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1533,14 +1856,14 @@ func (e UpdateGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateGroupResponse.%s: %s%s",
+		"invalid %sReadGroupRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = UpdateGroupResponseValidationError{}
+var _ error = ReadGroupRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1548,22 +1871,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = UpdateGroupResponseValidationError{}
+} = ReadGroupRequestValidationError{}
 
-// Validate checks the field values on DeleteGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DeleteGroupRequest) Validate() error {
+// Validate checks the field values on ReadGroupResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadGroupResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetId()).(interface {
+	if v, ok := interface{}(m.GetResult()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return DeleteGroupRequestValidationError{
-				Field:  "Id",
+			return ReadGroupResponseValidationError{
+				Field:  "Result",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -1573,9 +1896,9 @@ func (m *DeleteGroupRequest) Validate() error {
 	return nil
 }
 
-// DeleteGroupRequestValidationError is the validation error returned by
-// DeleteGroupRequest.Validate if the designated constraints aren't met.
-type DeleteGroupRequestValidationError struct {
+// ReadGroupResponseValidationError is the validation error returned by
+// ReadGroupResponse.Validate if the designated constraints aren't met.
+type ReadGroupResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1583,24 +1906,24 @@ type DeleteGroupRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e DeleteGroupRequestValidationError) GetField() string { return e.Field }
+func (e ReadGroupResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e DeleteGroupRequestValidationError) GetReason() string { return e.Reason }
+func (e ReadGroupResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e DeleteGroupRequestValidationError) GetCause() error { return e.Cause }
+func (e ReadGroupResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e DeleteGroupRequestValidationError) GetKey() bool { return e.Key }
+func (e ReadGroupResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e DeleteGroupRequestValidationError) GetErrorName() string {
-	return "DeleteGroupRequestValidationError"
+func (e ReadGroupResponseValidationError) GetErrorName() string {
+	return "ReadGroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteGroupRequestValidationError) Error() string {
+func (e ReadGroupResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1612,14 +1935,14 @@ func (e DeleteGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteGroupRequest.%s: %s%s",
+		"invalid %sReadGroupResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = DeleteGroupRequestValidationError{}
+var _ error = ReadGroupResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1627,22 +1950,34 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = DeleteGroupRequestValidationError{}
+} = ReadGroupResponseValidationError{}
 
-// Validate checks the field values on DeleteGroupResponse with the rules
+// Validate checks the field values on UpdateGroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteGroupResponse) Validate() error {
+func (m *UpdateGroupRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateGroupRequestValidationError{
+				Field:  "Payload",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
-// DeleteGroupResponseValidationError is the validation error returned by
-// DeleteGroupResponse.Validate if the designated constraints aren't met.
-type DeleteGroupResponseValidationError struct {
+// UpdateGroupRequestValidationError is the validation error returned by
+// UpdateGroupRequest.Validate if the designated constraints aren't met.
+type UpdateGroupRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1650,24 +1985,24 @@ type DeleteGroupResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e DeleteGroupResponseValidationError) GetField() string { return e.Field }
+func (e UpdateGroupRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e DeleteGroupResponseValidationError) GetReason() string { return e.Reason }
+func (e UpdateGroupRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e DeleteGroupResponseValidationError) GetCause() error { return e.Cause }
+func (e UpdateGroupRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e DeleteGroupResponseValidationError) GetKey() bool { return e.Key }
+func (e UpdateGroupRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e DeleteGroupResponseValidationError) GetErrorName() string {
-	return "DeleteGroupResponseValidationError"
+func (e UpdateGroupRequestValidationError) GetErrorName() string {
+	return "UpdateGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteGroupResponseValidationError) Error() string {
+func (e UpdateGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1679,14 +2014,14 @@ func (e DeleteGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteGroupResponse.%s: %s%s",
+		"invalid %sUpdateGroupRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = DeleteGroupResponseValidationError{}
+var _ error = UpdateGroupRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1694,58 +2029,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = DeleteGroupResponseValidationError{}
+} = UpdateGroupRequestValidationError{}
 
-// Validate checks the field values on ListGroupRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListGroupRequest) Validate() error {
+// Validate checks the field values on UpdateGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateGroupResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetFilter()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return ListGroupRequestValidationError{
-				Field:  "Filter",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetOrderBy()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return ListGroupRequestValidationError{
-				Field:  "OrderBy",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetFields()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return ListGroupRequestValidationError{
-				Field:  "Fields",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetPaging()).(interface {
+	if v, ok := interface{}(m.GetResult()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ListGroupRequestValidationError{
-				Field:  "Paging",
+			return UpdateGroupResponseValidationError{
+				Field:  "Result",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -1755,9 +2054,9 @@ func (m *ListGroupRequest) Validate() error {
 	return nil
 }
 
-// ListGroupRequestValidationError is the validation error returned by
-// ListGroupRequest.Validate if the designated constraints aren't met.
-type ListGroupRequestValidationError struct {
+// UpdateGroupResponseValidationError is the validation error returned by
+// UpdateGroupResponse.Validate if the designated constraints aren't met.
+type UpdateGroupResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1765,24 +2064,24 @@ type ListGroupRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ListGroupRequestValidationError) GetField() string { return e.Field }
+func (e UpdateGroupResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ListGroupRequestValidationError) GetReason() string { return e.Reason }
+func (e UpdateGroupResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ListGroupRequestValidationError) GetCause() error { return e.Cause }
+func (e UpdateGroupResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ListGroupRequestValidationError) GetKey() bool { return e.Key }
+func (e UpdateGroupResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ListGroupRequestValidationError) GetErrorName() string {
-	return "ListGroupRequestValidationError"
+func (e UpdateGroupResponseValidationError) GetErrorName() string {
+	return "UpdateGroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListGroupRequestValidationError) Error() string {
+func (e UpdateGroupResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1794,14 +2093,14 @@ func (e ListGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListGroupRequest.%s: %s%s",
+		"invalid %sUpdateGroupResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ListGroupRequestValidationError{}
+var _ error = UpdateGroupResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1809,39 +2108,34 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ListGroupRequestValidationError{}
+} = UpdateGroupResponseValidationError{}
 
-// Validate checks the field values on ListGroupsResponse with the rules
+// Validate checks the field values on DeleteGroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListGroupsResponse) Validate() error {
+func (m *DeleteGroupRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface {
-			Validate() error
-		}); ok {
-			if err := v.Validate(); err != nil {
-				return ListGroupsResponseValidationError{
-					Field:  fmt.Sprintf("Results[%v]", idx),
-					Reason: "embedded message failed validation",
-					Cause:  err,
-				}
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteGroupRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
 			}
 		}
-
 	}
 
 	return nil
 }
 
-// ListGroupsResponseValidationError is the validation error returned by
-// ListGroupsResponse.Validate if the designated constraints aren't met.
-type ListGroupsResponseValidationError struct {
+// DeleteGroupRequestValidationError is the validation error returned by
+// DeleteGroupRequest.Validate if the designated constraints aren't met.
+type DeleteGroupRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -1849,24 +2143,24 @@ type ListGroupsResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ListGroupsResponseValidationError) GetField() string { return e.Field }
+func (e DeleteGroupRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ListGroupsResponseValidationError) GetReason() string { return e.Reason }
+func (e DeleteGroupRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ListGroupsResponseValidationError) GetCause() error { return e.Cause }
+func (e DeleteGroupRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ListGroupsResponseValidationError) GetKey() bool { return e.Key }
+func (e DeleteGroupRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ListGroupsResponseValidationError) GetErrorName() string {
-	return "ListGroupsResponseValidationError"
+func (e DeleteGroupRequestValidationError) GetErrorName() string {
+	return "DeleteGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListGroupsResponseValidationError) Error() string {
+func (e DeleteGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -1878,14 +2172,14 @@ func (e ListGroupsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListGroupsResponse.%s: %s%s",
+		"invalid %sDeleteGroupRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ListGroupsResponseValidationError{}
+var _ error = DeleteGroupRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -1893,119 +2187,6253 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ListGroupsResponseValidationError{}
+} = DeleteGroupRequestValidationError{}
 
-// Validate checks the field values on Contact with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Contact) Validate() error {
+// Validate checks the field values on DeleteGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteGroupResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetId()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return ContactValidationError{
-				Field:  "Id",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for FirstName
+	return nil
+}
+
+// DeleteGroupResponseValidationError is the validation error returned by
+// DeleteGroupResponse.Validate if the designated constraints aren't met.
+type DeleteGroupResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e DeleteGroupResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e DeleteGroupResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e DeleteGroupResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e DeleteGroupResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e DeleteGroupResponseValidationError) GetErrorName() string {
+	return "DeleteGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGroupResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = DeleteGroupResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = DeleteGroupResponseValidationError{}
+
+// Validate checks the field values on UndeleteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UndeleteGroupRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteGroupRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeleteGroupRequestValidationError is the validation error returned by
+// UndeleteGroupRequest.Validate if the designated constraints aren't met.
+type UndeleteGroupRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UndeleteGroupRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UndeleteGroupRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UndeleteGroupRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UndeleteGroupRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UndeleteGroupRequestValidationError) GetErrorName() string {
+	return "UndeleteGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteGroupRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UndeleteGroupRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UndeleteGroupRequestValidationError{}
+
+// Validate checks the field values on UndeleteGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UndeleteGroupResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteGroupResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeleteGroupResponseValidationError is the validation error returned by
+// UndeleteGroupResponse.Validate if the designated constraints aren't met.
+type UndeleteGroupResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UndeleteGroupResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UndeleteGroupResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UndeleteGroupResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UndeleteGroupResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UndeleteGroupResponseValidationError) GetErrorName() string {
+	return "UndeleteGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteGroupResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UndeleteGroupResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UndeleteGroupResponseValidationError{}
+
+// Validate checks the field values on ListGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListGroupRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShowDeleted
+
+	return nil
+}
+
+// ListGroupRequestValidationError is the validation error returned by
+// ListGroupRequest.Validate if the designated constraints aren't met.
+type ListGroupRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListGroupRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListGroupRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListGroupRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListGroupRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListGroupRequestValidationError) GetErrorName() string {
+	return "ListGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListGroupRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListGroupRequestValidationError{}
+
+// Validate checks the field values on ListGroupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListGroupsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListGroupsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListGroupsResponseValidationError is the validation error returned by
+// ListGroupsResponse.Validate if the designated constraints aren't met.
+type ListGroupsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListGroupsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListGroupsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListGroupsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListGroupsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListGroupsResponseValidationError) GetErrorName() string {
+	return "ListGroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListGroupsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListGroupsResponseValidationError{}
+
+// Validate checks the field values on AddGroupContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AddGroupContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return AddGroupContactsRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetContactIds()); l < 1 || l > 1000 {
+		return AddGroupContactsRequestValidationError{
+			Field:  "ContactIds",
+			Reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetContactIds() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return AddGroupContactsRequestValidationError{
+					Field:  fmt.Sprintf("ContactIds[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AddGroupContactsRequestValidationError is the validation error returned by
+// AddGroupContactsRequest.Validate if the designated constraints aren't met.
+type AddGroupContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e AddGroupContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e AddGroupContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e AddGroupContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e AddGroupContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e AddGroupContactsRequestValidationError) GetErrorName() string {
+	return "AddGroupContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddGroupContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddGroupContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = AddGroupContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = AddGroupContactsRequestValidationError{}
+
+// Validate checks the field values on AddGroupContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AddGroupContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// AddGroupContactsResponseValidationError is the validation error returned by
+// AddGroupContactsResponse.Validate if the designated constraints aren't met.
+type AddGroupContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e AddGroupContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e AddGroupContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e AddGroupContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e AddGroupContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e AddGroupContactsResponseValidationError) GetErrorName() string {
+	return "AddGroupContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddGroupContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddGroupContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = AddGroupContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = AddGroupContactsResponseValidationError{}
+
+// Validate checks the field values on RemoveGroupContactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveGroupContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveGroupContactsRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetContactIds()); l < 1 || l > 1000 {
+		return RemoveGroupContactsRequestValidationError{
+			Field:  "ContactIds",
+			Reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetContactIds() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return RemoveGroupContactsRequestValidationError{
+					Field:  fmt.Sprintf("ContactIds[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RemoveGroupContactsRequestValidationError is the validation error returned
+// by RemoveGroupContactsRequest.Validate if the designated constraints aren't met.
+type RemoveGroupContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e RemoveGroupContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e RemoveGroupContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e RemoveGroupContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e RemoveGroupContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e RemoveGroupContactsRequestValidationError) GetErrorName() string {
+	return "RemoveGroupContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGroupContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGroupContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = RemoveGroupContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = RemoveGroupContactsRequestValidationError{}
+
+// Validate checks the field values on RemoveGroupContactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveGroupContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RemoveGroupContactsResponseValidationError is the validation error returned
+// by RemoveGroupContactsResponse.Validate if the designated constraints
+// aren't met.
+type RemoveGroupContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e RemoveGroupContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e RemoveGroupContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e RemoveGroupContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e RemoveGroupContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e RemoveGroupContactsResponseValidationError) GetErrorName() string {
+	return "RemoveGroupContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveGroupContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveGroupContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = RemoveGroupContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = RemoveGroupContactsResponseValidationError{}
+
+// Validate checks the field values on ListGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListGroupMembersRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupMembersRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupMembersRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupMembersRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupMembersRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListGroupMembersRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListGroupMembersRequestValidationError is the validation error returned by
+// ListGroupMembersRequest.Validate if the designated constraints aren't met.
+type ListGroupMembersRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListGroupMembersRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListGroupMembersRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListGroupMembersRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListGroupMembersRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListGroupMembersRequestValidationError) GetErrorName() string {
+	return "ListGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupMembersRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListGroupMembersRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListGroupMembersRequestValidationError{}
+
+// Validate checks the field values on ListGroupMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListGroupMembersResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListGroupMembersResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListGroupMembersResponseValidationError is the validation error returned by
+// ListGroupMembersResponse.Validate if the designated constraints aren't met.
+type ListGroupMembersResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListGroupMembersResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListGroupMembersResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListGroupMembersResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListGroupMembersResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListGroupMembersResponseValidationError) GetErrorName() string {
+	return "ListGroupMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupMembersResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListGroupMembersResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListGroupMembersResponseValidationError{}
+
+// Validate checks the field values on Contact with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Contact) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FirstName
 
 	// no validation rules for MiddleName
 
-	// no validation rules for LastName
+	// no validation rules for LastName
+
+	if err := m._validateEmail(m.GetPrimaryEmail()); err != nil {
+		return ContactValidationError{
+			Field:  "PrimaryEmail",
+			Reason: "value must be a valid email address",
+			Cause:  err,
+		}
+	}
+
+	// no validation rules for Notes
+
+	for idx, item := range m.GetEmails() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ContactValidationError{
+					Field:  fmt.Sprintf("Emails[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetHomeAddress()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "HomeAddress",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetWorkAddress()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "WorkAddress",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetProfileId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "ProfileId",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ContactValidationError{
+					Field:  fmt.Sprintf("Groups[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetNicknames()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "Nicknames",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPhoneNumbers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ContactValidationError{
+					Field:  fmt.Sprintf("PhoneNumbers[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if !_Contact_PrimaryPhone_Pattern.MatchString(m.GetPrimaryPhone()) {
+		return ContactValidationError{
+			Field:  "PrimaryPhone",
+			Reason: "value does not match regex pattern \"^(\\\\+[1-9][0-9]{1,14})?$\"",
+		}
+	}
+
+	// no validation rules for Etag
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "CreatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactValidationError{
+				Field:  "UpdatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CarddavName
+
+	return nil
+}
+
+func (m *Contact) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Contact) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// ContactValidationError is the validation error returned by Contact.Validate
+// if the designated constraints aren't met.
+type ContactValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ContactValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ContactValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ContactValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ContactValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ContactValidationError) GetErrorName() string { return "ContactValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContactValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContact.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ContactValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ContactValidationError{}
+
+var _Contact_PrimaryPhone_Pattern = regexp.MustCompile("^(\\+[1-9][0-9]{1,14})?$")
+
+// Validate checks the field values on Email with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Email) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if err := m._validateEmail(m.GetAddress()); err != nil {
+		return EmailValidationError{
+			Field:  "Address",
+			Reason: "value must be a valid email address",
+			Cause:  err,
+		}
+	}
+
+	return nil
+}
+
+func (m *Email) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Email) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// EmailValidationError is the validation error returned by Email.Validate if
+// the designated constraints aren't met.
+type EmailValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e EmailValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e EmailValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e EmailValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e EmailValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e EmailValidationError) GetErrorName() string { return "EmailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EmailValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmail.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = EmailValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = EmailValidationError{}
+
+// Validate checks the field values on PhoneNumber with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *PhoneNumber) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if !_PhoneNumber_Number_Pattern.MatchString(m.GetNumber()) {
+		return PhoneNumberValidationError{
+			Field:  "Number",
+			Reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{1,14}$\"",
+		}
+	}
+
+	// no validation rules for Type
+
+	return nil
+}
+
+// PhoneNumberValidationError is the validation error returned by
+// PhoneNumber.Validate if the designated constraints aren't met.
+type PhoneNumberValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e PhoneNumberValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e PhoneNumberValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e PhoneNumberValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e PhoneNumberValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e PhoneNumberValidationError) GetErrorName() string { return "PhoneNumberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PhoneNumberValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPhoneNumber.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = PhoneNumberValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = PhoneNumberValidationError{}
+
+var _PhoneNumber_Number_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{1,14}$")
+
+// Validate checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Address) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Address
+
+	// no validation rules for City
+
+	// no validation rules for State
+
+	// no validation rules for Zip
+
+	// no validation rules for Country
+
+	return nil
+}
+
+// AddressValidationError is the validation error returned by Address.Validate
+// if the designated constraints aren't met.
+type AddressValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e AddressValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e AddressValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e AddressValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e AddressValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e AddressValidationError) GetErrorName() string { return "AddressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddress.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = AddressValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = AddressValidationError{}
+
+// Validate checks the field values on CreateContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateContactRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContactRequestValidationError{
+				Field:  "Payload",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateContactRequestValidationError is the validation error returned by
+// CreateContactRequest.Validate if the designated constraints aren't met.
+type CreateContactRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e CreateContactRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e CreateContactRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e CreateContactRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e CreateContactRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e CreateContactRequestValidationError) GetErrorName() string {
+	return "CreateContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateContactRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContactRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = CreateContactRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = CreateContactRequestValidationError{}
+
+// Validate checks the field values on CreateContactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateContactResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return CreateContactResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateContactResponseValidationError is the validation error returned by
+// CreateContactResponse.Validate if the designated constraints aren't met.
+type CreateContactResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e CreateContactResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e CreateContactResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e CreateContactResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e CreateContactResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e CreateContactResponseValidationError) GetErrorName() string {
+	return "CreateContactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateContactResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateContactResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = CreateContactResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = CreateContactResponseValidationError{}
+
+// Validate checks the field values on ReadContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadContactRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReadContactRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadContactRequestValidationError is the validation error returned by
+// ReadContactRequest.Validate if the designated constraints aren't met.
+type ReadContactRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ReadContactRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ReadContactRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ReadContactRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ReadContactRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ReadContactRequestValidationError) GetErrorName() string {
+	return "ReadContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadContactRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadContactRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ReadContactRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ReadContactRequestValidationError{}
+
+// Validate checks the field values on ReadContactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadContactResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReadContactResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadContactResponseValidationError is the validation error returned by
+// ReadContactResponse.Validate if the designated constraints aren't met.
+type ReadContactResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ReadContactResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ReadContactResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ReadContactResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ReadContactResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ReadContactResponseValidationError) GetErrorName() string {
+	return "ReadContactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadContactResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadContactResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ReadContactResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ReadContactResponseValidationError{}
+
+// Validate checks the field values on UpdateContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateContactRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContactRequestValidationError{
+				Field:  "Payload",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContactRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateContactRequestValidationError is the validation error returned by
+// UpdateContactRequest.Validate if the designated constraints aren't met.
+type UpdateContactRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UpdateContactRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UpdateContactRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UpdateContactRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UpdateContactRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UpdateContactRequestValidationError) GetErrorName() string {
+	return "UpdateContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateContactRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContactRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UpdateContactRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UpdateContactRequestValidationError{}
+
+// Validate checks the field values on UpdateContactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateContactResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateContactResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateContactResponseValidationError is the validation error returned by
+// UpdateContactResponse.Validate if the designated constraints aren't met.
+type UpdateContactResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UpdateContactResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UpdateContactResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UpdateContactResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UpdateContactResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UpdateContactResponseValidationError) GetErrorName() string {
+	return "UpdateContactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateContactResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContactResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UpdateContactResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UpdateContactResponseValidationError{}
+
+// Validate checks the field values on DeleteContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteContactRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteContactRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteContactRequestValidationError is the validation error returned by
+// DeleteContactRequest.Validate if the designated constraints aren't met.
+type DeleteContactRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e DeleteContactRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e DeleteContactRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e DeleteContactRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e DeleteContactRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e DeleteContactRequestValidationError) GetErrorName() string {
+	return "DeleteContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteContactRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContactRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = DeleteContactRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = DeleteContactRequestValidationError{}
+
+// Validate checks the field values on DeleteContactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteContactResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteContactResponseValidationError is the validation error returned by
+// DeleteContactResponse.Validate if the designated constraints aren't met.
+type DeleteContactResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e DeleteContactResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e DeleteContactResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e DeleteContactResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e DeleteContactResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e DeleteContactResponseValidationError) GetErrorName() string {
+	return "DeleteContactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteContactResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContactResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = DeleteContactResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = DeleteContactResponseValidationError{}
+
+// Validate checks the field values on UndeleteContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UndeleteContactRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteContactRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeleteContactRequestValidationError is the validation error returned by
+// UndeleteContactRequest.Validate if the designated constraints aren't met.
+type UndeleteContactRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UndeleteContactRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UndeleteContactRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UndeleteContactRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UndeleteContactRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UndeleteContactRequestValidationError) GetErrorName() string {
+	return "UndeleteContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteContactRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteContactRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UndeleteContactRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UndeleteContactRequestValidationError{}
+
+// Validate checks the field values on UndeleteContactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UndeleteContactResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UndeleteContactResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UndeleteContactResponseValidationError is the validation error returned by
+// UndeleteContactResponse.Validate if the designated constraints aren't met.
+type UndeleteContactResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UndeleteContactResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UndeleteContactResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UndeleteContactResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UndeleteContactResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UndeleteContactResponseValidationError) GetErrorName() string {
+	return "UndeleteContactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteContactResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteContactResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UndeleteContactResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UndeleteContactResponseValidationError{}
+
+// Validate checks the field values on ListContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListContactsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListContactsResponseValidationError is the validation error returned by
+// ListContactsResponse.Validate if the designated constraints aren't met.
+type ListContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListContactsResponseValidationError) GetErrorName() string {
+	return "ListContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListContactsResponseValidationError{}
+
+// Validate checks the field values on SMSRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *SMSRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetId() <= 0 {
+		return SMSRequestValidationError{
+			Field:  "Id",
+			Reason: "value must be greater than 0",
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetMessage()); l < 1 || l > 1600 {
+		return SMSRequestValidationError{
+			Field:  "Message",
+			Reason: "value length must be between 1 and 1600 runes, inclusive",
+		}
+	}
+
+	return nil
+}
+
+// SMSRequestValidationError is the validation error returned by
+// SMSRequest.Validate if the designated constraints aren't met.
+type SMSRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SMSRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SMSRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SMSRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SMSRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SMSRequestValidationError) GetErrorName() string { return "SMSRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SMSRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSMSRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SMSRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SMSRequestValidationError{}
+
+// Validate checks the field values on SMSResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SMSResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for DeliveryId
+
+	// no validation rules for Status
+
+	return nil
+}
+
+// SMSResponseValidationError is the validation error returned by
+// SMSResponse.Validate if the designated constraints aren't met.
+type SMSResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SMSResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SMSResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SMSResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SMSResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SMSResponseValidationError) GetErrorName() string { return "SMSResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SMSResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSMSResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SMSResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SMSResponseValidationError{}
+
+// Validate checks the field values on SyncContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SyncContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SyncToken
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		return SyncContactsRequestValidationError{
+			Field:  "Limit",
+			Reason: "value must be inside range [0, 1000]",
+		}
+	}
+
+	// no validation rules for Current
+
+	return nil
+}
+
+// SyncContactsRequestValidationError is the validation error returned by
+// SyncContactsRequest.Validate if the designated constraints aren't met.
+type SyncContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SyncContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SyncContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SyncContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SyncContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SyncContactsRequestValidationError) GetErrorName() string {
+	return "SyncContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SyncContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SyncContactsRequestValidationError{}
+
+// Validate checks the field values on SyncContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SyncContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return SyncContactsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDeleted() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return SyncContactsResponseValidationError{
+					Field:  fmt.Sprintf("Deleted[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SyncToken
+
+	// no validation rules for HasMore
+
+	return nil
+}
+
+// SyncContactsResponseValidationError is the validation error returned by
+// SyncContactsResponse.Validate if the designated constraints aren't met.
+type SyncContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SyncContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SyncContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SyncContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SyncContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SyncContactsResponseValidationError) GetErrorName() string {
+	return "SyncContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SyncContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SyncContactsResponseValidationError{}
+
+// Validate checks the field values on WatchContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WatchContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetLastEventId() < 0 {
+		return WatchContactsRequestValidationError{
+			Field:  "LastEventId",
+			Reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	return nil
+}
+
+// WatchContactsRequestValidationError is the validation error returned by
+// WatchContactsRequest.Validate if the designated constraints aren't met.
+type WatchContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e WatchContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e WatchContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e WatchContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e WatchContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e WatchContactsRequestValidationError) GetErrorName() string {
+	return "WatchContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = WatchContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = WatchContactsRequestValidationError{}
+
+// Validate checks the field values on ContactEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ContactEvent) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	if v, ok := interface{}(m.GetContact()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactEventValidationError{
+				Field:  "Contact",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactEventValidationError{
+				Field:  "CreatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ContactEventValidationError is the validation error returned by
+// ContactEvent.Validate if the designated constraints aren't met.
+type ContactEventValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ContactEventValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ContactEventValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ContactEventValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ContactEventValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ContactEventValidationError) GetErrorName() string { return "ContactEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContactEventValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContactEvent.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ContactEventValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ContactEventValidationError{}
+
+// Validate checks the field values on SearchContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetQ()); l < 1 || l > 1024 {
+		return SearchContactsRequestValidationError{
+			Field:  "Q",
+			Reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return SearchContactsRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SearchContactsRequestValidationError is the validation error returned by
+// SearchContactsRequest.Validate if the designated constraints aren't met.
+type SearchContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SearchContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SearchContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SearchContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SearchContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SearchContactsRequestValidationError) GetErrorName() string {
+	return "SearchContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SearchContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SearchContactsRequestValidationError{}
+
+// Validate checks the field values on ContactSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ContactSearchResult) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetContact()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactSearchResultValidationError{
+				Field:  "Contact",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for Snippet
+
+	return nil
+}
+
+// ContactSearchResultValidationError is the validation error returned by
+// ContactSearchResult.Validate if the designated constraints aren't met.
+type ContactSearchResultValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ContactSearchResultValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ContactSearchResultValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ContactSearchResultValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ContactSearchResultValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ContactSearchResultValidationError) GetErrorName() string {
+	return "ContactSearchResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContactSearchResultValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContactSearchResult.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ContactSearchResultValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ContactSearchResultValidationError{}
+
+// Validate checks the field values on SearchContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return SearchContactsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SearchContactsResponseValidationError is the validation error returned by
+// SearchContactsResponse.Validate if the designated constraints aren't met.
+type SearchContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SearchContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SearchContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SearchContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SearchContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SearchContactsResponseValidationError) GetErrorName() string {
+	return "SearchContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SearchContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SearchContactsResponseValidationError{}
+
+// Validate checks the field values on SuggestContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SuggestContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetQ()); l < 1 || l > 255 {
+		return SuggestContactsRequestValidationError{
+			Field:  "Q",
+			Reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		return SuggestContactsRequestValidationError{
+			Field:  "Limit",
+			Reason: "value must be inside range [0, 100]",
+		}
+	}
+
+	return nil
+}
+
+// SuggestContactsRequestValidationError is the validation error returned by
+// SuggestContactsRequest.Validate if the designated constraints aren't met.
+type SuggestContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SuggestContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SuggestContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SuggestContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SuggestContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SuggestContactsRequestValidationError) GetErrorName() string {
+	return "SuggestContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SuggestContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SuggestContactsRequestValidationError{}
+
+// Validate checks the field values on ContactSuggestion with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ContactSuggestion) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetContact()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ContactSuggestionValidationError{
+				Field:  "Contact",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Similarity
+
+	return nil
+}
+
+// ContactSuggestionValidationError is the validation error returned by
+// ContactSuggestion.Validate if the designated constraints aren't met.
+type ContactSuggestionValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ContactSuggestionValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ContactSuggestionValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ContactSuggestionValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ContactSuggestionValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ContactSuggestionValidationError) GetErrorName() string {
+	return "ContactSuggestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContactSuggestionValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContactSuggestion.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ContactSuggestionValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ContactSuggestionValidationError{}
+
+// Validate checks the field values on SuggestContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SuggestContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestContactsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SuggestContactsResponseValidationError is the validation error returned by
+// SuggestContactsResponse.Validate if the designated constraints aren't met.
+type SuggestContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e SuggestContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e SuggestContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e SuggestContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e SuggestContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e SuggestContactsResponseValidationError) GetErrorName() string {
+	return "SuggestContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = SuggestContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = SuggestContactsResponseValidationError{}
+
+// Validate checks the field values on FindDuplicatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindDuplicatesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetMinConfidence(); val < 0 || val > 1 {
+		return FindDuplicatesRequestValidationError{
+			Field:  "MinConfidence",
+			Reason: "value must be inside range [0, 1]",
+		}
+	}
+
+	return nil
+}
+
+// FindDuplicatesRequestValidationError is the validation error returned by
+// FindDuplicatesRequest.Validate if the designated constraints aren't met.
+type FindDuplicatesRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e FindDuplicatesRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e FindDuplicatesRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e FindDuplicatesRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e FindDuplicatesRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e FindDuplicatesRequestValidationError) GetErrorName() string {
+	return "FindDuplicatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindDuplicatesRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindDuplicatesRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = FindDuplicatesRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = FindDuplicatesRequestValidationError{}
+
+// Validate checks the field values on DuplicateGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DuplicateGroup) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetContacts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return DuplicateGroupValidationError{
+					Field:  fmt.Sprintf("Contacts[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Confidence
+
+	return nil
+}
+
+// DuplicateGroupValidationError is the validation error returned by
+// DuplicateGroup.Validate if the designated constraints aren't met.
+type DuplicateGroupValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e DuplicateGroupValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e DuplicateGroupValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e DuplicateGroupValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e DuplicateGroupValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e DuplicateGroupValidationError) GetErrorName() string { return "DuplicateGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DuplicateGroupValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuplicateGroup.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = DuplicateGroupValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = DuplicateGroupValidationError{}
+
+// Validate checks the field values on FindDuplicatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindDuplicatesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return FindDuplicatesResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FindDuplicatesResponseValidationError is the validation error returned by
+// FindDuplicatesResponse.Validate if the designated constraints aren't met.
+type FindDuplicatesResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e FindDuplicatesResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e FindDuplicatesResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e FindDuplicatesResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e FindDuplicatesResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e FindDuplicatesResponseValidationError) GetErrorName() string {
+	return "FindDuplicatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindDuplicatesResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindDuplicatesResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = FindDuplicatesResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = FindDuplicatesResponseValidationError{}
+
+// Validate checks the field values on MergeContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MergeContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return MergeContactsRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetMergedIds()); l < 1 || l > 100 {
+		return MergeContactsRequestValidationError{
+			Field:  "MergedIds",
+			Reason: "value must contain between 1 and 100 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetMergedIds() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return MergeContactsRequestValidationError{
+					Field:  fmt.Sprintf("MergedIds[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PrimaryEmail
+
+	// no validation rules for Etag
+
+	return nil
+}
+
+// MergeContactsRequestValidationError is the validation error returned by
+// MergeContactsRequest.Validate if the designated constraints aren't met.
+type MergeContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e MergeContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e MergeContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e MergeContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e MergeContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e MergeContactsRequestValidationError) GetErrorName() string {
+	return "MergeContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = MergeContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = MergeContactsRequestValidationError{}
+
+// Validate checks the field values on MergeContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MergeContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return MergeContactsResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// MergeContactsResponseValidationError is the validation error returned by
+// MergeContactsResponse.Validate if the designated constraints aren't met.
+type MergeContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e MergeContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e MergeContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e MergeContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e MergeContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e MergeContactsResponseValidationError) GetErrorName() string {
+	return "MergeContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = MergeContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = MergeContactsResponseValidationError{}
+
+// Validate checks the field values on ListContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListContactRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListContactRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListContactRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListContactRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListContactRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShowDeleted
+
+	return nil
+}
+
+// ListContactRequestValidationError is the validation error returned by
+// ListContactRequest.Validate if the designated constraints aren't met.
+type ListContactRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListContactRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListContactRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListContactRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListContactRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListContactRequestValidationError) GetErrorName() string {
+	return "ListContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContactRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContactRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListContactRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListContactRequestValidationError{}
+
+// Validate checks the field values on BatchCreateContactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchCreateContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetPayload()); l < 1 || l > 1000 {
+		return BatchCreateContactsRequestValidationError{
+			Field:  "Payload",
+			Reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetPayload() {
+		_, _ = idx, item
+
+		// skipping validation for payload
+
+	}
+
+	// no validation rules for Mode
+
+	return nil
+}
+
+// BatchCreateContactsRequestValidationError is the validation error returned
+// by BatchCreateContactsRequest.Validate if the designated constraints aren't met.
+type BatchCreateContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e BatchCreateContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e BatchCreateContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e BatchCreateContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e BatchCreateContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e BatchCreateContactsRequestValidationError) GetErrorName() string {
+	return "BatchCreateContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = BatchCreateContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = BatchCreateContactsRequestValidationError{}
+
+// Validate checks the field values on BatchCreateContactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchCreateContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateContactsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateContactsResponseValidationError{
+					Field:  fmt.Sprintf("Errors[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// BatchCreateContactsResponseValidationError is the validation error returned
+// by BatchCreateContactsResponse.Validate if the designated constraints
+// aren't met.
+type BatchCreateContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e BatchCreateContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e BatchCreateContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e BatchCreateContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e BatchCreateContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e BatchCreateContactsResponseValidationError) GetErrorName() string {
+	return "BatchCreateContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = BatchCreateContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = BatchCreateContactsResponseValidationError{}
+
+// Validate checks the field values on BatchUpdateContactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchUpdateContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetPayload()); l < 1 || l > 1000 {
+		return BatchUpdateContactsRequestValidationError{
+			Field:  "Payload",
+			Reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetPayload() {
+		_, _ = idx, item
+
+		// skipping validation for payload
+
+	}
+
+	// no validation rules for Mode
+
+	return nil
+}
+
+// BatchUpdateContactsRequestValidationError is the validation error returned
+// by BatchUpdateContactsRequest.Validate if the designated constraints aren't met.
+type BatchUpdateContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e BatchUpdateContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e BatchUpdateContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e BatchUpdateContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e BatchUpdateContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e BatchUpdateContactsRequestValidationError) GetErrorName() string {
+	return "BatchUpdateContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = BatchUpdateContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = BatchUpdateContactsRequestValidationError{}
+
+// Validate checks the field values on BatchUpdateContactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchUpdateContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateContactsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateContactsResponseValidationError{
+					Field:  fmt.Sprintf("Errors[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// BatchUpdateContactsResponseValidationError is the validation error returned
+// by BatchUpdateContactsResponse.Validate if the designated constraints
+// aren't met.
+type BatchUpdateContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e BatchUpdateContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e BatchUpdateContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e BatchUpdateContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e BatchUpdateContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e BatchUpdateContactsResponseValidationError) GetErrorName() string {
+	return "BatchUpdateContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = BatchUpdateContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = BatchUpdateContactsResponseValidationError{}
+
+// Validate checks the field values on BatchDeleteContactsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchDeleteContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetIds()); l < 1 || l > 1000 {
+		return BatchDeleteContactsRequestValidationError{
+			Field:  "Ids",
+			Reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteContactsRequestValidationError{
+					Field:  fmt.Sprintf("Ids[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Mode
+
+	return nil
+}
+
+// BatchDeleteContactsRequestValidationError is the validation error returned
+// by BatchDeleteContactsRequest.Validate if the designated constraints aren't met.
+type BatchDeleteContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e BatchDeleteContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e BatchDeleteContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e BatchDeleteContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e BatchDeleteContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e BatchDeleteContactsRequestValidationError) GetErrorName() string {
+	return "BatchDeleteContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = BatchDeleteContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = BatchDeleteContactsRequestValidationError{}
+
+// Validate checks the field values on BatchDeleteContactsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *BatchDeleteContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteContactsResponseValidationError{
+					Field:  fmt.Sprintf("Errors[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// BatchDeleteContactsResponseValidationError is the validation error returned
+// by BatchDeleteContactsResponse.Validate if the designated constraints
+// aren't met.
+type BatchDeleteContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e BatchDeleteContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e BatchDeleteContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e BatchDeleteContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e BatchDeleteContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e BatchDeleteContactsResponseValidationError) GetErrorName() string {
+	return "BatchDeleteContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = BatchDeleteContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = BatchDeleteContactsResponseValidationError{}
+
+// Validate checks the field values on ExportContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ExportContactsRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ExportContactsRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ExportContactsRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Format
+
+	// no validation rules for VcardVersion
+
+	return nil
+}
+
+// ExportContactsRequestValidationError is the validation error returned by
+// ExportContactsRequest.Validate if the designated constraints aren't met.
+type ExportContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ExportContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ExportContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ExportContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ExportContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ExportContactsRequestValidationError) GetErrorName() string {
+	return "ExportContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ExportContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ExportContactsRequestValidationError{}
+
+// Validate checks the field values on ReadVCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReadVCardRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReadVCardRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	return nil
+}
+
+// ReadVCardRequestValidationError is the validation error returned by
+// ReadVCardRequest.Validate if the designated constraints aren't met.
+type ReadVCardRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ReadVCardRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ReadVCardRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ReadVCardRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ReadVCardRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ReadVCardRequestValidationError) GetErrorName() string {
+	return "ReadVCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadVCardRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadVCardRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ReadVCardRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ReadVCardRequestValidationError{}
+
+// Validate checks the field values on FileChunk with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *FileChunk) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ContentType
+
+	// no validation rules for Data
+
+	return nil
+}
+
+// FileChunkValidationError is the validation error returned by
+// FileChunk.Validate if the designated constraints aren't met.
+type FileChunkValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e FileChunkValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e FileChunkValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e FileChunkValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e FileChunkValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e FileChunkValidationError) GetErrorName() string { return "FileChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileChunkValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileChunk.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = FileChunkValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = FileChunkValidationError{}
+
+// Validate checks the field values on ImportOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ImportOptions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Mapping
+
+	// no validation rules for Format
+
+	return nil
+}
+
+// ImportOptionsValidationError is the validation error returned by
+// ImportOptions.Validate if the designated constraints aren't met.
+type ImportOptionsValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ImportOptionsValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ImportOptionsValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ImportOptionsValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ImportOptionsValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ImportOptionsValidationError) GetErrorName() string { return "ImportOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportOptionsValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOptions.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ImportOptionsValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ImportOptionsValidationError{}
+
+// Validate checks the field values on ImportContactsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportContactsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetOptions()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ImportContactsRequestValidationError{
+				Field:  "Options",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Data
+
+	return nil
+}
+
+// ImportContactsRequestValidationError is the validation error returned by
+// ImportContactsRequest.Validate if the designated constraints aren't met.
+type ImportContactsRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ImportContactsRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ImportContactsRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ImportContactsRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ImportContactsRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ImportContactsRequestValidationError) GetErrorName() string {
+	return "ImportContactsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportContactsRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportContactsRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ImportContactsRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ImportContactsRequestValidationError{}
+
+// Validate checks the field values on ImportContactsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportContactsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Created
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ImportContactsResponseValidationError{
+					Field:  fmt.Sprintf("Errors[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportContactsResponseValidationError is the validation error returned by
+// ImportContactsResponse.Validate if the designated constraints aren't met.
+type ImportContactsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ImportContactsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ImportContactsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ImportContactsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ImportContactsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ImportContactsResponseValidationError) GetErrorName() string {
+	return "ImportContactsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportContactsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportContactsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ImportContactsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ImportContactsResponseValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *AuditEvent) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Subject
+
+	// no validation rules for RequestId
+
+	// no validation rules for Method
+
+	// no validation rules for ResourceId
+
+	if v, ok := interface{}(m.GetDiff()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				Field:  "Diff",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				Field:  "CreatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e AuditEventValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e AuditEventValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e AuditEventValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e AuditEventValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e AuditEventValidationError) GetErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on ListAuditEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAuditEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListAuditEventRequestValidationError is the validation error returned by
+// ListAuditEventRequest.Validate if the designated constraints aren't met.
+type ListAuditEventRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListAuditEventRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListAuditEventRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListAuditEventRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListAuditEventRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListAuditEventRequestValidationError) GetErrorName() string {
+	return "ListAuditEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListAuditEventRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListAuditEventRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAuditEventsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ListAuditEventsResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ListAuditEventsResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ListAuditEventsResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ListAuditEventsResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ListAuditEventsResponseValidationError) GetErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on WebhookSubscription with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *WebhookSubscription) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookSubscriptionValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		return WebhookSubscriptionValidationError{
+			Field:  "Url",
+			Reason: "value must be a valid URI",
+			Cause:  err,
+		}
+	} else if !uri.IsAbs() {
+		return WebhookSubscriptionValidationError{
+			Field:  "Url",
+			Reason: "value must be absolute",
+		}
+	}
+
+	_WebhookSubscription_EventTypes_Unique := make(map[string]struct{}, len(m.GetEventTypes()))
+
+	for idx, item := range m.GetEventTypes() {
+		_, _ = idx, item
+
+		if _, exists := _WebhookSubscription_EventTypes_Unique[item]; exists {
+			return WebhookSubscriptionValidationError{
+				Field:  fmt.Sprintf("EventTypes[%v]", idx),
+				Reason: "repeated value must contain unique items",
+			}
+		} else {
+			_WebhookSubscription_EventTypes_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _WebhookSubscription_EventTypes_InLookup[item]; !ok {
+			return WebhookSubscriptionValidationError{
+				Field:  fmt.Sprintf("EventTypes[%v]", idx),
+				Reason: "value must be in list [profile.created profile.updated profile.deleted profile.undeleted group.created group.updated group.deleted group.undeleted contact.created contact.updated contact.deleted contact.undeleted]",
+			}
+		}
+
+	}
+
+	// no validation rules for Secret
+
+	// no validation rules for Disabled
+
+	return nil
+}
+
+// WebhookSubscriptionValidationError is the validation error returned by
+// WebhookSubscription.Validate if the designated constraints aren't met.
+type WebhookSubscriptionValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e WebhookSubscriptionValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e WebhookSubscriptionValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e WebhookSubscriptionValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e WebhookSubscriptionValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e WebhookSubscriptionValidationError) GetErrorName() string {
+	return "WebhookSubscriptionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookSubscriptionValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookSubscription.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = WebhookSubscriptionValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = WebhookSubscriptionValidationError{}
+
+var _WebhookSubscription_EventTypes_InLookup = map[string]struct{}{
+	"profile.created":   {},
+	"profile.updated":   {},
+	"profile.deleted":   {},
+	"profile.undeleted": {},
+	"group.created":     {},
+	"group.updated":     {},
+	"group.deleted":     {},
+	"group.undeleted":   {},
+	"contact.created":   {},
+	"contact.updated":   {},
+	"contact.deleted":   {},
+	"contact.undeleted": {},
+}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *WebhookDelivery) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetSubscriptionId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				Field:  "SubscriptionId",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EventType
+
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				Field:  "Payload",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastStatusCode
+
+	// no validation rules for LastError
+
+	if v, ok := interface{}(m.GetNextAttemptAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				Field:  "NextAttemptAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				Field:  "CreatedAt",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e WebhookDeliveryValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e WebhookDeliveryValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e WebhookDeliveryValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e WebhookDeliveryValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e WebhookDeliveryValidationError) GetErrorName() string {
+	return "WebhookDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on CreateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateWebhookSubscriptionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookSubscriptionRequestValidationError{
+				Field:  "Payload",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateWebhookSubscriptionRequestValidationError is the validation error
+// returned by CreateWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e CreateWebhookSubscriptionRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e CreateWebhookSubscriptionRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e CreateWebhookSubscriptionRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e CreateWebhookSubscriptionRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e CreateWebhookSubscriptionRequestValidationError) GetErrorName() string {
+	return "CreateWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = CreateWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = CreateWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CreateWebhookSubscriptionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookSubscriptionResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateWebhookSubscriptionResponseValidationError is the validation error
+// returned by CreateWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type CreateWebhookSubscriptionResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e CreateWebhookSubscriptionResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e CreateWebhookSubscriptionResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e CreateWebhookSubscriptionResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e CreateWebhookSubscriptionResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e CreateWebhookSubscriptionResponseValidationError) GetErrorName() string {
+	return "CreateWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = CreateWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = CreateWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on ReadWebhookSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadWebhookSubscriptionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReadWebhookSubscriptionRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadWebhookSubscriptionRequestValidationError is the validation error
+// returned by ReadWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type ReadWebhookSubscriptionRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ReadWebhookSubscriptionRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ReadWebhookSubscriptionRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ReadWebhookSubscriptionRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ReadWebhookSubscriptionRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ReadWebhookSubscriptionRequestValidationError) GetErrorName() string {
+	return "ReadWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ReadWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ReadWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on ReadWebhookSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReadWebhookSubscriptionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReadWebhookSubscriptionResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadWebhookSubscriptionResponseValidationError is the validation error
+// returned by ReadWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type ReadWebhookSubscriptionResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e ReadWebhookSubscriptionResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e ReadWebhookSubscriptionResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e ReadWebhookSubscriptionResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e ReadWebhookSubscriptionResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e ReadWebhookSubscriptionResponseValidationError) GetErrorName() string {
+	return "ReadWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = ReadWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ReadWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on UpdateWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpdateWebhookSubscriptionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPayload()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWebhookSubscriptionRequestValidationError{
+				Field:  "Payload",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateWebhookSubscriptionRequestValidationError is the validation error
+// returned by UpdateWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type UpdateWebhookSubscriptionRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UpdateWebhookSubscriptionRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UpdateWebhookSubscriptionRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UpdateWebhookSubscriptionRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UpdateWebhookSubscriptionRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UpdateWebhookSubscriptionRequestValidationError) GetErrorName() string {
+	return "UpdateWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UpdateWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UpdateWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on UpdateWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpdateWebhookSubscriptionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetResult()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWebhookSubscriptionResponseValidationError{
+				Field:  "Result",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateWebhookSubscriptionResponseValidationError is the validation error
+// returned by UpdateWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type UpdateWebhookSubscriptionResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e UpdateWebhookSubscriptionResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e UpdateWebhookSubscriptionResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e UpdateWebhookSubscriptionResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e UpdateWebhookSubscriptionResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e UpdateWebhookSubscriptionResponseValidationError) GetErrorName() string {
+	return "UpdateWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = UpdateWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = UpdateWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on DeleteWebhookSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DeleteWebhookSubscriptionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
 
-	if err := m._validateEmail(m.GetPrimaryEmail()); err != nil {
-		return ContactValidationError{
-			Field:  "PrimaryEmail",
-			Reason: "value must be a valid email address",
-			Cause:  err,
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteWebhookSubscriptionRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
 		}
 	}
 
-	// no validation rules for Notes
+	return nil
+}
 
-	for idx, item := range m.GetEmails() {
-		_, _ = idx, item
+// DeleteWebhookSubscriptionRequestValidationError is the validation error
+// returned by DeleteWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
 
-		if v, ok := interface{}(item).(interface {
-			Validate() error
-		}); ok {
-			if err := v.Validate(); err != nil {
-				return ContactValidationError{
-					Field:  fmt.Sprintf("Emails[%v]", idx),
-					Reason: "embedded message failed validation",
-					Cause:  err,
-				}
-			}
-		}
+// GetField function returns Field value.
+func (e DeleteWebhookSubscriptionRequestValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e DeleteWebhookSubscriptionRequestValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e DeleteWebhookSubscriptionRequestValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e DeleteWebhookSubscriptionRequestValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e DeleteWebhookSubscriptionRequestValidationError) GetErrorName() string {
+	return "DeleteWebhookSubscriptionRequestValidationError"
+}
 
+// Error satisfies the builtin error interface
+func (e DeleteWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
 	}
 
-	if v, ok := interface{}(m.GetHomeAddress()).(interface {
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = DeleteWebhookSubscriptionRequestValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = DeleteWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DeleteWebhookSubscriptionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteWebhookSubscriptionResponseValidationError is the validation error
+// returned by DeleteWebhookSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type DeleteWebhookSubscriptionResponseValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
+
+// GetField function returns Field value.
+func (e DeleteWebhookSubscriptionResponseValidationError) GetField() string { return e.Field }
+
+// GetReason function returns Reason value.
+func (e DeleteWebhookSubscriptionResponseValidationError) GetReason() string { return e.Reason }
+
+// GetCause function returns Cause value.
+func (e DeleteWebhookSubscriptionResponseValidationError) GetCause() error { return e.Cause }
+
+// GetKey function returns Key value.
+func (e DeleteWebhookSubscriptionResponseValidationError) GetKey() bool { return e.Key }
+
+// GetErrorName returns Error Name value.
+func (e DeleteWebhookSubscriptionResponseValidationError) GetErrorName() string {
+	return "DeleteWebhookSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
+	}
+
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookSubscriptionResponse.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
+}
+
+var _ error = DeleteWebhookSubscriptionResponseValidationError{}
+
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = DeleteWebhookSubscriptionResponseValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhookSubscriptionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ContactValidationError{
-				Field:  "HomeAddress",
+			return ListWebhookSubscriptionRequestValidationError{
+				Field:  "Filter",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
 		}
 	}
 
-	if v, ok := interface{}(m.GetWorkAddress()).(interface {
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ContactValidationError{
-				Field:  "WorkAddress",
+			return ListWebhookSubscriptionRequestValidationError{
+				Field:  "OrderBy",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
 		}
 	}
 
-	if v, ok := interface{}(m.GetProfileId()).(interface {
+	if v, ok := interface{}(m.GetFields()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ContactValidationError{
-				Field:  "ProfileId",
+			return ListWebhookSubscriptionRequestValidationError{
+				Field:  "Fields",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetGroups() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface {
-			Validate() error
-		}); ok {
-			if err := v.Validate(); err != nil {
-				return ContactValidationError{
-					Field:  fmt.Sprintf("Groups[%v]", idx),
-					Reason: "embedded message failed validation",
-					Cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if v, ok := interface{}(m.GetNicknames()).(interface {
+	if v, ok := interface{}(m.GetPaging()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ContactValidationError{
-				Field:  "Nicknames",
+			return ListWebhookSubscriptionRequestValidationError{
+				Field:  "Paging",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2015,59 +8443,95 @@ func (m *Contact) Validate() error {
 	return nil
 }
 
-func (m *Contact) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// ListWebhookSubscriptionRequestValidationError is the validation error
+// returned by ListWebhookSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionRequestValidationError struct {
+	Field  string
+	Reason string
+	Cause  error
+	Key    bool
+}
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
+// GetField function returns Field value.
+func (e ListWebhookSubscriptionRequestValidationError) GetField() string { return e.Field }
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+// GetReason function returns Reason value.
+func (e ListWebhookSubscriptionRequestValidationError) GetReason() string { return e.Reason }
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+// GetCause function returns Cause value.
+func (e ListWebhookSubscriptionRequestValidationError) GetCause() error { return e.Cause }
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+// GetKey function returns Key value.
+func (e ListWebhookSubscriptionRequestValidationError) GetKey() bool { return e.Key }
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
+// GetErrorName returns Error Name value.
+func (e ListWebhookSubscriptionRequestValidationError) GetErrorName() string {
+	return "ListWebhookSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.Cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
 	}
 
-	return nil
+	key := ""
+	if e.Key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookSubscriptionRequest.%s: %s%s",
+		key,
+		e.Field,
+		e.Reason,
+		cause)
 }
 
-func (m *Contact) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
+var _ error = ListWebhookSubscriptionRequestValidationError{}
 
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
+var _ interface {
+	GetField() string
+	GetReason() string
+	GetKey() bool
+	GetCause() error
+	GetErrorName() string
+} = ListWebhookSubscriptionRequestValidationError{}
+
+// Validate checks the field values on ListWebhookSubscriptionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListWebhookSubscriptionsResponse) Validate() error {
+	if m == nil {
+		return nil
 	}
 
-	parts := strings.SplitN(addr, "@", 2)
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookSubscriptionsResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
 
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// ContactValidationError is the validation error returned by Contact.Validate
-// if the designated constraints aren't met.
-type ContactValidationError struct {
+// ListWebhookSubscriptionsResponseValidationError is the validation error
+// returned by ListWebhookSubscriptionsResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookSubscriptionsResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2075,22 +8539,24 @@ type ContactValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ContactValidationError) GetField() string { return e.Field }
+func (e ListWebhookSubscriptionsResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ContactValidationError) GetReason() string { return e.Reason }
+func (e ListWebhookSubscriptionsResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ContactValidationError) GetCause() error { return e.Cause }
+func (e ListWebhookSubscriptionsResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ContactValidationError) GetKey() bool { return e.Key }
+func (e ListWebhookSubscriptionsResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ContactValidationError) GetErrorName() string { return "ContactValidationError" }
+func (e ListWebhookSubscriptionsResponseValidationError) GetErrorName() string {
+	return "ListWebhookSubscriptionsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ContactValidationError) Error() string {
+func (e ListWebhookSubscriptionsResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2102,14 +8568,14 @@ func (e ContactValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sContact.%s: %s%s",
+		"invalid %sListWebhookSubscriptionsResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ContactValidationError{}
+var _ error = ListWebhookSubscriptionsResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2117,51 +8583,60 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ContactValidationError{}
+} = ListWebhookSubscriptionsResponseValidationError{}
 
-// Validate checks the field values on Email with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Email) Validate() error {
+// Validate checks the field values on ListWebhookDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhookDeliveryRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
-	if err := m._validateEmail(m.GetAddress()); err != nil {
-		return EmailValidationError{
-			Field:  "Address",
-			Reason: "value must be a valid email address",
-			Cause:  err,
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListWebhookDeliveryRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
 		}
 	}
 
-	return nil
-}
-
-func (m *Email) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListWebhookDeliveryRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
 		}
+	}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListWebhookDeliveryRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
 		}
+	}
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListWebhookDeliveryRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
 			}
 		}
 	}
@@ -2169,29 +8644,9 @@ func (m *Email) _validateHostname(host string) error {
 	return nil
 }
 
-func (m *Email) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// EmailValidationError is the validation error returned by Email.Validate if
-// the designated constraints aren't met.
-type EmailValidationError struct {
+// ListWebhookDeliveryRequestValidationError is the validation error returned
+// by ListWebhookDeliveryRequest.Validate if the designated constraints aren't met.
+type ListWebhookDeliveryRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2199,22 +8654,24 @@ type EmailValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e EmailValidationError) GetField() string { return e.Field }
+func (e ListWebhookDeliveryRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e EmailValidationError) GetReason() string { return e.Reason }
+func (e ListWebhookDeliveryRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e EmailValidationError) GetCause() error { return e.Cause }
+func (e ListWebhookDeliveryRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e EmailValidationError) GetKey() bool { return e.Key }
+func (e ListWebhookDeliveryRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e EmailValidationError) GetErrorName() string { return "EmailValidationError" }
+func (e ListWebhookDeliveryRequestValidationError) GetErrorName() string {
+	return "ListWebhookDeliveryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EmailValidationError) Error() string {
+func (e ListWebhookDeliveryRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2226,14 +8683,14 @@ func (e EmailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEmail.%s: %s%s",
+		"invalid %sListWebhookDeliveryRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = EmailValidationError{}
+var _ error = ListWebhookDeliveryRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2241,31 +8698,40 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = EmailValidationError{}
+} = ListWebhookDeliveryRequestValidationError{}
 
-// Validate checks the field values on Address with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Address) Validate() error {
+// Validate checks the field values on ListWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListWebhookDeliveriesResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Address
-
-	// no validation rules for City
-
-	// no validation rules for State
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-	// no validation rules for Zip
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Country
+	}
 
 	return nil
 }
 
-// AddressValidationError is the validation error returned by Address.Validate
-// if the designated constraints aren't met.
-type AddressValidationError struct {
+// ListWebhookDeliveriesResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2273,22 +8739,24 @@ type AddressValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e AddressValidationError) GetField() string { return e.Field }
+func (e ListWebhookDeliveriesResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e AddressValidationError) GetReason() string { return e.Reason }
+func (e ListWebhookDeliveriesResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e AddressValidationError) GetCause() error { return e.Cause }
+func (e ListWebhookDeliveriesResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e AddressValidationError) GetKey() bool { return e.Key }
+func (e ListWebhookDeliveriesResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e AddressValidationError) GetErrorName() string { return "AddressValidationError" }
+func (e ListWebhookDeliveriesResponseValidationError) GetErrorName() string {
+	return "ListWebhookDeliveriesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddressValidationError) Error() string {
+func (e ListWebhookDeliveriesResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2300,14 +8768,14 @@ func (e AddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddress.%s: %s%s",
+		"invalid %sListWebhookDeliveriesResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = AddressValidationError{}
+var _ error = ListWebhookDeliveriesResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2315,34 +8783,59 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = AddressValidationError{}
+} = ListWebhookDeliveriesResponseValidationError{}
 
-// Validate checks the field values on CreateContactRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *CreateContactRequest) Validate() error {
+// Validate checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SavedSearch) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPayload()).(interface {
+	if v, ok := interface{}(m.GetId()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return CreateContactRequestValidationError{
-				Field:  "Payload",
+			return SavedSearchValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		return SavedSearchValidationError{
+			Field:  "Name",
+			Reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+	}
+
+	if v, ok := interface{}(m.GetProfileId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchValidationError{
+				Field:  "ProfileId",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Filter
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Fields
+
 	return nil
 }
 
-// CreateContactRequestValidationError is the validation error returned by
-// CreateContactRequest.Validate if the designated constraints aren't met.
-type CreateContactRequestValidationError struct {
+// SavedSearchValidationError is the validation error returned by
+// SavedSearch.Validate if the designated constraints aren't met.
+type SavedSearchValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2350,24 +8843,22 @@ type CreateContactRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e CreateContactRequestValidationError) GetField() string { return e.Field }
+func (e SavedSearchValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e CreateContactRequestValidationError) GetReason() string { return e.Reason }
+func (e SavedSearchValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e CreateContactRequestValidationError) GetCause() error { return e.Cause }
+func (e SavedSearchValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e CreateContactRequestValidationError) GetKey() bool { return e.Key }
+func (e SavedSearchValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e CreateContactRequestValidationError) GetErrorName() string {
-	return "CreateContactRequestValidationError"
-}
+func (e SavedSearchValidationError) GetErrorName() string { return "SavedSearchValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateContactRequestValidationError) Error() string {
+func (e SavedSearchValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2379,14 +8870,14 @@ func (e CreateContactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateContactRequest.%s: %s%s",
+		"invalid %sSavedSearch.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = CreateContactRequestValidationError{}
+var _ error = SavedSearchValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2394,22 +8885,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = CreateContactRequestValidationError{}
+} = SavedSearchValidationError{}
 
-// Validate checks the field values on CreateContactResponse with the rules
+// Validate checks the field values on CreateSavedSearchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateContactResponse) Validate() error {
+func (m *CreateSavedSearchRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface {
+	if v, ok := interface{}(m.GetPayload()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return CreateContactResponseValidationError{
-				Field:  "Result",
+			return CreateSavedSearchRequestValidationError{
+				Field:  "Payload",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2419,9 +8910,9 @@ func (m *CreateContactResponse) Validate() error {
 	return nil
 }
 
-// CreateContactResponseValidationError is the validation error returned by
-// CreateContactResponse.Validate if the designated constraints aren't met.
-type CreateContactResponseValidationError struct {
+// CreateSavedSearchRequestValidationError is the validation error returned by
+// CreateSavedSearchRequest.Validate if the designated constraints aren't met.
+type CreateSavedSearchRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2429,24 +8920,24 @@ type CreateContactResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e CreateContactResponseValidationError) GetField() string { return e.Field }
+func (e CreateSavedSearchRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e CreateContactResponseValidationError) GetReason() string { return e.Reason }
+func (e CreateSavedSearchRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e CreateContactResponseValidationError) GetCause() error { return e.Cause }
+func (e CreateSavedSearchRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e CreateContactResponseValidationError) GetKey() bool { return e.Key }
+func (e CreateSavedSearchRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e CreateContactResponseValidationError) GetErrorName() string {
-	return "CreateContactResponseValidationError"
+func (e CreateSavedSearchRequestValidationError) GetErrorName() string {
+	return "CreateSavedSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateContactResponseValidationError) Error() string {
+func (e CreateSavedSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2458,14 +8949,14 @@ func (e CreateContactResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateContactResponse.%s: %s%s",
+		"invalid %sCreateSavedSearchRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = CreateContactResponseValidationError{}
+var _ error = CreateSavedSearchRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2473,22 +8964,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = CreateContactResponseValidationError{}
+} = CreateSavedSearchRequestValidationError{}
 
-// Validate checks the field values on ReadContactRequest with the rules
+// Validate checks the field values on CreateSavedSearchResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ReadContactRequest) Validate() error {
+func (m *CreateSavedSearchResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetId()).(interface {
+	if v, ok := interface{}(m.GetResult()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ReadContactRequestValidationError{
-				Field:  "Id",
+			return CreateSavedSearchResponseValidationError{
+				Field:  "Result",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2498,9 +8989,9 @@ func (m *ReadContactRequest) Validate() error {
 	return nil
 }
 
-// ReadContactRequestValidationError is the validation error returned by
-// ReadContactRequest.Validate if the designated constraints aren't met.
-type ReadContactRequestValidationError struct {
+// CreateSavedSearchResponseValidationError is the validation error returned by
+// CreateSavedSearchResponse.Validate if the designated constraints aren't met.
+type CreateSavedSearchResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2508,24 +8999,24 @@ type ReadContactRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ReadContactRequestValidationError) GetField() string { return e.Field }
+func (e CreateSavedSearchResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ReadContactRequestValidationError) GetReason() string { return e.Reason }
+func (e CreateSavedSearchResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ReadContactRequestValidationError) GetCause() error { return e.Cause }
+func (e CreateSavedSearchResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ReadContactRequestValidationError) GetKey() bool { return e.Key }
+func (e CreateSavedSearchResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ReadContactRequestValidationError) GetErrorName() string {
-	return "ReadContactRequestValidationError"
+func (e CreateSavedSearchResponseValidationError) GetErrorName() string {
+	return "CreateSavedSearchResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadContactRequestValidationError) Error() string {
+func (e CreateSavedSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2537,14 +9028,14 @@ func (e ReadContactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadContactRequest.%s: %s%s",
+		"invalid %sCreateSavedSearchResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ReadContactRequestValidationError{}
+var _ error = CreateSavedSearchResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2552,22 +9043,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ReadContactRequestValidationError{}
+} = CreateSavedSearchResponseValidationError{}
 
-// Validate checks the field values on ReadContactResponse with the rules
+// Validate checks the field values on ReadSavedSearchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ReadContactResponse) Validate() error {
+func (m *ReadSavedSearchRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface {
+	if v, ok := interface{}(m.GetId()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ReadContactResponseValidationError{
-				Field:  "Result",
+			return ReadSavedSearchRequestValidationError{
+				Field:  "Id",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2577,9 +9068,9 @@ func (m *ReadContactResponse) Validate() error {
 	return nil
 }
 
-// ReadContactResponseValidationError is the validation error returned by
-// ReadContactResponse.Validate if the designated constraints aren't met.
-type ReadContactResponseValidationError struct {
+// ReadSavedSearchRequestValidationError is the validation error returned by
+// ReadSavedSearchRequest.Validate if the designated constraints aren't met.
+type ReadSavedSearchRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2587,24 +9078,24 @@ type ReadContactResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ReadContactResponseValidationError) GetField() string { return e.Field }
+func (e ReadSavedSearchRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ReadContactResponseValidationError) GetReason() string { return e.Reason }
+func (e ReadSavedSearchRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ReadContactResponseValidationError) GetCause() error { return e.Cause }
+func (e ReadSavedSearchRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ReadContactResponseValidationError) GetKey() bool { return e.Key }
+func (e ReadSavedSearchRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ReadContactResponseValidationError) GetErrorName() string {
-	return "ReadContactResponseValidationError"
+func (e ReadSavedSearchRequestValidationError) GetErrorName() string {
+	return "ReadSavedSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadContactResponseValidationError) Error() string {
+func (e ReadSavedSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2616,14 +9107,14 @@ func (e ReadContactResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadContactResponse.%s: %s%s",
+		"invalid %sReadSavedSearchRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ReadContactResponseValidationError{}
+var _ error = ReadSavedSearchRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2631,34 +9122,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ReadContactResponseValidationError{}
+} = ReadSavedSearchRequestValidationError{}
 
-// Validate checks the field values on UpdateContactRequest with the rules
+// Validate checks the field values on ReadSavedSearchResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateContactRequest) Validate() error {
+func (m *ReadSavedSearchResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetPayload()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateContactRequestValidationError{
-				Field:  "Payload",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetFields()).(interface {
+	if v, ok := interface{}(m.GetResult()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateContactRequestValidationError{
-				Field:  "Fields",
+			return ReadSavedSearchResponseValidationError{
+				Field:  "Result",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2668,9 +9147,9 @@ func (m *UpdateContactRequest) Validate() error {
 	return nil
 }
 
-// UpdateContactRequestValidationError is the validation error returned by
-// UpdateContactRequest.Validate if the designated constraints aren't met.
-type UpdateContactRequestValidationError struct {
+// ReadSavedSearchResponseValidationError is the validation error returned by
+// ReadSavedSearchResponse.Validate if the designated constraints aren't met.
+type ReadSavedSearchResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2678,24 +9157,24 @@ type UpdateContactRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e UpdateContactRequestValidationError) GetField() string { return e.Field }
+func (e ReadSavedSearchResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e UpdateContactRequestValidationError) GetReason() string { return e.Reason }
+func (e ReadSavedSearchResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e UpdateContactRequestValidationError) GetCause() error { return e.Cause }
+func (e ReadSavedSearchResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e UpdateContactRequestValidationError) GetKey() bool { return e.Key }
+func (e ReadSavedSearchResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e UpdateContactRequestValidationError) GetErrorName() string {
-	return "UpdateContactRequestValidationError"
+func (e ReadSavedSearchResponseValidationError) GetErrorName() string {
+	return "ReadSavedSearchResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateContactRequestValidationError) Error() string {
+func (e ReadSavedSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2707,14 +9186,14 @@ func (e UpdateContactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateContactRequest.%s: %s%s",
+		"invalid %sReadSavedSearchResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = UpdateContactRequestValidationError{}
+var _ error = ReadSavedSearchResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2722,22 +9201,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = UpdateContactRequestValidationError{}
+} = ReadSavedSearchResponseValidationError{}
 
-// Validate checks the field values on UpdateContactResponse with the rules
+// Validate checks the field values on UpdateSavedSearchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateContactResponse) Validate() error {
+func (m *UpdateSavedSearchRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetResult()).(interface {
+	if v, ok := interface{}(m.GetPayload()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateContactResponseValidationError{
-				Field:  "Result",
+			return UpdateSavedSearchRequestValidationError{
+				Field:  "Payload",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2747,9 +9226,9 @@ func (m *UpdateContactResponse) Validate() error {
 	return nil
 }
 
-// UpdateContactResponseValidationError is the validation error returned by
-// UpdateContactResponse.Validate if the designated constraints aren't met.
-type UpdateContactResponseValidationError struct {
+// UpdateSavedSearchRequestValidationError is the validation error returned by
+// UpdateSavedSearchRequest.Validate if the designated constraints aren't met.
+type UpdateSavedSearchRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2757,24 +9236,24 @@ type UpdateContactResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e UpdateContactResponseValidationError) GetField() string { return e.Field }
+func (e UpdateSavedSearchRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e UpdateContactResponseValidationError) GetReason() string { return e.Reason }
+func (e UpdateSavedSearchRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e UpdateContactResponseValidationError) GetCause() error { return e.Cause }
+func (e UpdateSavedSearchRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e UpdateContactResponseValidationError) GetKey() bool { return e.Key }
+func (e UpdateSavedSearchRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e UpdateContactResponseValidationError) GetErrorName() string {
-	return "UpdateContactResponseValidationError"
+func (e UpdateSavedSearchRequestValidationError) GetErrorName() string {
+	return "UpdateSavedSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateContactResponseValidationError) Error() string {
+func (e UpdateSavedSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2786,14 +9265,14 @@ func (e UpdateContactResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateContactResponse.%s: %s%s",
+		"invalid %sUpdateSavedSearchRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = UpdateContactResponseValidationError{}
+var _ error = UpdateSavedSearchRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2801,22 +9280,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = UpdateContactResponseValidationError{}
+} = UpdateSavedSearchRequestValidationError{}
 
-// Validate checks the field values on DeleteContactRequest with the rules
+// Validate checks the field values on UpdateSavedSearchResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteContactRequest) Validate() error {
+func (m *UpdateSavedSearchResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetId()).(interface {
+	if v, ok := interface{}(m.GetResult()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return DeleteContactRequestValidationError{
-				Field:  "Id",
+			return UpdateSavedSearchResponseValidationError{
+				Field:  "Result",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -2826,9 +9305,9 @@ func (m *DeleteContactRequest) Validate() error {
 	return nil
 }
 
-// DeleteContactRequestValidationError is the validation error returned by
-// DeleteContactRequest.Validate if the designated constraints aren't met.
-type DeleteContactRequestValidationError struct {
+// UpdateSavedSearchResponseValidationError is the validation error returned by
+// UpdateSavedSearchResponse.Validate if the designated constraints aren't met.
+type UpdateSavedSearchResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2836,24 +9315,24 @@ type DeleteContactRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e DeleteContactRequestValidationError) GetField() string { return e.Field }
+func (e UpdateSavedSearchResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e DeleteContactRequestValidationError) GetReason() string { return e.Reason }
+func (e UpdateSavedSearchResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e DeleteContactRequestValidationError) GetCause() error { return e.Cause }
+func (e UpdateSavedSearchResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e DeleteContactRequestValidationError) GetKey() bool { return e.Key }
+func (e UpdateSavedSearchResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e DeleteContactRequestValidationError) GetErrorName() string {
-	return "DeleteContactRequestValidationError"
+func (e UpdateSavedSearchResponseValidationError) GetErrorName() string {
+	return "UpdateSavedSearchResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteContactRequestValidationError) Error() string {
+func (e UpdateSavedSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2865,14 +9344,14 @@ func (e DeleteContactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteContactRequest.%s: %s%s",
+		"invalid %sUpdateSavedSearchResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = DeleteContactRequestValidationError{}
+var _ error = UpdateSavedSearchResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2880,22 +9359,34 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = DeleteContactRequestValidationError{}
+} = UpdateSavedSearchResponseValidationError{}
 
-// Validate checks the field values on DeleteContactResponse with the rules
+// Validate checks the field values on DeleteSavedSearchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteContactResponse) Validate() error {
+func (m *DeleteSavedSearchRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if v, ok := interface{}(m.GetId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteSavedSearchRequestValidationError{
+				Field:  "Id",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
-// DeleteContactResponseValidationError is the validation error returned by
-// DeleteContactResponse.Validate if the designated constraints aren't met.
-type DeleteContactResponseValidationError struct {
+// DeleteSavedSearchRequestValidationError is the validation error returned by
+// DeleteSavedSearchRequest.Validate if the designated constraints aren't met.
+type DeleteSavedSearchRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2903,24 +9394,24 @@ type DeleteContactResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e DeleteContactResponseValidationError) GetField() string { return e.Field }
+func (e DeleteSavedSearchRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e DeleteContactResponseValidationError) GetReason() string { return e.Reason }
+func (e DeleteSavedSearchRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e DeleteContactResponseValidationError) GetCause() error { return e.Cause }
+func (e DeleteSavedSearchRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e DeleteContactResponseValidationError) GetKey() bool { return e.Key }
+func (e DeleteSavedSearchRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e DeleteContactResponseValidationError) GetErrorName() string {
-	return "DeleteContactResponseValidationError"
+func (e DeleteSavedSearchRequestValidationError) GetErrorName() string {
+	return "DeleteSavedSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteContactResponseValidationError) Error() string {
+func (e DeleteSavedSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -2932,14 +9423,14 @@ func (e DeleteContactResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteContactResponse.%s: %s%s",
+		"invalid %sDeleteSavedSearchRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = DeleteContactResponseValidationError{}
+var _ error = DeleteSavedSearchRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -2947,39 +9438,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = DeleteContactResponseValidationError{}
+} = DeleteSavedSearchRequestValidationError{}
 
-// Validate checks the field values on ListContactsResponse with the rules
+// Validate checks the field values on DeleteSavedSearchResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListContactsResponse) Validate() error {
+func (m *DeleteSavedSearchResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetResults() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface {
-			Validate() error
-		}); ok {
-			if err := v.Validate(); err != nil {
-				return ListContactsResponseValidationError{
-					Field:  fmt.Sprintf("Results[%v]", idx),
-					Reason: "embedded message failed validation",
-					Cause:  err,
-				}
-			}
-		}
-
-	}
-
 	return nil
 }
 
-// ListContactsResponseValidationError is the validation error returned by
-// ListContactsResponse.Validate if the designated constraints aren't met.
-type ListContactsResponseValidationError struct {
+// DeleteSavedSearchResponseValidationError is the validation error returned by
+// DeleteSavedSearchResponse.Validate if the designated constraints aren't met.
+type DeleteSavedSearchResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -2987,24 +9461,24 @@ type ListContactsResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ListContactsResponseValidationError) GetField() string { return e.Field }
+func (e DeleteSavedSearchResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ListContactsResponseValidationError) GetReason() string { return e.Reason }
+func (e DeleteSavedSearchResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ListContactsResponseValidationError) GetCause() error { return e.Cause }
+func (e DeleteSavedSearchResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ListContactsResponseValidationError) GetKey() bool { return e.Key }
+func (e DeleteSavedSearchResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ListContactsResponseValidationError) GetErrorName() string {
-	return "ListContactsResponseValidationError"
+func (e DeleteSavedSearchResponseValidationError) GetErrorName() string {
+	return "DeleteSavedSearchResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListContactsResponseValidationError) Error() string {
+func (e DeleteSavedSearchResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -3016,14 +9490,14 @@ func (e ListContactsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListContactsResponse.%s: %s%s",
+		"invalid %sDeleteSavedSearchResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ListContactsResponseValidationError{}
+var _ error = DeleteSavedSearchResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -3031,25 +9505,70 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ListContactsResponseValidationError{}
+} = DeleteSavedSearchResponseValidationError{}
 
-// Validate checks the field values on SMSRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *SMSRequest) Validate() error {
+// Validate checks the field values on ListSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSavedSearchRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListSavedSearchRequestValidationError{
+				Field:  "Filter",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetOrderBy()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListSavedSearchRequestValidationError{
+				Field:  "OrderBy",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFields()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListSavedSearchRequestValidationError{
+				Field:  "Fields",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Message
+	if v, ok := interface{}(m.GetPaging()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListSavedSearchRequestValidationError{
+				Field:  "Paging",
+				Reason: "embedded message failed validation",
+				Cause:  err,
+			}
+		}
+	}
 
 	return nil
 }
 
-// SMSRequestValidationError is the validation error returned by
-// SMSRequest.Validate if the designated constraints aren't met.
-type SMSRequestValidationError struct {
+// ListSavedSearchRequestValidationError is the validation error returned by
+// ListSavedSearchRequest.Validate if the designated constraints aren't met.
+type ListSavedSearchRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -3057,22 +9576,24 @@ type SMSRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e SMSRequestValidationError) GetField() string { return e.Field }
+func (e ListSavedSearchRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e SMSRequestValidationError) GetReason() string { return e.Reason }
+func (e ListSavedSearchRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e SMSRequestValidationError) GetCause() error { return e.Cause }
+func (e ListSavedSearchRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e SMSRequestValidationError) GetKey() bool { return e.Key }
+func (e ListSavedSearchRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e SMSRequestValidationError) GetErrorName() string { return "SMSRequestValidationError" }
+func (e ListSavedSearchRequestValidationError) GetErrorName() string {
+	return "ListSavedSearchRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SMSRequestValidationError) Error() string {
+func (e ListSavedSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -3084,14 +9605,14 @@ func (e SMSRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSMSRequest.%s: %s%s",
+		"invalid %sListSavedSearchRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = SMSRequestValidationError{}
+var _ error = ListSavedSearchRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -3099,22 +9620,39 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = SMSRequestValidationError{}
+} = ListSavedSearchRequestValidationError{}
 
-// Validate checks the field values on SMSResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *SMSResponse) Validate() error {
+// Validate checks the field values on ListSavedSearchesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSavedSearchesResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListSavedSearchesResponseValidationError{
+					Field:  fmt.Sprintf("Results[%v]", idx),
+					Reason: "embedded message failed validation",
+					Cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// SMSResponseValidationError is the validation error returned by
-// SMSResponse.Validate if the designated constraints aren't met.
-type SMSResponseValidationError struct {
+// ListSavedSearchesResponseValidationError is the validation error returned by
+// ListSavedSearchesResponse.Validate if the designated constraints aren't met.
+type ListSavedSearchesResponseValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -3122,22 +9660,24 @@ type SMSResponseValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e SMSResponseValidationError) GetField() string { return e.Field }
+func (e ListSavedSearchesResponseValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e SMSResponseValidationError) GetReason() string { return e.Reason }
+func (e ListSavedSearchesResponseValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e SMSResponseValidationError) GetCause() error { return e.Cause }
+func (e ListSavedSearchesResponseValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e SMSResponseValidationError) GetKey() bool { return e.Key }
+func (e ListSavedSearchesResponseValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e SMSResponseValidationError) GetErrorName() string { return "SMSResponseValidationError" }
+func (e ListSavedSearchesResponseValidationError) GetErrorName() string {
+	return "ListSavedSearchesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SMSResponseValidationError) Error() string {
+func (e ListSavedSearchesResponseValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -3149,14 +9689,14 @@ func (e SMSResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSMSResponse.%s: %s%s",
+		"invalid %sListSavedSearchesResponse.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = SMSResponseValidationError{}
+var _ error = ListSavedSearchesResponseValidationError{}
 
 var _ interface {
 	GetField() string
@@ -3164,46 +9704,22 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = SMSResponseValidationError{}
+} = ListSavedSearchesResponseValidationError{}
 
-// Validate checks the field values on ListContactRequest with the rules
+// Validate checks the field values on RunSavedSearchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListContactRequest) Validate() error {
+func (m *RunSavedSearchRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetFilter()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return ListContactRequestValidationError{
-				Field:  "Filter",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetOrderBy()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return ListContactRequestValidationError{
-				Field:  "OrderBy",
-				Reason: "embedded message failed validation",
-				Cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetFields()).(interface {
+	if v, ok := interface{}(m.GetId()).(interface {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ListContactRequestValidationError{
-				Field:  "Fields",
+			return RunSavedSearchRequestValidationError{
+				Field:  "Id",
 				Reason: "embedded message failed validation",
 				Cause:  err,
 			}
@@ -3214,7 +9730,7 @@ func (m *ListContactRequest) Validate() error {
 		Validate() error
 	}); ok {
 		if err := v.Validate(); err != nil {
-			return ListContactRequestValidationError{
+			return RunSavedSearchRequestValidationError{
 				Field:  "Paging",
 				Reason: "embedded message failed validation",
 				Cause:  err,
@@ -3225,9 +9741,9 @@ func (m *ListContactRequest) Validate() error {
 	return nil
 }
 
-// ListContactRequestValidationError is the validation error returned by
-// ListContactRequest.Validate if the designated constraints aren't met.
-type ListContactRequestValidationError struct {
+// RunSavedSearchRequestValidationError is the validation error returned by
+// RunSavedSearchRequest.Validate if the designated constraints aren't met.
+type RunSavedSearchRequestValidationError struct {
 	Field  string
 	Reason string
 	Cause  error
@@ -3235,24 +9751,24 @@ type ListContactRequestValidationError struct {
 }
 
 // GetField function returns Field value.
-func (e ListContactRequestValidationError) GetField() string { return e.Field }
+func (e RunSavedSearchRequestValidationError) GetField() string { return e.Field }
 
 // GetReason function returns Reason value.
-func (e ListContactRequestValidationError) GetReason() string { return e.Reason }
+func (e RunSavedSearchRequestValidationError) GetReason() string { return e.Reason }
 
 // GetCause function returns Cause value.
-func (e ListContactRequestValidationError) GetCause() error { return e.Cause }
+func (e RunSavedSearchRequestValidationError) GetCause() error { return e.Cause }
 
 // GetKey function returns Key value.
-func (e ListContactRequestValidationError) GetKey() bool { return e.Key }
+func (e RunSavedSearchRequestValidationError) GetKey() bool { return e.Key }
 
 // GetErrorName returns Error Name value.
-func (e ListContactRequestValidationError) GetErrorName() string {
-	return "ListContactRequestValidationError"
+func (e RunSavedSearchRequestValidationError) GetErrorName() string {
+	return "RunSavedSearchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListContactRequestValidationError) Error() string {
+func (e RunSavedSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.Cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.Cause)
@@ -3264,14 +9780,14 @@ func (e ListContactRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListContactRequest.%s: %s%s",
+		"invalid %sRunSavedSearchRequest.%s: %s%s",
 		key,
 		e.Field,
 		e.Reason,
 		cause)
 }
 
-var _ error = ListContactRequestValidationError{}
+var _ error = RunSavedSearchRequestValidationError{}
 
 var _ interface {
 	GetField() string
@@ -3279,4 +9795,4 @@ var _ interface {
 	GetKey() bool
 	GetCause() error
 	GetErrorName() string
-} = ListContactRequestValidationError{}
+} = RunSavedSearchRequestValidationError{}
@@ -0,0 +1,198 @@
+package purge
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/sirupsen/logrus"
+)
+
+// LockKey is the key of the Postgres advisory lock which guarantees that only
+// one replica purges deleted records at a time
+const LockKey int64 = 0x70757267652d6361 // "purge-ca"
+
+// DefaultBatchSize is the batch size used if a non-positive one is provided
+const DefaultBatchSize = 500
+
+// Tables lists the tables with soft deleted records in the order they are purged
+var Tables = []string{"contacts", "groups", "profiles"}
+
+// EventsTable is the table of contact events, the events are purged after
+// the retention period as well
+const EventsTable = "contact_events"
+
+// OutboxTable is the table of outbox events, the published events are purged
+// after the retention period
+const OutboxTable = "outbox"
+
+var (
+	purgeRuns = expvar.NewInt("purge_runs")
+	purgeRows = expvar.NewMap("purge_rows")
+)
+
+// Purger permanently removes records which were deleted longer than the
+// retention period ago
+type Purger struct {
+	db        *gorm.DB
+	retention time.Duration
+	batchSize int
+	logger    logrus.FieldLogger
+}
+
+// NewPurger returns a purger which removes records deleted longer than
+// retention ago, at most batchSize rows of one account at a time
+func NewPurger(db *gorm.DB, retention time.Duration, batchSize int, logger logrus.FieldLogger) *Purger {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &Purger{db: db, retention: retention, batchSize: batchSize, logger: logger}
+}
+
+// Run purges deleted records every interval until ctx is done
+func (p *Purger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := p.Purge(ctx); err != nil {
+			p.logger.Errorf("unable to purge deleted records: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge removes the expired deleted records of all tables and returns the
+// number of purged rows per table. Nothing is purged if another replica
+// holds the advisory lock.
+func (p *Purger) Purge(ctx context.Context) (map[string]int64, error) {
+	// the advisory lock belongs to a session so it is acquired and released
+	// using a dedicated connection
+	conn, err := p.db.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", LockKey).Scan(&locked); err != nil {
+		return nil, err
+	}
+	if !locked {
+		p.logger.Debug("deleted records are being purged by another replica")
+		return nil, nil
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", LockKey)
+
+	before := time.Now().Add(-p.retention)
+	purged := make(map[string]int64, len(Tables))
+	for _, table := range Tables {
+		n, err := p.purgeTable(ctx, table, before)
+		purged[table] = n
+		purgeRows.Add(table, n)
+		if err != nil {
+			return purged, err
+		}
+	}
+	n, err := p.purgeEvents(ctx, EventsTable, "created_at < ?", before)
+	purged[EventsTable] = n
+	purgeRows.Add(EventsTable, n)
+	if err != nil {
+		return purged, err
+	}
+	n, err = p.purgeEvents(ctx, OutboxTable, "published_at < ?", before)
+	purged[OutboxTable] = n
+	purgeRows.Add(OutboxTable, n)
+	if err != nil {
+		return purged, err
+	}
+	purgeRuns.Add(1)
+
+	p.logger.WithFields(logrus.Fields{
+		"contacts": purged["contacts"],
+		"groups":   purged["groups"],
+		"profiles": purged["profiles"],
+		"events":   purged[EventsTable],
+		"outbox":   purged[OutboxTable],
+	}).Info("purged deleted records")
+
+	return purged, nil
+}
+
+func (p *Purger) purgeTable(ctx context.Context, table string, before time.Time) (int64, error) {
+	var accounts []string
+	if err := p.db.Table(table).
+		Where("deleted_at < ? AND account_id IS NOT NULL", before).
+		Pluck("DISTINCT account_id", &accounts).Error; err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, account := range accounts {
+		n, err := p.purgeAccount(ctx, table, account, before)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		p.logger.WithFields(logrus.Fields{
+			"table":      table,
+			"account_id": account,
+			"rows":       n,
+		}).Debug("purged deleted records of account")
+	}
+	return total, nil
+}
+
+// purgeAccount removes the expired records of a single account in batches
+// of at most batchSize rows, so no statement holds locks on too many rows
+func (p *Purger) purgeAccount(ctx context.Context, table, account string, before time.Time) (int64, error) {
+	query := fmt.Sprintf(
+		"DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE account_id = ? AND deleted_at < ? ORDER BY id LIMIT ?)",
+		table,
+	)
+
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		res := p.db.Exec(query, account, before, p.batchSize)
+		if res.Error != nil {
+			return total, res.Error
+		}
+		total += res.RowsAffected
+		if res.RowsAffected < int64(p.batchSize) {
+			return total, nil
+		}
+	}
+}
+
+// purgeEvents removes the events of the table which match the condition in
+// batches, the condition takes the time before which the events expire
+func (p *Purger) purgeEvents(ctx context.Context, table, condition string, before time.Time) (int64, error) {
+	query := fmt.Sprintf(
+		"DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s ORDER BY id LIMIT ?)",
+		table, condition,
+	)
+
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		res := p.db.Exec(query, before, p.batchSize)
+		if res.Error != nil {
+			return total, res.Error
+		}
+		total += res.RowsAffected
+		if res.RowsAffected < int64(p.batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package svc
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of unique constraint violations
+	uniqueViolation = "23505"
+	// emailsUniqueKey is the unique constraint of e-mail addresses within an account
+	emailsUniqueKey = "emails_account_id_address_key"
+)
+
+// BatchCreate creates the contacts within the caller's account in the order
+// of the request items
+func (s *contactsServer) BatchCreate(ctx context.Context, in *pb.BatchCreateContactsRequest) (*pb.BatchCreateContactsResponse, error) {
+	items := in.GetPayload()
+	res := &pb.BatchCreateContactsResponse{Results: make([]*pb.Contact, len(items))}
+	validate := func(i int) error {
+		return items[i].Validate()
+	}
+	apply := func(i int) error {
+		created, err := s.Create(ctx, &pb.CreateContactRequest{Payload: items[i]})
+		if err != nil {
+			return err
+		}
+		res.Results[i] = created.GetResult()
+		return nil
+	}
+	errs, err := runBatch(ctx, in.GetMode(), len(items), validate, apply)
+	if err != nil {
+		return nil, err
+	}
+	res.Errors = errs
+	for i, r := range res.Results {
+		if r == nil {
+			res.Results[i] = &pb.Contact{}
+		}
+	}
+	return res, nil
+}
+
+// BatchUpdate updates the contacts within the caller's account in the order
+// of the request items. The etag of an item is checked like the one of Update,
+// the If-Match header doesn't apply to batches.
+func (s *contactsServer) BatchUpdate(ctx context.Context, in *pb.BatchUpdateContactsRequest) (*pb.BatchUpdateContactsResponse, error) {
+	items := in.GetPayload()
+	res := &pb.BatchUpdateContactsResponse{Results: make([]*pb.Contact, len(items))}
+	validate := func(i int) error {
+		if items[i].GetId() == nil {
+			return errors.NewContainer(codes.InvalidArgument, "The contact id is required.")
+		}
+		return items[i].Validate()
+	}
+	apply := func(i int) error {
+		updated, err := s.update(ctx, &pb.UpdateContactRequest{Payload: items[i]}, items[i].GetEtag())
+		if err != nil {
+			return err
+		}
+		res.Results[i] = updated.GetResult()
+		return nil
+	}
+	errs, err := runBatch(ctx, in.GetMode(), len(items), validate, apply)
+	if err != nil {
+		return nil, err
+	}
+	res.Errors = errs
+	for i, r := range res.Results {
+		if r == nil {
+			res.Results[i] = &pb.Contact{}
+		}
+	}
+	return res, nil
+}
+
+// BatchDelete marks the contacts within the caller's account as deleted in
+// the order of the request items
+func (s *contactsServer) BatchDelete(ctx context.Context, in *pb.BatchDeleteContactsRequest) (*pb.BatchDeleteContactsResponse, error) {
+	ids := in.GetIds()
+	validate := func(i int) error {
+		if ids[i] == nil {
+			return errors.NewContainer(codes.InvalidArgument, "The contact id is required.")
+		}
+		return nil
+	}
+	apply := func(i int) error {
+		_, err := s.Delete(ctx, &pb.DeleteContactRequest{Id: ids[i]})
+		return err
+	}
+	errs, err := runBatch(ctx, in.GetMode(), len(ids), validate, apply)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchDeleteContactsResponse{Errors: errs}, nil
+}
+
+// runBatch validates and applies n items of a batch by their indexes.
+// In the ATOMIC mode the batch fails if any item is invalid or fails and the
+// transaction of the request is rolled back. In the BEST_EFFORT mode every
+// item is applied within a savepoint, so only the changes of the failed items
+// are rolled back, and the failures are returned as error details.
+func runBatch(ctx context.Context, mode pb.BatchMode, n int, validate, apply func(i int) error) ([]*errdetails.TargetInfo, error) {
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == pb.BatchMode_ATOMIC {
+		errC := errors.InitContainer()
+		invalid := false
+		for i := 0; i < n; i++ {
+			if err := validate(i); err != nil {
+				errC.WithDetail(codes.InvalidArgument, strconv.Itoa(i), "%s", err)
+				invalid = true
+			}
+		}
+		if invalid {
+			return nil, errC.New(codes.InvalidArgument, "Batch validation failed.")
+		}
+		for i := 0; i < n; i++ {
+			if err := apply(i); err != nil {
+				detail := itemError(ctx, strconv.Itoa(i), err)
+				return nil, errors.NewContainer(codes.Code(detail.GetCode()), "Batch item %d failed.", i).
+					WithDetail(codes.Code(detail.GetCode()), detail.GetTarget(), "%s", detail.GetMessage())
+			}
+		}
+		return nil, nil
+	}
+
+	var errs []*errdetails.TargetInfo
+	for i := 0; i < n; i++ {
+		if err := validate(i); err != nil {
+			errs = append(errs, errdetails.New(codes.InvalidArgument, strconv.Itoa(i), "%s", err))
+			continue
+		}
+		failed, err := savepoint(db, func() error { return apply(i) })
+		if err != nil {
+			return nil, err
+		}
+		if failed != nil {
+			errs = append(errs, itemError(ctx, strconv.Itoa(i), failed))
+		}
+	}
+	return errs, nil
+}
+
+// savepoint runs apply within a savepoint of the transaction db, the changes
+// of apply are rolled back if it fails. The error of apply is returned as
+// failed, err is the error of the savepoint itself.
+func savepoint(db *gorm.DB, apply func() error) (failed error, err error) {
+	if err := db.Exec("SAVEPOINT item").Error; err != nil {
+		return nil, err
+	}
+	if failed := apply(); failed != nil {
+		if err := db.Exec("ROLLBACK TO SAVEPOINT item").Error; err != nil {
+			return nil, err
+		}
+		return failed, nil
+	}
+	return nil, db.Exec("RELEASE SAVEPOINT item").Error
+}
+
+// itemError describes the failure of the batch item with the given target,
+// which is the index of the item or the number of a row of an imported file
+func itemError(ctx context.Context, target string, err error) *errdetails.TargetInfo {
+	if err == gorm.ErrRecordNotFound {
+		return errdetails.New(codes.NotFound, target, "The contact does not exist.")
+	}
+	if e, ok := err.(*pq.Error); ok && e.Code == uniqueViolation {
+		if e.Constraint == emailsUniqueKey {
+			return errdetails.New(codes.AlreadyExists, target, "The e-mail address is already used by another contact.")
+		}
+		return errdetails.New(codes.AlreadyExists, target, "The contact conflicts with an existing one.")
+	}
+	if st, ok := status.FromError(err); ok {
+		return errdetails.New(st.Code(), target, "%s", st.Message())
+	}
+	if _, ok := err.(interface {
+		GetField() string
+		GetReason() string
+	}); ok {
+		return errdetails.New(codes.InvalidArgument, target, "%s", err)
+	}
+	ctxlogrus.Extract(ctx).WithError(err).WithField("item", target).Error("batch item failed")
+	return errdetails.New(codes.Internal, target, "Internal error occured.")
+}
@@ -0,0 +1,215 @@
+package svc
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/protoc-gen-gorm/types"
+	"google.golang.org/grpc/codes"
+)
+
+// CSVContentType is the media type of exported and imported CSV files
+const CSVContentType = "text/csv"
+
+// csvListSeparator separates the values of list columns, e.g. "emails"
+const csvListSeparator = ";"
+
+// csvColumn is a column of the CSV representation of contacts
+type csvColumn struct {
+	name string
+	get  func(c *pb.Contact) string
+	set  func(c *pb.Contact, value string)
+}
+
+// csvColumns lists the columns of exported files in their order
+var csvColumns = append([]csvColumn{
+	{
+		name: "first_name",
+		get:  func(c *pb.Contact) string { return c.GetFirstName() },
+		set:  func(c *pb.Contact, v string) { c.FirstName = v },
+	},
+	{
+		name: "middle_name",
+		get:  func(c *pb.Contact) string { return c.GetMiddleName() },
+		set:  func(c *pb.Contact, v string) { c.MiddleName = v },
+	},
+	{
+		name: "last_name",
+		get:  func(c *pb.Contact) string { return c.GetLastName() },
+		set:  func(c *pb.Contact, v string) { c.LastName = v },
+	},
+	{
+		name: "primary_email",
+		get:  func(c *pb.Contact) string { return c.GetPrimaryEmail() },
+		set:  func(c *pb.Contact, v string) { c.PrimaryEmail = v },
+	},
+	{
+		// emails are the e-mail addresses other than the primary one
+		name: "emails",
+		get: func(c *pb.Contact) string {
+			emails := []string{}
+			for _, e := range c.GetEmails() {
+				if e.GetAddress() != c.GetPrimaryEmail() {
+					emails = append(emails, e.GetAddress())
+				}
+			}
+			return strings.Join(emails, csvListSeparator)
+		},
+		set: func(c *pb.Contact, v string) {
+			for _, address := range splitCSVList(v) {
+				c.Emails = append(c.Emails, &pb.Email{Address: address})
+			}
+		},
+	},
+	{
+		name: "notes",
+		get:  func(c *pb.Contact) string { return c.GetNotes() },
+		set:  func(c *pb.Contact, v string) { c.Notes = v },
+	},
+	{
+		name: "nicknames",
+		get: func(c *pb.Contact) string {
+			var nicknames []string
+			if err := json.Unmarshal([]byte(c.GetNicknames().GetValue()), &nicknames); err != nil {
+				// nicknames is arbitrary JSON, it is exported as is
+				return c.GetNicknames().GetValue()
+			}
+			return strings.Join(nicknames, csvListSeparator)
+		},
+		set: func(c *pb.Contact, v string) {
+			if nicknames := splitCSVList(v); len(nicknames) > 0 {
+				data, _ := json.Marshal(nicknames)
+				c.Nicknames = &types.JSONValue{Value: string(data)}
+			}
+		},
+	},
+}, append(
+	addressColumns("home_address", func(c *pb.Contact) **pb.Address { return &c.HomeAddress }),
+	addressColumns("work_address", func(c *pb.Contact) **pb.Address { return &c.WorkAddress })...,
+)...)
+
+// addressColumns returns the columns of the fields of an address, e.g.
+// home_address.city. The address is created when any of them is set.
+func addressColumns(prefix string, address func(c *pb.Contact) **pb.Address) []csvColumn {
+	field := func(name string, get func(a *pb.Address) string, set func(a *pb.Address, v string)) csvColumn {
+		return csvColumn{
+			name: prefix + "." + name,
+			get:  func(c *pb.Contact) string { return get(*address(c)) },
+			set: func(c *pb.Contact, v string) {
+				a := address(c)
+				if *a == nil {
+					*a = &pb.Address{}
+				}
+				set(*a, v)
+			},
+		}
+	}
+	return []csvColumn{
+		field("address", (*pb.Address).GetAddress, func(a *pb.Address, v string) { a.Address = v }),
+		field("city", (*pb.Address).GetCity, func(a *pb.Address, v string) { a.City = v }),
+		field("state", (*pb.Address).GetState, func(a *pb.Address, v string) { a.State = v }),
+		field("zip", (*pb.Address).GetZip, func(a *pb.Address, v string) { a.Zip = v }),
+		field("country", (*pb.Address).GetCountry, func(a *pb.Address, v string) { a.Country = v }),
+	}
+}
+
+// selectCSVColumns returns the columns selected by the field selection in
+// the order of the exported files. A field with subfields, e.g. home_address,
+// selects the columns of all of its subfields unless they are selected too.
+// All the columns are selected if there is no field.
+func selectCSVColumns(fs *query.FieldSelection) ([]csvColumn, error) {
+	fields := fs.GetFields()
+	if len(fields) == 0 {
+		return csvColumns, nil
+	}
+	for name, f := range fields {
+		if !knownCSVField(name) {
+			return nil, errors.NewContainer(codes.InvalidArgument, "Unknown field %q.", name)
+		}
+		for sub := range f.GetSubs() {
+			if !knownCSVField(name + "." + sub) {
+				return nil, errors.NewContainer(codes.InvalidArgument, "Unknown field %q.", name+"."+sub)
+			}
+		}
+	}
+
+	columns := []csvColumn{}
+	for _, c := range csvColumns {
+		path := strings.SplitN(c.name, ".", 2)
+		f, ok := fields[path[0]]
+		if !ok {
+			continue
+		}
+		if len(path) == 2 && len(f.GetSubs()) > 0 && f.GetSubs()[path[1]] == nil {
+			continue
+		}
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+// knownCSVField reports whether the field is a column or has subfields which
+// are columns
+func knownCSVField(name string) bool {
+	for _, c := range csvColumns {
+		if c.name == name || strings.HasPrefix(c.name, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// mapCSVHeader returns the columns of the header of an imported file, the
+// column of a header which is neither mapped nor named like a column is nil
+func mapCSVHeader(header []string, mapping map[string]string) ([]*csvColumn, error) {
+	columns := make([]*csvColumn, len(header))
+	for i, h := range header {
+		name, mapped := mapping[h]
+		if !mapped {
+			name = strings.TrimSpace(h)
+		}
+		for j := range csvColumns {
+			if csvColumns[j].name == name {
+				columns[i] = &csvColumns[j]
+				break
+			}
+		}
+		if mapped && columns[i] == nil {
+			return nil, errors.NewContainer(codes.InvalidArgument, "The column %q is mapped to unknown field %q.", h, name)
+		}
+	}
+	return columns, nil
+}
+
+// csvRecord returns the values of the columns of the contact
+func csvRecord(c *pb.Contact, columns []csvColumn) []string {
+	record := make([]string, len(columns))
+	for i, col := range columns {
+		record[i] = col.get(c)
+	}
+	return record
+}
+
+// contactFromCSV returns the contact described by a row of an imported file
+func contactFromCSV(record []string, columns []*csvColumn) *pb.Contact {
+	c := &pb.Contact{}
+	for i, v := range record {
+		if i < len(columns) && columns[i] != nil && v != "" {
+			columns[i].set(c, v)
+		}
+	}
+	return c
+}
+
+func splitCSVList(v string) []string {
+	values := []string{}
+	for _, s := range strings.Split(v, csvListSeparator) {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
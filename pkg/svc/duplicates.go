@@ -0,0 +1,188 @@
+package svc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// duplicateWeights are the likelihoods of two contacts being duplicates if
+// they share a property. A shared e-mail address differs in case only as the
+// addresses are unique within an account.
+var duplicateWeights = map[string]float64{
+	"email":   0.9,
+	"name":    0.6,
+	"address": 0.5,
+}
+
+// duplicateKeysSQL selects the normalized properties of the contacts which
+// are compared by FindDuplicates, the contacts sharing a key are duplicates
+const duplicateKeysSQL = `
+SELECT e.contact_id AS id, 'email' AS reason, lower(e.address) AS key
+	FROM emails e JOIN contacts c ON c.id = e.contact_id
+	WHERE c.account_id = (SELECT account FROM params) AND c.deleted_at IS NULL
+UNION ALL
+SELECT c.id, 'name', lower(regexp_replace(concat(c.first_name, c.last_name), '[^[:alnum:]]+', '', 'g'))
+	FROM contacts c
+	WHERE c.account_id = (SELECT account FROM params) AND c.deleted_at IS NULL
+UNION ALL
+SELECT c.id, 'address', lower(regexp_replace(concat(a.address, a.zip, a.country), '[^[:alnum:]]+', '', 'g'))
+	FROM addresses a JOIN contacts c ON c.id IN (a.home_address_contact_id, a.work_address_contact_id)
+	WHERE c.account_id = (SELECT account FROM params) AND c.deleted_at IS NULL AND a.address <> ''
+`
+
+// duplicatePair is a pair of contacts sharing a property
+type duplicatePair struct {
+	ID1    int64
+	ID2    int64
+	Reason string
+}
+
+// FindDuplicates groups the contacts within the caller's account which are
+// likely the same person. Two contacts are duplicates if they share an
+// e-mail address, a name or an address, the groups are the sets of contacts
+// connected by such pairs.
+func (s *contactsServer) FindDuplicates(ctx context.Context, in *pb.FindDuplicatesRequest) (*pb.FindDuplicatesResponse, error) {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pairs := []duplicatePair{}
+	if err := db.Raw(`WITH params AS (SELECT ?::text AS account), keys AS (`+duplicateKeysSQL+`)
+		SELECT DISTINCT a.id AS id1, b.id AS id2, a.reason
+		FROM keys a JOIN keys b ON a.reason = b.reason AND a.key = b.key AND a.id < b.id
+		WHERE a.key <> ''
+		ORDER BY 1, 2, 3`, accountID,
+	).Scan(&pairs).Error; err != nil {
+		return nil, err
+	}
+
+	// the confidence of a pair combines the likelihoods of its shared properties
+	type pair struct{ id1, id2 int64 }
+	confidence := map[pair]float64{}
+	reasons := map[pair][]string{}
+	for _, p := range pairs {
+		k := pair{p.ID1, p.ID2}
+		if _, ok := confidence[k]; !ok {
+			confidence[k] = 1
+		}
+		confidence[k] *= 1 - duplicateWeights[p.Reason]
+		reasons[k] = append(reasons[k], p.Reason)
+	}
+
+	groups := newDisjointSets()
+	for k := range confidence {
+		confidence[k] = 1 - confidence[k]
+		if confidence[k] >= float64(in.GetMinConfidence()) {
+			groups.union(k.id1, k.id2)
+		}
+	}
+	results := map[int64]*pb.DuplicateGroup{}
+	members := map[int64][]int64{}
+	for k, c := range confidence {
+		if c < float64(in.GetMinConfidence()) {
+			continue
+		}
+		root := groups.find(k.id1)
+		g, ok := results[root]
+		if !ok {
+			g = &pb.DuplicateGroup{}
+			results[root] = g
+		}
+		if float32(c) > g.Confidence {
+			g.Confidence = float32(c)
+		}
+		g.Reasons = appendMissing(g.Reasons, reasons[k]...)
+	}
+
+	ids := []int64{}
+	for id := range groups.parent {
+		ids = append(ids, id)
+		root := groups.find(id)
+		members[root] = append(members[root], id)
+	}
+	res := &pb.FindDuplicatesResponse{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+	contacts, err := readContacts(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+	roots := []int64{}
+	for root, g := range results {
+		sort.Slice(members[root], func(i, j int) bool { return members[root][i] < members[root][j] })
+		for _, id := range members[root] {
+			g.Contacts = append(g.Contacts, contacts[id])
+		}
+		sort.Strings(g.Reasons)
+		roots = append(roots, root)
+	}
+	// the most likely duplicates first, then the oldest contacts first
+	sort.Slice(roots, func(i, j int) bool {
+		a, b := results[roots[i]], results[roots[j]]
+		if a.Confidence != b.Confidence {
+			return a.Confidence > b.Confidence
+		}
+		return members[roots[i]][0] < members[roots[j]][0]
+	})
+	for _, root := range roots {
+		res.Results = append(res.Results, results[root])
+	}
+	return res, nil
+}
+
+// disjointSets is a union-find structure of contact ids
+type disjointSets struct {
+	parent map[int64]int64
+}
+
+func newDisjointSets() *disjointSets {
+	return &disjointSets{parent: map[int64]int64{}}
+}
+
+// find returns the representative of the set of the id
+func (s *disjointSets) find(id int64) int64 {
+	p, ok := s.parent[id]
+	if !ok {
+		s.parent[id] = id
+		return id
+	}
+	if p == id {
+		return id
+	}
+	root := s.find(p)
+	s.parent[id] = root
+	return root
+}
+
+// union merges the sets of the ids
+func (s *disjointSets) union(id1, id2 int64) {
+	r1, r2 := s.find(id1), s.find(id2)
+	if r1 != r2 {
+		s.parent[r2] = r1
+	}
+}
+
+// appendMissing appends the values which list doesn't contain yet
+func appendMissing(list []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, l := range list {
+			if l == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -0,0 +1,137 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ETagHeader is the response metadata key of the entity tag
+	ETagHeader = "etag"
+	// IfMatchHeader is the request metadata key of the expected entity tag
+	IfMatchHeader = "if-match"
+	// gatewayIfMatchHeader is the If-Match HTTP header forwarded by the gateway
+	gatewayIfMatchHeader = "grpcgateway-if-match"
+)
+
+// setETag sends the entity tag in the response header, the gateway returns
+// it as the HTTP ETag header
+func setETag(ctx context.Context, etag string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(ETagHeader, fmt.Sprintf("%q", etag)))
+}
+
+// expectedETag returns the entity tag the client expects the resource to
+// have. The If-Match header takes precedence over the etag field of the
+// payload. An empty string means the client doesn't expect any version.
+func expectedETag(ctx context.Context, payloadETag string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{IfMatchHeader, gatewayIfMatchHeader} {
+			if vals := md[key]; len(vals) > 0 {
+				etag := strings.TrimPrefix(strings.TrimSpace(vals[0]), "W/")
+				if etag == "*" {
+					return ""
+				}
+				return strings.Trim(etag, `"`)
+			}
+		}
+	}
+	return payloadETag
+}
+
+// revision is the state of a row after its version was incremented
+type revision struct {
+	// ETag is the entity tag of the new version
+	ETag string
+	// CreatedAt is the unchanged creation time of the row, an Update keeps it
+	CreatedAt *timestamp.Timestamp
+}
+
+// nextRevision increments the version of the row with the given id from the
+// table of model within the caller's account. If etag is not empty the version
+// is incremented only if etag is the entity tag of the current version,
+// otherwise FailedPrecondition is returned, see IsPreconditionFailed.
+func nextRevision(ctx context.Context, db *gorm.DB, model interface{}, id int64, etag string) (*revision, error) {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	table := db.NewScope(model).TableName()
+
+	query := fmt.Sprintf("UPDATE %s SET version = version + 1 WHERE account_id = ? AND id = ? AND deleted_at IS NULL", table)
+	args := []interface{}{accountID, id}
+	if etag != "" {
+		if _, err := strconv.ParseInt(etag, 10, 64); err != nil {
+			return nil, preconditionFailed("The provided entity tag %q is invalid.", etag)
+		}
+		query += " AND version = ?"
+		args = append(args, pb.VersionFromETag(etag))
+	}
+	query += " RETURNING version, created_at"
+
+	var (
+		version   int64
+		createdAt *time.Time
+	)
+	err = db.Raw(query, args...).Row().Scan(&version, &createdAt)
+	if err == sql.ErrNoRows {
+		var count int
+		if err := db.Model(model).Where("account_id = ? AND id = ?", accountID, id).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, preconditionFailed("The resource was modified, the provided entity tag %q is outdated.", etag)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rev := &revision{ETag: pb.ETag(version)}
+	if createdAt != nil {
+		if rev.CreatedAt, err = ptypes.TimestampProto(*createdAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return rev, nil
+}
+
+// preconditionFailed returns FailedPrecondition with a detail targeting the
+// entity tag, so it can be told apart from other failed preconditions
+func preconditionFailed(format string, args ...interface{}) error {
+	return errors.NewContainer(codes.FailedPrecondition, format, args...).
+		WithDetail(codes.FailedPrecondition, ETagHeader, format, args...)
+}
+
+// IsPreconditionFailed reports whether err rejects a change because the
+// entity tag provided by the client isn't the one of the current version.
+// The gateway responds with 412 Precondition Failed then.
+func IsPreconditionFailed(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.TargetInfo); ok && info.GetTarget() == ETagHeader {
+			return true
+		}
+	}
+	return false
+}
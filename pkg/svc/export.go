@@ -0,0 +1,177 @@
+package svc
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gateway"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// exportPageSize is the number of contacts read from the database at once
+	exportPageSize int32 = 500
+	// exportChunkSize is the maximum size of the data of a chunk of an exported file
+	exportChunkSize = 32 << 10
+)
+
+// Export streams the contacts within the caller's account which match the
+// filter as a CSV or a vCard file. The contacts are read in pages within a
+// single read-only transaction, so the file is a consistent snapshot of the
+// contacts however large it is. The fields select the columns of CSV files.
+func (s *contactsServer) Export(in *pb.ExportContactsRequest, stream pb.Contacts_ExportServer) error {
+	ctx := stream.Context()
+	if err := exportCollectionOps(ctx, in); err != nil {
+		return err
+	}
+
+	var (
+		w     *chunkWriter
+		write func(c *pb.Contact) error
+		flush func() error
+	)
+	switch strings.ToLower(in.GetFormat()) {
+	case "", "csv":
+		columns, err := selectCSVColumns(in.GetFields())
+		if err != nil {
+			return err
+		}
+		w = &chunkWriter{stream: stream, contentType: CSVContentType}
+		out := csv.NewWriter(w)
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = c.name
+		}
+		if err := out.Write(header); err != nil {
+			return err
+		}
+		write = func(c *pb.Contact) error {
+			return out.Write(csvRecord(c, columns))
+		}
+		flush = func() error {
+			out.Flush()
+			return out.Error()
+		}
+	case "vcard":
+		version, err := vcardVersion(in.GetVcardVersion())
+		if err != nil {
+			return err
+		}
+		w = &chunkWriter{stream: stream, contentType: vcard.ContentType}
+		out := vcard.NewWriter(w)
+		write = func(c *pb.Contact) error {
+			return out.Write(vcard.FromContact(c, version), version)
+		}
+		flush = func() error { return nil }
+	default:
+		return errors.NewContainer(codes.InvalidArgument, "Unknown format %q.", in.GetFormat())
+	}
+
+	tx := s.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	// the transaction is read-only, it is never committed
+	defer tx.Rollback()
+	if err := tx.Exec("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY").Error; err != nil {
+		return err
+	}
+
+	for offset := int32(0); ; offset += exportPageSize {
+		req := &pb.ListContactRequest{
+			OrderBy: in.GetOrderBy(),
+			Paging:  &query.Pagination{Offset: offset, Limit: exportPageSize},
+		}
+		if in.GetFilter() != nil {
+			// the filter is rewritten when it is applied, so every page needs a copy
+			req.Filter = proto.Clone(in.GetFilter()).(*query.Filtering)
+		}
+		contacts, err := pb.ListContacts(ctx, tx, req)
+		if err != nil {
+			return err
+		}
+		for _, c := range contacts {
+			if err := write(c); err != nil {
+				return err
+			}
+		}
+		if int32(len(contacts)) < exportPageSize {
+			break
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// exportCollectionOps sets the collection operators of an export requested
+// through the gateway. The gateway passes them to streaming calls as request
+// metadata only.
+func exportCollectionOps(ctx context.Context, in *pb.ExportContactsRequest) error {
+	if in.Filter == nil {
+		f, err := gateway.Filtering(ctx)
+		if err != nil {
+			return err
+		}
+		in.Filter = f
+	}
+	if in.OrderBy == nil {
+		s, err := gateway.Sorting(ctx)
+		if err != nil {
+			return err
+		}
+		in.OrderBy = s
+	}
+	if in.Fields == nil {
+		in.Fields = gateway.FieldSelection(ctx)
+	}
+	return nil
+}
+
+// chunkWriter sends the data written to it as chunks of a file
+type chunkWriter struct {
+	stream      pb.Contacts_ExportServer
+	contentType string
+	buf         []byte
+	sent        bool
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Close sends the rest of the data, at least one chunk is sent even if the
+// file is empty
+func (w *chunkWriter) Close() error {
+	if len(w.buf) == 0 && w.sent {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+// send sends a chunk, only the first chunk has the content type of the file
+func (w *chunkWriter) send(data []byte) error {
+	chunk := &pb.FileChunk{Data: append([]byte(nil), data...)}
+	if !w.sent {
+		chunk.ContentType = w.contentType
+	}
+	w.sent = true
+	return w.stream.Send(chunk)
+}
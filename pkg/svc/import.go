@@ -0,0 +1,260 @@
+package svc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/rpc/errdetails"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/audit"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ImportDryRunMetadata is the request metadata key of the dry_run option
+	// of an import requested through the gateway
+	ImportDryRunMetadata = "import-dry-run"
+	// ImportFormatMetadata is the request metadata key of the format option
+	// of an import requested through the gateway
+	ImportFormatMetadata = "import-format"
+	// ImportMappingMetadata is the request metadata key of the column mapping
+	// of an import requested through the gateway, its values are URL encoded
+	// pairs of a column and a field, e.g. "E-mail=primary_email"
+	ImportMappingMetadata = "import-mapping"
+	// MaxImportContacts is the maximum number of contacts of an imported file
+	MaxImportContacts = 10000
+	// importMethod is the method the audit events of imported contacts are
+	// recorded for
+	importMethod = "/api.contacts.Contacts/Import"
+)
+
+// Import creates the contacts described by a CSV or a vCard file within the
+// caller's account. The first row of a CSV file is the header with the names
+// of the columns, which are the fields of the exported files unless the
+// mapping of the options maps them to the fields. Columns of other fields are
+// ignored. The format is detected from the content unless the options set it.
+// The options are taken from the first message of the stream or, if it has
+// none, from the request metadata.
+// The whole file is read before the transaction which creates the contacts
+// is opened, so a slow upload doesn't keep it open. Every contact is created
+// like the ones of Contacts.Create within a savepoint, so the rows or cards
+// which fail are reported as errors and don't prevent the others from being
+// created. The created contacts are recorded in the audit log. Nothing is
+// stored on a dry run.
+func (s *contactsServer) Import(stream pb.Contacts_ImportServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return errors.NewContainer(codes.InvalidArgument, "The file is empty.")
+	}
+	if err != nil {
+		return err
+	}
+	opts, err := importOptions(ctx, first.GetOptions())
+	if err != nil {
+		return err
+	}
+
+	in := bufio.NewReader(&chunkReader{stream: stream, buf: first.GetData()})
+	format := strings.ToLower(opts.GetFormat())
+	if format == "" {
+		format = detectFormat(in)
+	}
+	var next contactReader
+	switch format {
+	case "csv":
+		if next, err = csvContactReader(in, opts.GetMapping()); err != nil {
+			return err
+		}
+	case "vcard":
+		next = vcardContactReader(in)
+	default:
+		return errors.NewContainer(codes.InvalidArgument, "Unknown format %q.", opts.GetFormat())
+	}
+
+	var items []importItem
+	for {
+		contact, target, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(items) == MaxImportContacts {
+			return errors.NewContainer(codes.InvalidArgument, "The file has more than %d contacts.", MaxImportContacts)
+		}
+		items = append(items, importItem{contact: contact, target: target})
+	}
+
+	tx := s.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	res := &pb.ImportContactsResponse{}
+	for _, item := range items {
+		contact := item.contact
+		if err := contact.Validate(); err != nil {
+			res.Errors = append(res.Errors, errdetails.New(codes.InvalidArgument, item.target, "%s", err))
+			continue
+		}
+		failed, err := savepoint(tx, func() error {
+			created, err := createContact(ctx, tx, contact)
+			if err != nil {
+				return err
+			}
+			return audit.Record(ctx, tx, importMethod, nil, created)
+		})
+		if err != nil {
+			return err
+		}
+		if failed != nil {
+			res.Errors = append(res.Errors, itemError(ctx, item.target, failed))
+			continue
+		}
+		res.Created++
+	}
+
+	if !opts.GetDryRun() {
+		if err := tx.Commit().Error; err != nil {
+			return err
+		}
+	}
+	return stream.SendAndClose(res)
+}
+
+// importItem is a contact of an imported file and the target of its errors
+type importItem struct {
+	contact *pb.Contact
+	target  string
+}
+
+// contactReader returns the next contact of an imported file and the target
+// of its errors, io.EOF is returned after the last one
+type contactReader func() (*pb.Contact, string, error)
+
+// csvContactReader reads the header of a CSV file and returns the reader of
+// its rows, the target of a row is its number where the header is row 1
+func csvContactReader(r io.Reader, mapping map[string]string) (contactReader, error) {
+	in := csv.NewReader(r)
+	// the rows with a wrong number of columns are tolerated, missing values are empty
+	in.FieldsPerRecord = -1
+	header, err := in.Read()
+	if err == io.EOF {
+		return nil, errors.NewContainer(codes.InvalidArgument, "The file is empty.")
+	}
+	if err != nil {
+		return nil, errors.NewContainer(codes.InvalidArgument, "Invalid CSV file: %s.", err)
+	}
+	columns, err := mapCSVHeader(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+	row := 1
+	return func() (*pb.Contact, string, error) {
+		record, err := in.Read()
+		if err == io.EOF {
+			return nil, "", io.EOF
+		}
+		if err != nil {
+			return nil, "", errors.NewContainer(codes.InvalidArgument, "Invalid CSV file: %s.", err)
+		}
+		row++
+		return contactFromCSV(record, columns), strconv.Itoa(row), nil
+	}, nil
+}
+
+// vcardContactReader returns the reader of the cards of a vCard file, the
+// target of a card is its number starting at 1
+func vcardContactReader(r io.Reader) contactReader {
+	in := vcard.NewReader(r)
+	n := 0
+	return func() (*pb.Contact, string, error) {
+		card, err := in.Read()
+		if err == io.EOF {
+			return nil, "", io.EOF
+		}
+		if err != nil {
+			return nil, "", errors.NewContainer(codes.InvalidArgument, "Invalid vCard file: %s.", err)
+		}
+		n++
+		return vcard.ToContact(card), strconv.Itoa(n), nil
+	}
+}
+
+// detectFormat returns "vcard" if the file starts with BEGIN:VCARD and "csv"
+// otherwise
+func detectFormat(r *bufio.Reader) string {
+	head, _ := r.Peek(512)
+	head = bytes.TrimLeft(bytes.TrimPrefix(head, []byte("\xef\xbb\xbf")), " \t\r\n")
+	if len(head) >= 11 && bytes.EqualFold(head[:11], []byte("BEGIN:VCARD")) {
+		return "vcard"
+	}
+	return "csv"
+}
+
+// importOptions returns the options of an import, the options from the
+// request metadata are used if the stream provides none
+func importOptions(ctx context.Context, opts *pb.ImportOptions) (*pb.ImportOptions, error) {
+	if opts != nil {
+		return opts, nil
+	}
+	opts = &pb.ImportOptions{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return opts, nil
+	}
+	if vals := md[ImportDryRunMetadata]; len(vals) > 0 {
+		dryRun, err := strconv.ParseBool(vals[0])
+		if err != nil {
+			return nil, errors.NewContainer(codes.InvalidArgument, "Invalid dry_run %q.", vals[0])
+		}
+		opts.DryRun = dryRun
+	}
+	if vals := md[ImportFormatMetadata]; len(vals) > 0 {
+		opts.Format = vals[0]
+	}
+	for _, v := range md[ImportMappingMetadata] {
+		pairs, err := url.ParseQuery(v)
+		if err != nil {
+			return nil, errors.NewContainer(codes.InvalidArgument, "Invalid mapping %q.", v)
+		}
+		for column, fields := range pairs {
+			if opts.Mapping == nil {
+				opts.Mapping = map[string]string{}
+			}
+			opts.Mapping[column] = fields[0]
+		}
+	}
+	return opts, nil
+}
+
+// chunkReader reads the data of the messages of an import stream
+type chunkReader struct {
+	stream pb.Contacts_ImportServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,178 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	rpcresource "github.com/infobloxopen/atlas-app-toolkit/rpc/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// AddContacts adds the contacts within the caller's account to the group.
+// Only the new members change the group, their event is stored in the outbox.
+func (s *groupsServer) AddContacts(ctx context.Context, in *pb.AddGroupContactsRequest) (*pb.AddGroupContactsResponse, error) {
+	id, contactIDs, err := decodeMembers(in.GetId(), in.GetContactIds())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := manualGroup(ctx, db, id); err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	found := []int64{}
+	if err := db.Model(&pb.ContactORM{}).Where("account_id = ? AND id IN (?)", accountID, contactIDs).
+		Pluck("id", &found).Error; err != nil {
+		return nil, err
+	}
+	for _, c := range contactIDs {
+		if !containsID(found, c) {
+			return nil, errors.NewContainer(codes.NotFound, "The contact %d does not exist.", c)
+		}
+	}
+
+	res := db.Exec(`INSERT INTO group_contacts (group_id, contact_id)
+		SELECT ?, id FROM contacts WHERE id IN (?)
+		ON CONFLICT DO NOTHING`, id, contactIDs)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if err := membersChanged(ctx, db, in.GetId(), id, res.RowsAffected); err != nil {
+		return nil, err
+	}
+	return &pb.AddGroupContactsResponse{}, nil
+}
+
+// RemoveContacts removes the contacts from the group, the contacts
+// themselves are kept.
+func (s *groupsServer) RemoveContacts(ctx context.Context, in *pb.RemoveGroupContactsRequest) (*pb.RemoveGroupContactsResponse, error) {
+	id, contactIDs, err := decodeMembers(in.GetId(), in.GetContactIds())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := manualGroup(ctx, db, id); err != nil {
+		return nil, err
+	}
+	res := db.Exec("DELETE FROM group_contacts WHERE group_id = ? AND contact_id IN (?)", id, contactIDs)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if err := membersChanged(ctx, db, in.GetId(), id, res.RowsAffected); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveGroupContactsResponse{}, nil
+}
+
+// ListMembers returns the contacts of the group which aren't deleted, the
+// request supports the same collection operators and page token as
+// Contacts.List. The members of a smart group are the contacts matching its
+// member filter.
+func (s *groupsServer) ListMembers(ctx context.Context, in *pb.ListGroupMembersRequest) (*pb.ListGroupMembersResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Group{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := memberFilter(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	page, err := requestedPage(in.GetPaging())
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.ListContactRequest{
+		Filter:  in.GetFilter(),
+		OrderBy: in.GetOrderBy(),
+		Fields:  in.GetFields(),
+		Paging:  page,
+	}
+	var res []*pb.Contact
+	if filter != "" {
+		res, err = smartGroupMembers(ctx, db, filter, req)
+	} else {
+		res, err = pb.ListContacts(ctx, db.Where("contacts.id IN (SELECT contact_id FROM group_contacts WHERE group_id = ?)", id), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := setPageToken(ctx, page, len(res)); err != nil {
+		return nil, err
+	}
+	return &pb.ListGroupMembersResponse{Results: res}, nil
+}
+
+// decodeMembers decodes the identifiers of a group and of its members
+func decodeMembers(group *rpcresource.Identifier, contacts []*rpcresource.Identifier) (int64, []int64, error) {
+	id, err := resource.DecodeInt64(&pb.Group{}, group)
+	if err != nil {
+		return 0, nil, err
+	}
+	contactIDs := make([]int64, len(contacts))
+	for i, c := range contacts {
+		if contactIDs[i], err = resource.DecodeInt64(&pb.Contact{}, c); err != nil {
+			return 0, nil, err
+		}
+	}
+	return id, contactIDs, nil
+}
+
+// manualGroup returns FailedPrecondition if the group is a smart group whose
+// members can't be added or removed
+func manualGroup(ctx context.Context, db *gorm.DB, id int64) error {
+	filter, err := memberFilter(ctx, db, id)
+	if err != nil {
+		return err
+	}
+	if filter != "" {
+		return errors.NewContainer(codes.FailedPrecondition, "The members of a smart group match its member filter, they can't be added or removed.")
+	}
+	return nil
+}
+
+// membersChanged increments the version of the group whose members have
+// changed and stores its event in the outbox, nothing is done if no member
+// was added or removed
+func membersChanged(ctx context.Context, db *gorm.DB, group *rpcresource.Identifier, id int64, changed int64) error {
+	if changed == 0 {
+		return nil
+	}
+	rev, err := nextRevision(ctx, db, &pb.GroupORM{}, id, "")
+	if err != nil {
+		return err
+	}
+	res, err := pb.DefaultReadGroup(ctx, &pb.Group{Id: group}, db)
+	if err != nil {
+		return err
+	}
+	if err := addEvent(ctx, "group.updated", res); err != nil {
+		return err
+	}
+	return setETag(ctx, rev.ETag)
+}
+
+func containsID(list []int64, id int64) bool {
+	for _, l := range list {
+		if l == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/protoc-gen-gorm/types"
+	"google.golang.org/grpc/codes"
+)
+
+// Merge merges the contacts into the survivor and deletes them. The survivor
+// gets the union of their e-mail addresses, phone numbers, groups, nicknames
+// and notes, and the names and addresses it doesn't have. The audit
+// interceptor records the change of the survivor and the deletion of the
+// merged contacts with the same request id.
+func (s *contactsServer) Merge(ctx context.Context, in *pb.MergeContactsRequest) (*pb.MergeContactsResponse, error) {
+	survivorID, err := resource.DecodeInt64(&pb.Contact{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	survivor, err := pb.DefaultReadContact(ctx, &pb.Contact{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[int64]bool{survivorID: true}
+	merged := []*pb.Contact{}
+	for _, id := range in.GetMergedIds() {
+		mergedID, err := resource.DecodeInt64(&pb.Contact{}, id)
+		if err != nil {
+			return nil, err
+		}
+		if seen[mergedID] {
+			return nil, errors.NewContainer(codes.InvalidArgument, "The contact %d is merged more than once.", mergedID)
+		}
+		seen[mergedID] = true
+		c, err := pb.DefaultReadContact(ctx, &pb.Contact{Id: id}, db)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, c)
+	}
+
+	// the members of smart groups match their member filters, so the union
+	// of the groups of the contacts leaves the smart groups out
+	for _, c := range append([]*pb.Contact{survivor}, merged...) {
+		if c.Groups, err = withoutSmartGroups(db, c.GetGroups()); err != nil {
+			return nil, err
+		}
+	}
+	result := mergeContacts(survivor, merged)
+	if in.GetPrimaryEmail() != "" {
+		found := false
+		for _, e := range result.GetEmails() {
+			if strings.EqualFold(e.GetAddress(), in.GetPrimaryEmail()) {
+				result.PrimaryEmail = e.GetAddress()
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.NewContainer(codes.InvalidArgument, "The primary e-mail address %q is not an address of the merged contacts.", in.GetPrimaryEmail())
+		}
+	}
+
+	// e-mail addresses are unique among the contacts which aren't deleted, so
+	// the merged contacts are deleted before the survivor gets their
+	// addresses. They keep their own ones, undeleting a merged contact fails
+	// while the survivor has one of its addresses.
+	for _, c := range merged {
+		if _, err := s.Delete(ctx, &pb.DeleteContactRequest{Id: c.GetId()}); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.update(ctx, &pb.UpdateContactRequest{Payload: result}, expectedETag(ctx, in.GetEtag()))
+	if err != nil {
+		return nil, err
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return &pb.MergeContactsResponse{Result: res.GetResult()}, nil
+}
+
+// mergeContacts returns the survivor with the properties of the merged
+// contacts, the properties of the survivor take precedence
+func mergeContacts(survivor *pb.Contact, merged []*pb.Contact) *pb.Contact {
+	result := proto.Clone(survivor).(*pb.Contact)
+	// the etag of the update is the one expected by the client
+	result.Etag = ""
+
+	emails := map[string]bool{}
+	for _, e := range result.GetEmails() {
+		emails[strings.ToLower(e.GetAddress())] = true
+	}
+	phones := map[string]bool{}
+	for _, p := range result.GetPhoneNumbers() {
+		phones[p.GetNumber()] = true
+	}
+	groups := map[string]bool{}
+	for _, g := range result.GetGroups() {
+		groups[g.GetId().GetResourceId()] = true
+	}
+	notes := []string{}
+	if result.GetNotes() != "" {
+		notes = append(notes, result.GetNotes())
+	}
+	nicknames := nicknameList(result.GetNicknames())
+
+	for _, c := range merged {
+		if result.FirstName == "" && result.LastName == "" {
+			result.FirstName, result.MiddleName, result.LastName = c.GetFirstName(), c.GetMiddleName(), c.GetLastName()
+		}
+		for _, e := range c.GetEmails() {
+			if !emails[strings.ToLower(e.GetAddress())] {
+				emails[strings.ToLower(e.GetAddress())] = true
+				result.Emails = append(result.Emails, &pb.Email{Address: e.GetAddress()})
+			}
+		}
+		if result.PrimaryEmail == "" {
+			result.PrimaryEmail = c.GetPrimaryEmail()
+		}
+		for _, p := range c.GetPhoneNumbers() {
+			if !phones[p.GetNumber()] {
+				phones[p.GetNumber()] = true
+				result.PhoneNumbers = append(result.PhoneNumbers, &pb.PhoneNumber{Number: p.GetNumber(), Type: p.GetType()})
+			}
+		}
+		if result.PrimaryPhone == "" {
+			result.PrimaryPhone = c.GetPrimaryPhone()
+		}
+		if result.HomeAddress == nil {
+			result.HomeAddress = c.GetHomeAddress()
+		}
+		if result.WorkAddress == nil {
+			result.WorkAddress = c.GetWorkAddress()
+		}
+		if result.ProfileId == nil {
+			result.ProfileId = c.GetProfileId()
+		}
+		for _, g := range c.GetGroups() {
+			if !groups[g.GetId().GetResourceId()] {
+				groups[g.GetId().GetResourceId()] = true
+				result.Groups = append(result.Groups, g)
+			}
+		}
+		if n := c.GetNotes(); n != "" && !containsString(notes, n) {
+			notes = append(notes, n)
+		}
+		for _, n := range nicknameList(c.GetNicknames()) {
+			if !containsString(nicknames, n) {
+				nicknames = append(nicknames, n)
+			}
+		}
+		if result.Nicknames == nil {
+			result.Nicknames = c.GetNicknames()
+		}
+	}
+
+	result.Notes = strings.Join(notes, "\n\n")
+	if len(nicknames) > 0 {
+		data, _ := json.Marshal(nicknames)
+		result.Nicknames = &types.JSONValue{Value: string(data)}
+	}
+	return result
+}
+
+// nicknameList returns the nicknames if they are a JSON array of strings,
+// the nicknames in any other format can't be merged
+func nicknameList(v *types.JSONValue) []string {
+	list := []string{}
+	if v.GetValue() == "" {
+		return list
+	}
+	json.Unmarshal([]byte(v.GetValue()), &list)
+	return list
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/outbox"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// addEvent stores the event of a change made by the request in the outbox.
+// The transaction of the request commits the event together with the change,
+// so the event is published if and only if the change is committed.
+func addEvent(ctx context.Context, eventType string, data proto.Message) error {
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return err
+	}
+	return outbox.Add(ctx, db, eventType, data)
+}
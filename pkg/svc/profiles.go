@@ -0,0 +1,106 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// ListContacts returns the contacts of the profile within the caller's
+// account, the request supports the same collection operators, synthetic
+// fields and page token as Contacts.List. NotFound is returned if there is no
+// such profile.
+func (s *profilesServer) ListContacts(ctx context.Context, in *pb.ListProfileContactsRequest) (*pb.ListContactsResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Profile{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := parentInAccount(ctx, db, &pb.ProfileORM{}, "profile", id); err != nil {
+		return nil, err
+	}
+	page, err := requestedPage(in.GetPaging())
+	if err != nil {
+		return nil, err
+	}
+	res, err := pb.ListContacts(ctx, db.Where("contacts.profile_id = ?", id), &pb.ListContactRequest{
+		Filter:  in.GetFilter(),
+		OrderBy: in.GetOrderBy(),
+		Fields:  in.GetFields(),
+		Paging:  page,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := setPageToken(ctx, page, len(res)); err != nil {
+		return nil, err
+	}
+	return &pb.ListContactsResponse{Results: res}, nil
+}
+
+// ListGroups returns the groups of the profile within the caller's account,
+// the request supports the same collection operators as Groups.List and the
+// page token of Contacts.List. NotFound is returned if there is no such
+// profile.
+func (s *profilesServer) ListGroups(ctx context.Context, in *pb.ListProfileGroupsRequest) (*pb.ListGroupsResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Profile{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := parentInAccount(ctx, db, &pb.ProfileORM{}, "profile", id); err != nil {
+		return nil, err
+	}
+	page, err := requestedPage(in.GetPaging())
+	if err != nil {
+		return nil, err
+	}
+	res, err := pb.DefaultListGroup(ctx, db.Where("profile_id = ?", id), &pb.ListGroupRequest{
+		Filter:  in.GetFilter(),
+		OrderBy: in.GetOrderBy(),
+		Fields:  in.GetFields(),
+		Paging:  page,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := setPageToken(ctx, page, len(res)); err != nil {
+		return nil, err
+	}
+	return &pb.ListGroupsResponse{Results: res}, nil
+}
+
+// CreateContact creates the contact assigned to the profile within the
+// caller's account and stores its event in the outbox. The profile_id of the
+// payload is replaced by the profile of the request, the timestamps and the
+// entity tag provided by the client are ignored.
+func (s *profilesServer) CreateContact(ctx context.Context, in *pb.CreateProfileContactRequest) (*pb.CreateContactResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Profile{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := parentInAccount(ctx, db, &pb.ProfileORM{}, "profile", id); err != nil {
+		return nil, err
+	}
+	payload := in.GetPayload()
+	if payload == nil {
+		payload = &pb.Contact{}
+	}
+	payload.ProfileId = in.GetId()
+	res, err := createContact(ctx, db, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateContactResponse{Result: res}, nil
+}
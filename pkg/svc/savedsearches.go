@@ -0,0 +1,135 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc/codes"
+)
+
+// NewSavedSearchesServer returns an instance of the default saved searches
+// server interface
+func NewSavedSearchesServer() (pb.SavedSearchesServer, error) {
+	return &savedSearchesServer{&pb.SavedSearchesDefaultServer{}}, nil
+}
+
+type savedSearchesServer struct {
+	*pb.SavedSearchesDefaultServer
+}
+
+// Create validates the collection operators and the profile of the saved
+// search and forwards the request to the default implementation.
+func (s *savedSearchesServer) Create(ctx context.Context, in *pb.CreateSavedSearchRequest) (*pb.CreateSavedSearchResponse, error) {
+	if err := validateSavedSearch(ctx, in.GetPayload()); err != nil {
+		return nil, err
+	}
+	return s.SavedSearchesDefaultServer.Create(ctx, in)
+}
+
+// Update validates the collection operators and the profile of the saved
+// search and forwards the request to the default implementation. Unlike the
+// default implementation it returns NotFound if there is no such saved search.
+func (s *savedSearchesServer) Update(ctx context.Context, in *pb.UpdateSavedSearchRequest) (*pb.UpdateSavedSearchResponse, error) {
+	if in.GetPayload() == nil {
+		return s.SavedSearchesDefaultServer.Update(ctx, in)
+	}
+	id, err := resource.DecodeInt64(&pb.SavedSearch{}, in.GetPayload().GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := parentInAccount(ctx, db, &pb.SavedSearchORM{}, "saved search", id); err != nil {
+		return nil, err
+	}
+	if err := validateSavedSearch(ctx, in.GetPayload()); err != nil {
+		return nil, err
+	}
+	return s.SavedSearchesDefaultServer.Update(ctx, in)
+}
+
+// Delete removes the saved search within the caller's account.
+// Unlike the default implementation it returns NotFound if nothing was deleted.
+func (s *savedSearchesServer) Delete(ctx context.Context, in *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchResponse, error) {
+	id, err := resource.DecodeInt64(&pb.SavedSearch{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteInAccount(ctx, db, &pb.SavedSearchORM{}, id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteSavedSearchResponse{}, nil
+}
+
+// RunSavedSearch lists the contacts with the collection operators of the
+// saved search within the caller's account. The request is forwarded to List,
+// so the paging of the request and the page tokens work like the ones of List.
+func (s *contactsServer) RunSavedSearch(ctx context.Context, in *pb.RunSavedSearchRequest) (*pb.ListContactsResponse, error) {
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	search, err := pb.DefaultReadSavedSearch(ctx, &pb.SavedSearch{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	req, err := savedSearchRequest(search)
+	if err != nil {
+		return nil, errors.NewContainer(codes.FailedPrecondition, "Invalid saved search: %v.", err)
+	}
+	req.Paging = in.GetPaging()
+	return s.List(ctx, req)
+}
+
+// validateSavedSearch rejects a saved search with invalid collection
+// operators or with a profile of another account
+func validateSavedSearch(ctx context.Context, ss *pb.SavedSearch) error {
+	if _, err := savedSearchRequest(ss); err != nil {
+		return errors.NewContainer(codes.InvalidArgument, "Invalid collection operators: %v.", err)
+	}
+	if ss.GetProfileId() == nil {
+		return nil
+	}
+	id, err := resource.DecodeInt64(&pb.Profile{}, ss.GetProfileId())
+	if err != nil {
+		return err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return err
+	}
+	return parentInAccount(ctx, db, &pb.ProfileORM{}, "profile", id)
+}
+
+// savedSearchRequest parses the collection operators of the saved search
+// into a request of the list of contacts
+func savedSearchRequest(ss *pb.SavedSearch) (*pb.ListContactRequest, error) {
+	req := &pb.ListContactRequest{}
+	if ss.GetFilter() != "" {
+		f, err := query.ParseFiltering(ss.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+		req.Filter = f
+	}
+	if ss.GetOrderBy() != "" {
+		o, err := query.ParseSorting(ss.GetOrderBy())
+		if err != nil {
+			return nil, err
+		}
+		req.OrderBy = o
+	}
+	if ss.GetFields() != "" {
+		req.Fields = query.ParseFieldSelection(ss.GetFields())
+	}
+	return req, nil
+}
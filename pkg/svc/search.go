@@ -0,0 +1,113 @@
+package svc
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// searchHeadlineOptions are the options of ts_headline for the snippets of
+// search results
+const searchHeadlineOptions = "StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=20, MinWords=5"
+
+// searchMatch is a contact matching the search query
+type searchMatch struct {
+	ID      int64
+	Rank    float32
+	Snippet string
+}
+
+// Search returns the contacts matching the text of the query, the best
+// matches first. The paging and the page token work like the ones of List.
+func (s *contactsServer) Search(ctx context.Context, in *pb.SearchContactsRequest) (*pb.SearchContactsResponse, error) {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	tsquery := searchQuery(in.GetQ())
+	if tsquery == "" {
+		return nil, errors.NewContainer(codes.InvalidArgument, "The search query has no words.")
+	}
+
+	page, err := requestedPage(in.GetPaging())
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matches := []searchMatch{}
+	if err := db.Raw(`SELECT id, ts_rank(search, q) AS rank, ts_headline('simple', contact_search_text(contacts), q, ?) AS snippet
+		FROM contacts, to_tsquery('simple', ?) q
+		WHERE account_id = ? AND deleted_at IS NULL AND search @@ q
+		ORDER BY rank DESC, id
+		OFFSET ? LIMIT ?`,
+		searchHeadlineOptions, tsquery, accountID, page.GetOffset(), page.DefaultLimit(),
+	).Scan(&matches).Error; err != nil {
+		return nil, err
+	}
+
+	res := &pb.SearchContactsResponse{}
+	if len(matches) > 0 {
+		ids := make([]int64, len(matches))
+		for i, m := range matches {
+			ids[i] = m.ID
+		}
+		contacts, err := readContacts(ctx, db, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range matches {
+			res.Results = append(res.Results, &pb.ContactSearchResult{
+				Contact: contacts[m.ID],
+				Rank:    m.Rank,
+				Snippet: m.Snippet,
+			})
+		}
+	}
+
+	if err := setPageToken(ctx, page, len(res.Results)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// readContacts returns the contacts with the ids mapped by their ids
+func readContacts(ctx context.Context, db *gorm.DB, ids []int64) (map[int64]*pb.Contact, error) {
+	contacts, err := pb.DefaultListContact(ctx, db.Where("id IN (?)", ids), &pb.ListContactRequest{})
+	if err != nil {
+		return nil, err
+	}
+	byID := map[int64]*pb.Contact{}
+	for _, c := range contacts {
+		id, err := resource.DecodeInt64(&pb.Contact{}, c.GetId())
+		if err != nil {
+			return nil, err
+		}
+		byID[id] = c
+	}
+	return byID, nil
+}
+
+// searchQuery converts the text of a search query to a tsquery which matches
+// the documents containing all its words as prefixes of their words
+func searchQuery(text string) string {
+	terms := []string{}
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool {
+		// the tsquery operators are separators, the other punctuation is
+		// kept as it is a part of e.g. e-mail addresses
+		return unicode.IsSpace(r) || strings.ContainsRune("&|!():*<>'\\", r)
+	}) {
+		terms = append(terms, "'"+word+"':*")
+	}
+	return strings.Join(terms, " & ")
+}
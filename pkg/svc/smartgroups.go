@@ -0,0 +1,166 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+)
+
+// smartGroupReadLimit is the maximum number of members returned with a smart
+// group by Read, the rest are listed by ListMembers
+const smartGroupReadLimit = 100
+
+// validateGroup rejects a smart group with an invalid member filter or with
+// contacts, the members of a smart group are defined by its filter only. The
+// filter is run once, so a filter by an unknown field is rejected too.
+func validateGroup(ctx context.Context, db *gorm.DB, g *pb.Group) error {
+	if g.GetMemberFilter() == "" {
+		return nil
+	}
+	f, err := query.ParseFiltering(g.GetMemberFilter())
+	if err == nil {
+		_, err = pb.ListContacts(ctx, db, &pb.ListContactRequest{Filter: f, Paging: &query.Pagination{Limit: 1}})
+	}
+	if err != nil {
+		return errors.NewContainer(codes.InvalidArgument, "Invalid member filter: %v.", err).
+			WithField("member_filter", "%v", err)
+	}
+	if len(g.GetContacts()) > 0 {
+		return errors.NewContainer(codes.InvalidArgument, "The contacts of a smart group can't be changed, they match its member filter.")
+	}
+	return nil
+}
+
+// validateContactGroups rejects a contact payload which makes the contact a
+// member of a smart group, the members of a smart group match its filter
+func validateContactGroups(db *gorm.DB, c *pb.Contact) error {
+	smart, err := smartGroups(db, c.GetGroups())
+	if err != nil {
+		return err
+	}
+	if len(smart) > 0 {
+		return errors.NewContainer(codes.FailedPrecondition, "The members of a smart group match its member filter, they can't be added.")
+	}
+	return nil
+}
+
+// smartGroups returns the ids of the smart groups among the groups, the
+// groups without an id are skipped
+func smartGroups(db *gorm.DB, groups []*pb.Group) (map[int64]bool, error) {
+	ids := []int64{}
+	for _, g := range groups {
+		if g.GetId() == nil {
+			continue
+		}
+		id, err := resource.DecodeInt64(&pb.Group{}, g.GetId())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	smart := map[int64]bool{}
+	if len(ids) == 0 {
+		return smart, nil
+	}
+	found := []int64{}
+	if err := db.Model(&pb.GroupORM{}).Where("id IN (?) AND coalesce(member_filter, '') <> ''", ids).
+		Pluck("id", &found).Error; err != nil {
+		return nil, err
+	}
+	for _, id := range found {
+		smart[id] = true
+	}
+	return smart, nil
+}
+
+// withoutSmartGroups returns the groups which aren't smart groups
+func withoutSmartGroups(db *gorm.DB, groups []*pb.Group) ([]*pb.Group, error) {
+	smart, err := smartGroups(db, groups)
+	if err != nil || len(smart) == 0 {
+		return groups, err
+	}
+	res := []*pb.Group{}
+	for _, g := range groups {
+		if g.GetId() != nil {
+			id, err := resource.DecodeInt64(&pb.Group{}, g.GetId())
+			if err != nil {
+				return nil, err
+			}
+			if smart[id] {
+				continue
+			}
+		}
+		res = append(res, g)
+	}
+	return res, nil
+}
+
+// memberFilter returns the member filter of the group within the caller's
+// account, it is empty unless the group is a smart group
+func memberFilter(ctx context.Context, db *gorm.DB, id int64) (string, error) {
+	if err := parentInAccount(ctx, db, &pb.GroupORM{}, "group", id); err != nil {
+		return "", err
+	}
+	filters := []string{}
+	if err := db.Model(&pb.GroupORM{}).Where("id = ?", id).Pluck("coalesce(member_filter, '')", &filters).Error; err != nil {
+		return "", err
+	}
+	if len(filters) == 0 {
+		return "", nil
+	}
+	return filters[0], nil
+}
+
+// smartGroupMembers lists the contacts matching the member filter of a smart
+// group and the collection operators of the request. The filter may use the
+// synthetic fields like the _filter parameter of Contacts.List.
+func smartGroupMembers(ctx context.Context, db *gorm.DB, filter string, in *pb.ListContactRequest) ([]*pb.Contact, error) {
+	f, err := query.ParseFiltering(filter)
+	if err != nil {
+		return nil, errors.NewContainer(codes.FailedPrecondition, "Invalid member filter of the smart group: %v.", err)
+	}
+	return pb.ListContacts(ctx, db, &pb.ListContactRequest{
+		Filter:  andFiltering(f, in.GetFilter()),
+		OrderBy: in.GetOrderBy(),
+		Fields:  in.GetFields(),
+		Paging:  in.GetPaging(),
+	})
+}
+
+// andFiltering returns the filtering which matches the records matching
+// both filterings, either of them may be empty
+func andFiltering(a, b *query.Filtering) *query.Filtering {
+	left, right := filteringRoot(a), filteringRoot(b)
+	if left == nil {
+		return b
+	}
+	if right == nil {
+		return a
+	}
+	op := &query.LogicalOperator{Type: query.LogicalOperator_AND}
+	op.SetLeft(left)
+	op.SetRight(right)
+	f := &query.Filtering{}
+	f.SetRoot(op)
+	return f
+}
+
+// filteringRoot returns the root condition or operator of the filtering
+func filteringRoot(f *query.Filtering) interface{} {
+	switch root := f.GetRoot().(type) {
+	case *query.Filtering_Operator:
+		return root.Operator
+	case *query.Filtering_StringCondition:
+		return root.StringCondition
+	case *query.Filtering_NumberCondition:
+		return root.NumberCondition
+	case *query.Filtering_NullCondition:
+		return root.NullCondition
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+const (
+	// SMSStatusQueued means the message was accepted by the delivery backend
+	// but it is not known yet whether it reached the recipient
+	SMSStatusQueued = "QUEUED"
+	// SMSStatusSent means the message was handed over to the recipient
+	SMSStatusSent = "SENT"
+)
+
+// SMSMessage is a text message addressed to a single contact
+type SMSMessage struct {
+	AccountID string
+	Contact   *pb.Contact
+	// To is the recipient phone number in E.164 format
+	To   string
+	Text string
+}
+
+// SMSDelivery describes the outcome of a message delivery
+type SMSDelivery struct {
+	ID     string
+	Status string
+}
+
+// SMSSender is the interface implemented by SMS delivery backends
+type SMSSender interface {
+	Send(ctx context.Context, msg *SMSMessage) (*SMSDelivery, error)
+}
+
+// NewLocalSMSSender returns an SMSSender which doesn't deliver anything but
+// writes every message as a single line to w. It is meant for local
+// development and testing.
+func NewLocalSMSSender(w io.Writer) SMSSender {
+	return &localSMSSender{w: w}
+}
+
+type localSMSSender struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// Send writes the message to the underlying writer
+func (s *localSMSSender) Send(ctx context.Context, msg *SMSMessage) (*SMSDelivery, error) {
+	id, err := newDeliveryID()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = fmt.Fprintf(s.w, "%s sms delivery=%s account=%s contact=%s to=%s message=%q\n",
+		time.Now().UTC().Format(time.RFC3339), id, msg.AccountID, msg.Contact.GetId().GetResourceId(), msg.To, msg.Text,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSDelivery{ID: id, Status: SMSStatusSent}, nil
+}
+
+// NewWebhookSMSSender returns an SMSSender which posts every message as JSON
+// to the given URL. If client is nil http.DefaultClient is used.
+func NewWebhookSMSSender(url string, client *http.Client) SMSSender {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &webhookSMSSender{url: url, client: client}
+}
+
+type webhookSMSSender struct {
+	url    string
+	client *http.Client
+}
+
+type webhookSMSRequest struct {
+	ID        string `json:"id"`
+	AccountID string `json:"account_id"`
+	ContactID string `json:"contact_id"`
+	To        string `json:"to"`
+	Message   string `json:"message"`
+}
+
+type webhookSMSResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// Send posts the message to the webhook. The webhook may respond with its
+// own delivery id and status, otherwise the message is reported as queued.
+func (s *webhookSMSSender) Send(ctx context.Context, msg *SMSMessage) (*SMSDelivery, error) {
+	id, err := newDeliveryID()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&webhookSMSRequest{
+		ID:        id,
+		AccountID: msg.AccountID,
+		ContactID: msg.Contact.GetId().GetResourceId(),
+		To:        msg.To,
+		Message:   msg.Text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("sms webhook responded with status %d", resp.StatusCode)
+	}
+
+	delivery := &SMSDelivery{ID: id, Status: SMSStatusQueued}
+
+	var wr webhookSMSResponse
+	if err := json.NewDecoder(resp.Body).Decode(&wr); err == nil {
+		if wr.ID != "" {
+			delivery.ID = wr.ID
+		}
+		if wr.Status != "" {
+			delivery.Status = wr.Status
+		}
+	}
+
+	return delivery, nil
+}
+
+// newDeliveryID generates a random delivery identifier
+func newDeliveryID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,74 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+)
+
+// DefaultSuggestLimit is the number of suggestions returned by Suggest if the
+// request doesn't specify the limit
+const DefaultSuggestLimit = 10
+
+// suggestMatch is a contact similar to the text of a Suggest request
+type suggestMatch struct {
+	ID         int64
+	Similarity float32
+}
+
+// Suggest returns the contacts whose name, nickname or primary e-mail address
+// contains a word similar to the text, e.g. its prefix or its misspelling.
+// The contacts are ranked by the trigram similarity of the best matching word.
+func (s *contactsServer) Suggest(ctx context.Context, in *pb.SuggestContactsRequest) (*pb.SuggestContactsResponse, error) {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(in.GetLimit())
+	if limit == 0 {
+		limit = DefaultSuggestLimit
+	}
+
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the <% operator uses the trigram indexes, word_similarity returns the
+	// similarity the operator compares to its threshold
+	matches := []suggestMatch{}
+	if err := db.Raw(`SELECT id, GREATEST(
+			word_similarity(q, contact_name(first_name, middle_name, last_name)),
+			word_similarity(q, coalesce(contact_nicknames(nicknames), '')),
+			coalesce((SELECT max(word_similarity(q, address)) FROM emails WHERE contact_id = contacts.id AND is_primary), 0)
+		) AS similarity
+		FROM contacts, (SELECT ?::text AS q) params
+		WHERE account_id = ? AND deleted_at IS NULL AND (
+			q <% contact_name(first_name, middle_name, last_name) OR
+			q <% contact_nicknames(nicknames) OR
+			EXISTS (SELECT 1 FROM emails WHERE contact_id = contacts.id AND is_primary AND q <% address)
+		)
+		ORDER BY similarity DESC, id
+		LIMIT ?`,
+		in.GetQ(), accountID, limit,
+	).Scan(&matches).Error; err != nil {
+		return nil, err
+	}
+
+	res := &pb.SuggestContactsResponse{}
+	if len(matches) == 0 {
+		return res, nil
+	}
+	ids := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	contacts, err := readContacts(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		res.Results = append(res.Results, &pb.ContactSuggestion{Contact: contacts[m.ID], Similarity: m.Similarity})
+	}
+	return res, nil
+}
@@ -0,0 +1,174 @@
+package svc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"google.golang.org/grpc/codes"
+)
+
+// DefaultSyncLimit is the number of changes returned by Sync if the request
+// doesn't specify the limit
+const DefaultSyncLimit = 100
+
+// changedAt is the SQL expression for the time of the last change of a row,
+// a deleted row is changed when it is marked as deleted
+const changedAt = "GREATEST(created_at, updated_at, deleted_at)"
+
+// syncHorizonSQL returns the start of the oldest transaction which is still
+// running, including the one of the request. The rows it changes and the rows
+// changed by later transactions are changed at or after this time, so a row
+// changed before it is never committed after it is read. The database role
+// of the service must be able to see the transactions of the other sessions.
+const syncHorizonSQL = "SELECT min(xact_start) FROM pg_stat_activity WHERE datname = current_database()"
+
+// syncCursor is a position in the history of changes of the contacts within
+// an account. The changes are ordered by time and contact id.
+type syncCursor struct {
+	ChangedAt time.Time
+	ID        int64
+	// IssuedAt is the time the position was read at, the deleted contacts
+	// which follow it are purged after the retention period
+	IssuedAt time.Time
+}
+
+// syncChange is a change of a single contact
+type syncChange struct {
+	ID        int64
+	ChangedAt time.Time
+	Deleted   bool
+}
+
+// Sync returns the contacts created, updated or deleted since the position
+// encoded in the sync token. Without a token all the contacts are returned.
+// The changes of the transactions which are still running are returned once
+// they finish, so a change committed late is never skipped. A token older
+// than the retention period of deleted records is rejected with
+// FailedPrecondition as the deletions it precedes might be purged.
+func (s *contactsServer) Sync(ctx context.Context, in *pb.SyncContactsRequest) (*pb.SyncContactsResponse, error) {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(in.GetLimit())
+	if limit == 0 {
+		limit = DefaultSyncLimit
+	}
+
+	cursor := syncCursor{ChangedAt: time.Unix(0, 0)}
+	if in.GetSyncToken() != "" {
+		if cursor, err = decodeSyncToken(in.GetSyncToken()); err != nil {
+			return nil, err
+		}
+		if s.syncRetention > 0 && time.Since(cursor.IssuedAt) > s.syncRetention {
+			return nil, resyncRequired()
+		}
+	}
+
+	tx, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cursor.IssuedAt = time.Now()
+	var horizon time.Time
+	if err := tx.Raw(syncHorizonSQL).Row().Scan(&horizon); err != nil {
+		return nil, err
+	}
+	db := tx.Unscoped().Model(&pb.ContactORM{}).
+		Select(fmt.Sprintf("id, %s AS changed_at, deleted_at IS NOT NULL AS deleted", changedAt)).
+		Where("account_id = ?", accountID).
+		Where(fmt.Sprintf("%s < ?", changedAt), horizon)
+
+	if in.GetCurrent() {
+		// the position of the last change of the finished transactions
+		changes := []syncChange{}
+		if err := db.Order(changedAt + " DESC").Order("id DESC").Limit(1).Scan(&changes).Error; err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			cursor.ChangedAt, cursor.ID = changes[0].ChangedAt, changes[0].ID
+		}
+		return &pb.SyncContactsResponse{SyncToken: encodeSyncToken(cursor)}, nil
+	}
+
+	db = db.Where(fmt.Sprintf("(%s, id) > (?, ?)", changedAt), cursor.ChangedAt, cursor.ID)
+	if in.GetSyncToken() == "" {
+		// a client without a token doesn't have any contact to delete
+		db = db.Where("deleted_at IS NULL")
+	}
+	changes := []syncChange{}
+	if err := db.Order(changedAt).Order("id").Limit(limit + 1).Scan(&changes).Error; err != nil {
+		return nil, err
+	}
+
+	res := &pb.SyncContactsResponse{}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		res.HasMore = true
+	}
+
+	ids := []int64{}
+	for _, c := range changes {
+		if !c.Deleted {
+			ids = append(ids, c.ID)
+			continue
+		}
+		id, err := resource.Encode(&pb.Contact{}, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		res.Deleted = append(res.Deleted, id)
+	}
+	if len(ids) > 0 {
+		if res.Results, err = pb.DefaultListContact(ctx, tx.Where("id IN (?)", ids), &pb.ListContactRequest{}); err != nil {
+			return nil, err
+		}
+	}
+
+	if n := len(changes); n > 0 {
+		cursor.ChangedAt, cursor.ID = changes[n-1].ChangedAt, changes[n-1].ID
+	}
+	res.SyncToken = encodeSyncToken(cursor)
+	return res, nil
+}
+
+// resyncRequired rejects the sync tokens which can't be continued, the
+// client has to sync from scratch without a token
+func resyncRequired() error {
+	return errors.NewContainer(codes.FailedPrecondition, "The sync token has expired, a full resync without the token is required.")
+}
+
+// decodeSyncToken decodes the sync token from the user's request.
+// Returns an error if the token is malformed.
+func decodeSyncToken(token string) (syncCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return syncCursor{}, errors.NewContainer(codes.InvalidArgument, "Invalid sync token %q.", token)
+	}
+	vals := strings.Split(string(data), ":")
+	if len(vals) != 3 {
+		return syncCursor{}, errors.NewContainer(codes.InvalidArgument, "Malformed sync token.")
+	}
+	nums := make([]int64, len(vals))
+	for i, val := range vals {
+		if nums[i], err = strconv.ParseInt(val, 10, 64); err != nil {
+			return syncCursor{}, errors.NewContainer(codes.InvalidArgument, "Malformed sync token.")
+		}
+	}
+	return syncCursor{ChangedAt: time.Unix(0, nums[0]), ID: nums[1], IssuedAt: time.Unix(0, nums[2])}, nil
+}
+
+// encodeSyncToken encodes the position of the last returned change to a string
+// in application specific format (nanoseconds:id:issued_at) in base64 encoding.
+func encodeSyncToken(cursor syncCursor) string {
+	data := fmt.Sprintf("%d:%d:%d", cursor.ChangedAt.UnixNano(), cursor.ID, cursor.IssuedAt.UnixNano())
+	return base64.RawURLEncoding.EncodeToString([]byte(data))
+}
@@ -0,0 +1,40 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/vcard"
+	"google.golang.org/grpc/codes"
+)
+
+// ReadVCard returns the contact within the caller's account as a vCard with
+// the entity tag of the contact in the response header
+func (s *contactsServer) ReadVCard(ctx context.Context, in *pb.ReadVCardRequest) (*pb.FileChunk, error) {
+	version, err := vcardVersion(in.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.Read(ctx, &pb.ReadContactRequest{Id: in.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := vcard.NewWriter(&buf).Write(vcard.FromContact(res.GetResult(), version), version); err != nil {
+		return nil, err
+	}
+	return &pb.FileChunk{ContentType: vcard.ContentType, Data: buf.Bytes()}, nil
+}
+
+// vcardVersion returns the requested vCard version, 4.0 by default
+func vcardVersion(version string) (string, error) {
+	switch version {
+	case "":
+		return vcard.Version4, nil
+	case vcard.Version3, vcard.Version4:
+		return version, nil
+	}
+	return "", errors.NewContainer(codes.InvalidArgument, "Unsupported vCard version %q.", version)
+}
@@ -0,0 +1,280 @@
+package svc
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/outbox"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ContactEventsChannel is the Postgres notification channel on which the
+	// database announces new contact events, the payload is the account id
+	ContactEventsChannel = "contact_events"
+	// LastEventIDHeader is the request metadata key of the id of the last
+	// event received by a reconnecting watcher. The gateway maps the
+	// Last-Event-ID header of server-sent events to it.
+	LastEventIDHeader = "last-event-id"
+)
+
+var (
+	// watchBatchSize is the maximum number of events read at once
+	watchBatchSize = 100
+	// watchPollInterval is the interval the events are read at even if no
+	// notification arrives, it covers notifications lost on reconnects
+	watchPollInterval = 30 * time.Second
+	// watchRetryInterval is the interval the events are read at while the
+	// committed events wait for an older transaction to finish
+	watchRetryInterval = time.Second
+)
+
+// contactEvent is a row of the contact_events table filled by triggers
+type contactEvent struct {
+	ID        int64
+	AccountID string
+	ContactID int64
+	Type      string
+	// TxID is the id of the transaction which stored the event
+	TxID      int64 `gorm:"column:txid"`
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the table of contact events
+func (contactEvent) TableName() string {
+	return "contact_events"
+}
+
+// ContactEvents wakes up the watchers of contacts when the database notifies
+// about new events of their account
+type ContactEvents struct {
+	mu       sync.Mutex
+	watchers map[string]map[chan struct{}]bool
+}
+
+// NewContactEvents returns ContactEvents without any watcher
+func NewContactEvents() *ContactEvents {
+	return &ContactEvents{watchers: map[string]map[chan struct{}]bool{}}
+}
+
+// Listen forwards the notifications received by l to the watchers until ctx
+// is done
+func (e *ContactEvents) Listen(ctx context.Context, l *pq.Listener) error {
+	if err := l.Listen(ContactEventsChannel); err != nil {
+		return err
+	}
+	defer l.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-l.Notify:
+			if n == nil {
+				// the connection was re-established, notifications might be lost
+				e.notifyAll()
+				continue
+			}
+			e.notify(n.Extra)
+		case <-time.After(90 * time.Second):
+			// check the connection which has been idle for a while
+			go l.Ping()
+		}
+	}
+}
+
+// subscribe registers a watcher of the account, the returned channel receives
+// a value when there are new events. The returned function unregisters it.
+func (e *ContactEvents) subscribe(accountID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.watchers[accountID] == nil {
+		e.watchers[accountID] = map[chan struct{}]bool{}
+	}
+	e.watchers[accountID][ch] = true
+
+	return ch, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		delete(e.watchers[accountID], ch)
+		if len(e.watchers[accountID]) == 0 {
+			delete(e.watchers, accountID)
+		}
+	}
+}
+
+func (e *ContactEvents) notify(accountID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for ch := range e.watchers[accountID] {
+		wakeup(ch)
+	}
+}
+
+func (e *ContactEvents) notifyAll() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, watchers := range e.watchers {
+		for ch := range watchers {
+			wakeup(ch)
+		}
+	}
+}
+
+// wakeup signals ch without blocking, a pending signal is enough to make the
+// watcher read all the new events
+func wakeup(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// Watch streams the events of the contacts within the caller's account until
+// the client goes away. The events which follow the last event id from the
+// request or from the Last-Event-ID header are sent first. The events are
+// sent once their transaction and all the older ones have finished, so an
+// event committed late is never skipped.
+func (s *contactsServer) Watch(in *pb.WatchContactsRequest, stream pb.Contacts_WatchServer) error {
+	ctx := stream.Context()
+	if s.events == nil {
+		return errors.NewContainer(codes.Unimplemented, "Watching contacts is not enabled.")
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	lastID, err := lastEventID(ctx, in.GetLastEventId())
+	if err != nil {
+		return err
+	}
+	// the events are sent in the order of the transactions which stored them,
+	// the position is the last sent event and its transaction
+	var lastTxID int64
+	if lastID == 0 {
+		// only the events of the transactions which finish from now on are sent
+		if err := s.db.Raw("SELECT txid_snapshot_xmin(txid_current_snapshot())").Row().Scan(&lastTxID); err != nil {
+			return err
+		}
+	} else {
+		var last contactEvent
+		err := s.db.Where("account_id = ? AND id = ?", accountID, lastID).First(&last).Error
+		if err == gorm.ErrRecordNotFound {
+			// the event was purged or never existed, the position is lost
+			return errors.NewContainer(codes.FailedPrecondition, "Unknown last event id %d, watch without it.", lastID)
+		}
+		if err != nil {
+			return err
+		}
+		lastTxID = last.TxID
+	}
+
+	// subscribe before reading the events so no notification is missed
+	notifications, unsubscribe := s.events.subscribe(accountID)
+	defer unsubscribe()
+
+	for {
+		events := []contactEvent{}
+		if err := s.db.Where("account_id = ? AND (txid, id) > (?, ?)", accountID, lastTxID, lastID).
+			Where(outbox.FinishedTransactions).
+			Order("txid").Order("id").Limit(watchBatchSize).Find(&events).Error; err != nil {
+			return err
+		}
+		for _, e := range events {
+			res, err := s.contactEventToPB(ctx, &e)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			lastTxID, lastID = e.TxID, e.ID
+		}
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		// the committed events held back by an older running transaction are
+		// read again shortly, the transaction might not notify the account
+		interval := watchPollInterval
+		var pending int
+		if err := s.db.Model(&contactEvent{}).Where("account_id = ? AND (txid, id) > (?, ?)", accountID, lastTxID, lastID).
+			Not(outbox.FinishedTransactions).Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			interval = watchRetryInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notifications:
+		case <-time.After(interval):
+		}
+	}
+}
+
+// contactEventToPB converts the event to its API representation with the
+// current state of the contact
+func (s *contactsServer) contactEventToPB(ctx context.Context, e *contactEvent) (*pb.ContactEvent, error) {
+	id, err := resource.Encode(&pb.Contact{}, e.ContactID)
+	if err != nil {
+		return nil, err
+	}
+	created, err := ptypes.TimestampProto(e.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.ContactEvent{
+		Id:        e.ID,
+		Type:      pb.ContactEvent_Type(pb.ContactEvent_Type_value[e.Type]),
+		Contact:   &pb.Contact{Id: id},
+		CreatedAt: created,
+	}
+	if res.Type == pb.ContactEvent_DELETED {
+		return res, nil
+	}
+
+	contact, err := pb.DefaultReadContact(ctx, &pb.Contact{Id: id}, s.db)
+	if err == gorm.ErrRecordNotFound {
+		// the contact was deleted since, a DELETED event follows
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	res.Contact = contact
+	return res, nil
+}
+
+// lastEventID returns the id of the last event received by the client, the
+// Last-Event-ID header takes precedence over the id from the request
+func lastEventID(ctx context.Context, requestID int64) (int64, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md[LastEventIDHeader]; len(vals) > 0 && vals[0] != "" {
+			id, err := strconv.ParseInt(vals[0], 10, 64)
+			if err != nil || id < 0 {
+				return 0, errors.NewContainer(codes.InvalidArgument, "Invalid last event id %q.", vals[0])
+			}
+			return id, nil
+		}
+	}
+	if requestID < 0 {
+		return 0, errors.NewContainer(codes.InvalidArgument, "Invalid last event id %d.", requestID)
+	}
+	return requestID, nil
+}
@@ -0,0 +1,97 @@
+package svc
+
+import (
+	"context"
+
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+	"github.com/infobloxopen/atlas-app-toolkit/errors"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/webhook"
+	"google.golang.org/grpc/codes"
+)
+
+// NewWebhooksServer returns an instance of the default webhooks server interface
+func NewWebhooksServer() (pb.WebhooksServer, error) {
+	return &webhooksServer{&pb.WebhooksDefaultServer{}}, nil
+}
+
+type webhooksServer struct {
+	*pb.WebhooksDefaultServer
+}
+
+// validateSubscription rejects the urls which aren't absolute http or https
+// urls, the addresses they resolve to are checked when they are dialed
+func validateSubscription(sub *pb.WebhookSubscription) error {
+	if err := webhook.ValidateURL(sub.GetUrl()); err != nil {
+		return errors.NewContainer(codes.InvalidArgument, "Invalid webhook url: %v.", err).
+			WithField("url", "%v", err)
+	}
+	return nil
+}
+
+// Create generates the secret of the subscription unless the client provides
+// one. The secret is returned only in the response of Create.
+func (s *webhooksServer) Create(ctx context.Context, in *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	if err := validateSubscription(in.GetPayload()); err != nil {
+		return nil, err
+	}
+	if in.GetPayload() != nil && in.GetPayload().GetSecret() == "" {
+		secret, err := webhook.NewSecret()
+		if err != nil {
+			return nil, err
+		}
+		in.Payload.Secret = secret
+	}
+	res, err := s.WebhooksDefaultServer.Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	res.Result.Secret = in.GetPayload().GetSecret()
+	return res, nil
+}
+
+// Update keeps the secret of the subscription if the client doesn't provide
+// a new one
+func (s *webhooksServer) Update(ctx context.Context, in *pb.UpdateWebhookSubscriptionRequest) (*pb.UpdateWebhookSubscriptionResponse, error) {
+	if err := validateSubscription(in.GetPayload()); err != nil {
+		return nil, err
+	}
+	if in.GetPayload() != nil && in.GetPayload().GetSecret() == "" {
+		id, err := resource.DecodeInt64(&pb.WebhookSubscription{}, in.GetPayload().GetId())
+		if err != nil {
+			return nil, err
+		}
+		accountID, err := auth.GetAccountID(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		db, err := pb.RequestDB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sub := pb.WebhookSubscriptionORM{}
+		if err := db.Where("account_id = ? AND id = ?", accountID, id).First(&sub).Error; err != nil {
+			return nil, err
+		}
+		in.Payload.Secret = sub.Secret
+	}
+	return s.WebhooksDefaultServer.Update(ctx, in)
+}
+
+// Delete removes the subscription within the caller's account.
+// Unlike the default implementation it returns NotFound if nothing was deleted.
+func (s *webhooksServer) Delete(ctx context.Context, in *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	id, err := resource.DecodeInt64(&pb.WebhookSubscription{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteInAccount(ctx, db, &pb.WebhookSubscriptionORM{}, id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteWebhookSubscriptionResponse{}, nil
+}
@@ -12,44 +12,489 @@ import (
 	"strconv"
 
 	"fmt"
+	"time"
 
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
 	"github.com/infobloxopen/atlas-app-toolkit/errors"
 	"github.com/infobloxopen/atlas-app-toolkit/gateway"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
 	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/outbox"
 	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 )
 
 // NewProfilesServer returns an instance of the default profiles server interface
-func NewProfilesServer(database *gorm.DB) (pb.ProfilesServer, error) {
-	return &profilesServer{&pb.ProfilesDefaultServer{DB: database}}, nil
+func NewProfilesServer() (pb.ProfilesServer, error) {
+	return &profilesServer{&pb.ProfilesDefaultServer{}}, nil
 }
 
 type profilesServer struct {
 	*pb.ProfilesDefaultServer
 }
 
+// Create forwards the request to the default implementation and stores the
+// event of the new profile in the outbox.
+func (s *profilesServer) Create(ctx context.Context, in *pb.CreateProfileRequest) (*pb.CreateProfileResponse, error) {
+	res, err := s.ProfilesDefaultServer.Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "profile.created", res.GetResult()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Read returns the profile with its entity tag in the response header.
+func (s *profilesServer) Read(ctx context.Context, in *pb.ReadProfileRequest) (*pb.ReadProfileResponse, error) {
+	res, err := s.ProfilesDefaultServer.Read(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Update increments the version of the profile and forwards the request to
+// the default implementation. If the client provides the expected entity tag
+// in the If-Match header or in the etag field and it is outdated the update
+// is rejected with FailedPrecondition.
+func (s *profilesServer) Update(ctx context.Context, in *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	if in.GetPayload() == nil {
+		return s.ProfilesDefaultServer.Update(ctx, in)
+	}
+	id, err := resource.DecodeInt64(&pb.Profile{}, in.GetPayload().GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rev, err := nextRevision(ctx, db, &pb.ProfileORM{}, id, expectedETag(ctx, in.GetPayload().GetEtag()))
+	if err != nil {
+		return nil, err
+	}
+	in.Payload.Etag = rev.ETag
+	in.Payload.CreatedAt = rev.CreatedAt
+	res, err := s.ProfilesDefaultServer.Update(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "profile.updated", res.GetResult()); err != nil {
+		return nil, err
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete marks the profile within the caller's account as deleted.
+// Unlike the default implementation it returns NotFound if nothing was deleted.
+func (s *profilesServer) Delete(ctx context.Context, in *pb.DeleteProfileRequest) (*pb.DeleteProfileResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Profile{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteInAccount(ctx, db, &pb.ProfileORM{}, id); err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "profile.deleted", in); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteProfileResponse{}, nil
+}
+
+// Undelete restores the deleted profile within the caller's account together
+// with all of its child rows.
+func (s *profilesServer) Undelete(ctx context.Context, in *pb.UndeleteProfileRequest) (*pb.UndeleteProfileResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Profile{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := undeleteInAccount(ctx, db, &pb.ProfileORM{}, id); err != nil {
+		return nil, err
+	}
+	res, err := pb.DefaultReadProfile(ctx, &pb.Profile{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "profile.undeleted", res); err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteProfileResponse{Result: res}, nil
+}
+
 // NewGroupsServer returns an instance of the default groups server interface
-func NewGroupsServer(database *gorm.DB) (pb.GroupsServer, error) {
-	return &groupsServer{&pb.GroupsDefaultServer{DB: database}}, nil
+func NewGroupsServer() (pb.GroupsServer, error) {
+	return &groupsServer{&pb.GroupsDefaultServer{}}, nil
 }
 
 type groupsServer struct {
 	*pb.GroupsDefaultServer
 }
 
-// NewContactsServer returns an instance of the default contacts server interface
-func NewContactsServer(database *gorm.DB) (pb.ContactsServer, error) {
-	return &contactsServer{&pb.ContactsDefaultServer{DB: database}}, nil
+// Create forwards the request to the default implementation and stores the
+// event of the new group in the outbox.
+func (s *groupsServer) Create(ctx context.Context, in *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGroup(ctx, db, in.GetPayload()); err != nil {
+		return nil, err
+	}
+	res, err := s.GroupsDefaultServer.Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "group.created", res.GetResult()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Read returns the group with its entity tag in the response header.
+// The contacts of a smart group are the first ones matching its member
+// filter, all of them are listed by ListMembers.
+func (s *groupsServer) Read(ctx context.Context, in *pb.ReadGroupRequest) (*pb.ReadGroupResponse, error) {
+	res, err := s.GroupsDefaultServer.Read(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if filter := res.GetResult().GetMemberFilter(); filter != "" {
+		db, err := pb.RequestDB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res.Result.Contacts, err = smartGroupMembers(ctx, db, filter, &pb.ListContactRequest{
+			Paging: &query.Pagination{Limit: smartGroupReadLimit},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Update increments the version of the group and forwards the request to
+// the default implementation. If the client provides the expected entity tag
+// in the If-Match header or in the etag field and it is outdated the update
+// is rejected with FailedPrecondition. The contacts of a smart group are
+// ignored, so a group returned by Read can be updated as is.
+func (s *groupsServer) Update(ctx context.Context, in *pb.UpdateGroupRequest) (*pb.UpdateGroupResponse, error) {
+	if in.GetPayload() == nil {
+		return s.GroupsDefaultServer.Update(ctx, in)
+	}
+	id, err := resource.DecodeInt64(&pb.Group{}, in.GetPayload().GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetPayload().GetMemberFilter() != "" {
+		in.Payload.Contacts = nil
+	}
+	if err := validateGroup(ctx, db, in.GetPayload()); err != nil {
+		return nil, err
+	}
+	rev, err := nextRevision(ctx, db, &pb.GroupORM{}, id, expectedETag(ctx, in.GetPayload().GetEtag()))
+	if err != nil {
+		return nil, err
+	}
+	if in.GetPayload().GetMemberFilter() != "" {
+		// the members of a former manual group are replaced by the filter
+		if err := db.Exec("DELETE FROM group_contacts WHERE group_id = ?", id).Error; err != nil {
+			return nil, err
+		}
+	}
+	in.Payload.Etag = rev.ETag
+	in.Payload.CreatedAt = rev.CreatedAt
+	res, err := s.GroupsDefaultServer.Update(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "group.updated", res.GetResult()); err != nil {
+		return nil, err
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete marks the group within the caller's account as deleted.
+// Unlike the default implementation it returns NotFound if nothing was deleted.
+func (s *groupsServer) Delete(ctx context.Context, in *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Group{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteInAccount(ctx, db, &pb.GroupORM{}, id); err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "group.deleted", in); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGroupResponse{}, nil
+}
+
+// Undelete restores the deleted group within the caller's account together
+// with all of its child rows.
+func (s *groupsServer) Undelete(ctx context.Context, in *pb.UndeleteGroupRequest) (*pb.UndeleteGroupResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Group{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := undeleteInAccount(ctx, db, &pb.GroupORM{}, id); err != nil {
+		return nil, err
+	}
+	res, err := pb.DefaultReadGroup(ctx, &pb.Group{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "group.undeleted", res); err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteGroupResponse{Result: res}, nil
+}
+
+// NewContactsServer returns an instance of the default contacts server interface.
+// The unary calls use the transaction of the request, database is used by Watch
+// which is unavailable if events is nil. The sync tokens older than
+// syncRetention are rejected.
+func NewContactsServer(database *gorm.DB, sender SMSSender, events *ContactEvents, syncRetention time.Duration) (pb.ContactsServer, error) {
+	if sender == nil {
+		return nil, fmt.Errorf("SMS sender is required")
+	}
+	return &contactsServer{&pb.ContactsDefaultServer{}, database, sender, events, syncRetention}, nil
 }
 
 type contactsServer struct {
 	*pb.ContactsDefaultServer
+	// db is used by the streaming calls which don't run in a transaction and
+	// by the calls which mustn't hold the request transaction open
+	db     *gorm.DB
+	sender SMSSender
+	events *ContactEvents
+	// syncRetention is the age of the sync tokens which are rejected because
+	// the changes they precede might be purged, zero means they never expire
+	syncRetention time.Duration
+}
+
+// Create creates the contact in the request transaction and stores its
+// event in the outbox. The groups of the payload can't be smart groups.
+func (s *contactsServer) Create(ctx context.Context, in *pb.CreateContactRequest) (*pb.CreateContactResponse, error) {
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := createContact(ctx, db, in.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateContactResponse{Result: res}, nil
+}
+
+// createContact creates the contact in db and stores its event in the
+// outbox. The timestamps and the entity tag provided by the client are
+// dropped and the groups of the contact can't be smart groups.
+func createContact(ctx context.Context, db *gorm.DB, payload *pb.Contact) (*pb.Contact, error) {
+	payload.ClearServerFields()
+	if err := validateContactGroups(db, payload); err != nil {
+		return nil, err
+	}
+	res, err := pb.DefaultCreateContact(ctx, payload, db)
+	if err != nil {
+		return nil, err
+	}
+	if err := outbox.Add(ctx, db, "contact.created", res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Read returns the contact with its entity tag in the response header.
+func (s *contactsServer) Read(ctx context.Context, in *pb.ReadContactRequest) (*pb.ReadContactResponse, error) {
+	res, err := s.ContactsDefaultServer.Read(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Update increments the version of the contact and forwards the request to
+// the default implementation. If the client provides the expected entity tag
+// in the If-Match header or in the etag field and it is outdated the update
+// is rejected with FailedPrecondition.
+func (s *contactsServer) Update(ctx context.Context, in *pb.UpdateContactRequest) (*pb.UpdateContactResponse, error) {
+	if in.GetPayload() == nil {
+		return s.ContactsDefaultServer.Update(ctx, in)
+	}
+	res, err := s.update(ctx, in, expectedETag(ctx, in.GetPayload().GetEtag()))
+	if err != nil {
+		return nil, err
+	}
+	if err := setETag(ctx, res.GetResult().GetEtag()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// update updates the contact if its entity tag matches etag, an empty etag
+// matches any version. The groups of the payload can't be smart groups.
+func (s *contactsServer) update(ctx context.Context, in *pb.UpdateContactRequest, etag string) (*pb.UpdateContactResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Contact{}, in.GetPayload().GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateContactGroups(db, in.GetPayload()); err != nil {
+		return nil, err
+	}
+	rev, err := nextRevision(ctx, db, &pb.ContactORM{}, id, etag)
+	if err != nil {
+		return nil, err
+	}
+	in.Payload.Etag = rev.ETag
+	in.Payload.CreatedAt = rev.CreatedAt
+	// the CardDAV clients find the contact by its resource name, so it's kept
+	if err := db.Model(&pb.ContactORM{}).Where("id = ?", id).Select("carddav_name").Row().Scan(&in.Payload.CarddavName); err != nil {
+		return nil, err
+	}
+	res, err := s.ContactsDefaultServer.Update(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "contact.updated", res.GetResult()); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Delete marks the contact within the caller's account as deleted.
+// Unlike the default implementation it returns NotFound if nothing was deleted.
+func (s *contactsServer) Delete(ctx context.Context, in *pb.DeleteContactRequest) (*pb.DeleteContactResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Contact{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteInAccount(ctx, db, &pb.ContactORM{}, id); err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "contact.deleted", in); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteContactResponse{}, nil
+}
+
+// Undelete restores the deleted contact within the caller's account together
+// with all of its child rows. The e-mail addresses of deleted contacts can be
+// used by other contacts, so it fails with AlreadyExists if an address or the
+// CardDAV name of the contact has been taken meanwhile.
+func (s *contactsServer) Undelete(ctx context.Context, in *pb.UndeleteContactRequest) (*pb.UndeleteContactResponse, error) {
+	id, err := resource.DecodeInt64(&pb.Contact{}, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := undeleteInAccount(ctx, db, &pb.ContactORM{}, id); err != nil {
+		return nil, err
+	}
+	res, err := pb.DefaultReadContact(ctx, &pb.Contact{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	if err := addEvent(ctx, "contact.undeleted", res); err != nil {
+		return nil, err
+	}
+	return &pb.UndeleteContactResponse{Result: res}, nil
 }
 
-// List wraps default ContactsDefaultServer.List implementation by adding
-// application specific page token implementation.
+// SendSMS resolves the contact within the caller's account and sends the
+// requested message to its primary phone number using the configured SMSSender.
+func (s *contactsServer) SendSMS(ctx context.Context, in *pb.SMSRequest) (*pb.SMSResponse, error) {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	id, err := resource.Encode(&pb.Contact{}, int64(in.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	// the contact is read outside of the request transaction, so it isn't
+	// held open while the message is sent
+	contact, err := pb.DefaultReadContact(ctx, &pb.Contact{Id: id}, s.db)
+	if err != nil {
+		return nil, err
+	}
+	if contact.GetPrimaryPhone() == "" {
+		return nil, errors.NewContainer(codes.FailedPrecondition, "The contact has no primary phone number.")
+	}
+
+	delivery, err := s.sender.Send(ctx, &SMSMessage{
+		AccountID: accountID,
+		Contact:   contact,
+		To:        contact.GetPrimaryPhone(),
+		Text:      in.GetMessage(),
+	})
+	if err != nil {
+		return nil, errors.NewContainer(codes.Unavailable, "Unable to deliver SMS message: %v", err)
+	}
+
+	return &pb.SMSResponse{DeliveryId: delivery.ID, Status: delivery.Status}, nil
+}
+
+// NewAuditLogServer returns an instance of the default audit log server interface
+func NewAuditLogServer() (pb.AuditLogServer, error) {
+	return &auditLogServer{&pb.AuditLogDefaultServer{}}, nil
+}
+
+type auditLogServer struct {
+	*pb.AuditLogDefaultServer
+}
+
+// List lists contacts with support of filtering by the synthetic fields
+// (primary_email, primary_phone) and adds application specific page token
+// implementation.
 // Actually the service supports "composite" pagination in a specific way:
 // - limit and offset are still supported but without page token
 // - if an user requests page token and provides limit then limit value will be
@@ -68,7 +513,7 @@ func (s *contactsServer) List(ctx context.Context, in *pb.ListContactRequest) (*
 	ptoken := page.GetPageToken()
 	// do not handle page token
 	if ptoken == "" {
-		return s.ContactsDefaultServer.List(ctx, in)
+		return listContacts(ctx, in)
 	}
 
 	// decode provided token (null means a client is requesting new token)
@@ -84,8 +529,7 @@ func (s *contactsServer) List(ctx context.Context, in *pb.ListContactRequest) (*
 		}
 	}
 
-	// forward request to default implementation
-	resp, err := s.ContactsDefaultServer.List(ctx, in)
+	resp, err := listContacts(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +548,24 @@ func (s *contactsServer) List(ctx context.Context, in *pb.ListContactRequest) (*
 	return resp, nil
 }
 
+// listContacts lists the contacts in the request transaction with support of
+// filtering by the synthetic fields
+func listContacts(ctx context.Context, in *pb.ListContactRequest) (*pb.ListContactsResponse, error) {
+	db, err := pb.RequestDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, db, err = in.BeforeList(ctx, in, db)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pb.ListContacts(ctx, db, in)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListContactsResponse{Results: res}, nil
+}
+
 // DecodePageToken decodes page token from the user's request.
 // Return error if provided token is malformed or contains ivalid values,
 // otherwise return offset, limit.
@@ -137,7 +599,7 @@ func DecodePageToken(ptoken string) (offset, limit int32, err error) {
 		return 0, 0, errC
 	}
 
-	return limit, offset, nil
+	return offset, limit, nil
 }
 
 // EncodePageToken encodes offset and limit to a string in application specific
@@ -146,3 +608,100 @@ func EncodePageToken(offset, limit int32) string {
 	data := fmt.Sprintf("%d:%d", offset, limit)
 	return base64.StdEncoding.EncodeToString([]byte(data))
 }
+
+// requestedPage returns the pagination of a request with the offset and the
+// limit of its page token applied, the page token itself is kept
+func requestedPage(in *query.Pagination) (*query.Pagination, error) {
+	page := &query.Pagination{Offset: in.GetOffset(), Limit: in.GetLimit(), PageToken: in.GetPageToken()}
+	if ptoken := page.GetPageToken(); ptoken != "" && ptoken != "null" {
+		var err error
+		if page.Offset, page.Limit, err = DecodePageToken(ptoken); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// setPageToken sets the page info with the token of the page which follows
+// the page of n results, the token is "null" after the last page. Nothing is
+// set unless the request has a page token.
+func setPageToken(ctx context.Context, page *query.Pagination, n int) error {
+	if page.GetPageToken() == "" {
+		return nil
+	}
+	var pinfo query.PageInfo
+	if n == 0 {
+		pinfo.SetLastToken()
+	} else {
+		pinfo.PageToken = EncodePageToken(page.GetOffset()+int32(n), page.DefaultLimit())
+	}
+	return gateway.SetPageInfo(ctx, &pinfo)
+}
+
+// deleteInAccount deletes the row with the given id from the table of model
+// only if it belongs to the caller's account. If the model has a DeletedAt
+// field gorm only marks the row as deleted and keeps its child rows.
+// gorm.ErrRecordNotFound is returned if no row was deleted, so a tenant
+// cannot tell whether the id exists in another account.
+func deleteInAccount(ctx context.Context, db *gorm.DB, model interface{}, id int64) error {
+	if id == 0 {
+		return errors.NewContainer(codes.InvalidArgument, "A non-zero ID value is required for a delete call.")
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	res := db.Where("account_id = ? AND id = ?", accountID, id).Delete(model)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// undeleteInAccount clears the deletion mark of the row with the given id
+// from the table of model if it belongs to the caller's account.
+// gorm.ErrRecordNotFound is returned if there is no such deleted row.
+func undeleteInAccount(ctx context.Context, db *gorm.DB, model interface{}, id int64) error {
+	if id == 0 {
+		return errors.NewContainer(codes.InvalidArgument, "A non-zero ID value is required for an undelete call.")
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	res := db.Unscoped().Model(model).
+		Where("account_id = ? AND id = ? AND deleted_at IS NOT NULL", accountID, id).
+		UpdateColumns(map[string]interface{}{
+			"deleted_at": gorm.Expr("NULL"),
+			// the row has changed, so Sync reports it again
+			"updated_at": gorm.Expr("current_timestamp"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// parentInAccount returns NotFound naming the parent resource if the caller's
+// account has no row with the given id in the table of model which isn't
+// deleted. The nested calls check their parent with it.
+func parentInAccount(ctx context.Context, db *gorm.DB, model interface{}, name string, id int64) error {
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var count int
+	if err := db.Model(model).Where("account_id = ? AND id = ?", accountID, id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NewContainer(codes.NotFound, "The %s %d does not exist.", name, id)
+	}
+	return nil
+}
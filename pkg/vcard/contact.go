@@ -0,0 +1,245 @@
+package vcard
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/infobloxopen/protoc-gen-gorm/types"
+)
+
+// revFormat is the format of the REV property
+const revFormat = "20060102T150405Z"
+
+// phoneTypes maps the phone number types to the values of the TYPE parameter
+var phoneTypes = map[pb.PhoneNumber_Type]string{
+	pb.PhoneNumber_MOBILE: "cell",
+	pb.PhoneNumber_HOME:   "home",
+	pb.PhoneNumber_WORK:   "work",
+}
+
+// FromContact returns the vCard of the contact in the version, Version3 or
+// Version4. The primary e-mail address and phone number are the preferred ones.
+func FromContact(c *pb.Contact, version string) Card {
+	card := Card{
+		{Name: "FN", Value: Escape(fullName(c))},
+		{Name: "N", Value: JoinValue([]string{c.GetLastName(), c.GetFirstName(), c.GetMiddleName(), "", ""}, ";")},
+	}
+
+	var nicknames []string
+	if err := json.Unmarshal([]byte(c.GetNicknames().GetValue()), &nicknames); err == nil && len(nicknames) > 0 {
+		card = append(card, &Property{Name: "NICKNAME", Value: JoinValue(nicknames, ",")})
+	}
+
+	if c.GetPrimaryEmail() != "" {
+		card = append(card, &Property{Name: "EMAIL", Params: typeParams(version, true, "internet"), Value: Escape(c.GetPrimaryEmail())})
+	}
+	for _, e := range c.GetEmails() {
+		if e.GetAddress() != c.GetPrimaryEmail() {
+			card = append(card, &Property{Name: "EMAIL", Params: typeParams(version, false, "internet"), Value: Escape(e.GetAddress())})
+		}
+	}
+
+	if c.GetPrimaryPhone() != "" {
+		card = append(card, &Property{Name: "TEL", Params: typeParams(version, true, phoneType(c, c.GetPrimaryPhone())), Value: c.GetPrimaryPhone()})
+	}
+	for _, p := range c.GetPhoneNumbers() {
+		if p.GetNumber() != c.GetPrimaryPhone() {
+			card = append(card, &Property{Name: "TEL", Params: typeParams(version, false, phoneTypes[p.GetType()]), Value: p.GetNumber()})
+		}
+	}
+
+	if a := c.GetHomeAddress(); a != nil {
+		card = append(card, &Property{Name: "ADR", Params: typeParams(version, false, "home"), Value: addressValue(a)})
+	}
+	if a := c.GetWorkAddress(); a != nil {
+		card = append(card, &Property{Name: "ADR", Params: typeParams(version, false, "work"), Value: addressValue(a)})
+	}
+
+	if c.GetNotes() != "" {
+		card = append(card, &Property{Name: "NOTE", Value: Escape(c.GetNotes())})
+	}
+	if updated, err := ptypes.Timestamp(c.GetUpdatedAt()); c.GetUpdatedAt() != nil && err == nil {
+		card = append(card, &Property{Name: "REV", Value: updated.UTC().Format(revFormat)})
+	}
+	return card
+}
+
+// ToContact returns the contact described by the vCard of any version.
+// The preferred e-mail address and phone number, or the first ones if none
+// is preferred, are the primary ones. The first address with the work type
+// is the work address, the first other address is the home address.
+func ToContact(card Card) *pb.Contact {
+	c := &pb.Contact{}
+
+	if n := card.Get("N"); n != nil {
+		names := append(SplitValue(n.Value, ';'), "", "", "")
+		c.LastName, c.FirstName, c.MiddleName = names[0], names[1], names[2]
+	}
+	if fn := card.Get("FN"); fn != nil && c.FirstName == "" && c.LastName == "" {
+		c.FirstName = Unescape(fn.Value)
+	}
+
+	var nicknames []string
+	for _, p := range card.All("NICKNAME") {
+		for _, n := range SplitValue(p.Value, ',') {
+			if n = strings.TrimSpace(n); n != "" {
+				nicknames = append(nicknames, n)
+			}
+		}
+	}
+	if len(nicknames) > 0 {
+		data, _ := json.Marshal(nicknames)
+		c.Nicknames = &types.JSONValue{Value: string(data)}
+	}
+
+	emails := preferred(card.All("EMAIL"))
+	for _, p := range emails {
+		c.Emails = append(c.Emails, &pb.Email{Address: strings.TrimSpace(Unescape(p.Value))})
+	}
+	if len(emails) > 0 {
+		c.PrimaryEmail = c.Emails[0].Address
+	}
+
+	phones := preferred(card.All("TEL"))
+	for _, p := range phones {
+		phone := &pb.PhoneNumber{Number: phoneNumber(p.Value)}
+		switch {
+		case p.HasType("work"):
+			phone.Type = pb.PhoneNumber_WORK
+		case p.HasType("home"):
+			phone.Type = pb.PhoneNumber_HOME
+		}
+		c.PhoneNumbers = append(c.PhoneNumbers, phone)
+	}
+	if len(phones) > 0 {
+		c.PrimaryPhone = c.PhoneNumbers[0].Number
+	}
+
+	for _, p := range card.All("ADR") {
+		if p.HasType("work") {
+			if c.WorkAddress == nil {
+				c.WorkAddress = address(p.Value)
+			}
+		} else if c.HomeAddress == nil {
+			c.HomeAddress = address(p.Value)
+		}
+	}
+
+	notes := []string{}
+	for _, p := range card.All("NOTE") {
+		notes = append(notes, Unescape(p.Value))
+	}
+	c.Notes = strings.Join(notes, "\n")
+
+	return c
+}
+
+func fullName(c *pb.Contact) string {
+	names := []string{}
+	for _, n := range []string{c.GetFirstName(), c.GetMiddleName(), c.GetLastName()} {
+		if n != "" {
+			names = append(names, n)
+		}
+	}
+	if len(names) == 0 {
+		return c.GetPrimaryEmail()
+	}
+	return strings.Join(names, " ")
+}
+
+// typeParams returns the parameters with the type, the preferred value is
+// marked by the PREF parameter in vCard 4.0 and by the "pref" type in 3.0
+func typeParams(version string, pref bool, typ string) map[string][]string {
+	params := map[string][]string{}
+	if version == Version3 {
+		if typ != "" {
+			params["TYPE"] = append(params["TYPE"], strings.ToUpper(typ))
+		}
+		if pref {
+			params["TYPE"] = append(params["TYPE"], "PREF")
+		}
+		return params
+	}
+	// the internet type of e-mail addresses is obsolete in vCard 4.0
+	if typ != "" && typ != "internet" {
+		params["TYPE"] = []string{typ}
+	}
+	if pref {
+		params["PREF"] = []string{"1"}
+	}
+	return params
+}
+
+func phoneType(c *pb.Contact, number string) string {
+	for _, p := range c.GetPhoneNumbers() {
+		if p.GetNumber() == number {
+			return phoneTypes[p.GetType()]
+		}
+	}
+	return phoneTypes[pb.PhoneNumber_MOBILE]
+}
+
+// preferred sorts the properties by preference, the most preferred first.
+// The PREF parameter is 1 to 100 where 1 is the most preferred, the ones
+// without it follow in the order of the card.
+func preferred(props []*Property) []*Property {
+	rank := func(p *Property) int {
+		if p.HasType("pref") {
+			return 1
+		}
+		if vals := p.Params["PREF"]; len(vals) > 0 {
+			if pref, err := strconv.Atoi(vals[0]); err == nil && pref > 0 {
+				return pref
+			}
+		}
+		return 101
+	}
+	sort.SliceStable(props, func(i, j int) bool { return rank(props[i]) < rank(props[j]) })
+	return props
+}
+
+// phoneNumber strips the tel: scheme and the formatting of a phone number,
+// e.g. "tel:+1-415-555-2671" is "+14155552671"
+func phoneNumber(v string) string {
+	v = strings.TrimPrefix(strings.TrimSpace(Unescape(v)), "tel:")
+	if i := strings.Index(v, ";"); i >= 0 {
+		// URI parameters, e.g. ;ext=102
+		v = v[:i]
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) || r == '+' {
+			return r
+		}
+		return -1
+	}, v)
+}
+
+// addressValue returns the structured value of ADR, the address is the
+// street address
+func addressValue(a *pb.Address) string {
+	return JoinValue([]string{"", "", a.GetAddress(), a.GetCity(), a.GetState(), a.GetZip(), a.GetCountry()}, ";")
+}
+
+// address parses the structured value of ADR, the post office box and the
+// extended address precede the street address
+func address(v string) *pb.Address {
+	parts := append(SplitValue(v, ';'), "", "", "", "", "", "", "")
+	street := []string{}
+	for _, s := range parts[:3] {
+		if s = strings.TrimSpace(s); s != "" {
+			street = append(street, s)
+		}
+	}
+	return &pb.Address{
+		Address: strings.Join(street, ", "),
+		City:    parts[3],
+		State:   parts[4],
+		Zip:     parts[5],
+		Country: parts[6],
+	}
+}
@@ -0,0 +1,354 @@
+// Package vcard reads and writes contacts as vCards (RFC 6350 and RFC 2426).
+package vcard
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// Version3 is vCard 3.0 (RFC 2426)
+	Version3 = "3.0"
+	// Version4 is vCard 4.0 (RFC 6350)
+	Version4 = "4.0"
+	// ContentType is the media type of vCard files
+	ContentType = "text/vcard"
+)
+
+// maxLineLength is the maximum length of a written line in octets, longer
+// lines are folded
+const maxLineLength = 75
+
+// Property is a content line of a vCard, e.g. "EMAIL;TYPE=work:a@b.com"
+type Property struct {
+	// Group is the optional group of the property, e.g. "item1"
+	Group string
+	// Name is the upper case name of the property, e.g. "EMAIL"
+	Name string
+	// Params are the parameters of the property by their upper case names
+	Params map[string][]string
+	// Value is the raw value, which is escaped as it is in the file
+	Value string
+}
+
+// HasType reports whether the TYPE parameter of the property has the value,
+// the comparison is case-insensitive
+func (p *Property) HasType(t string) bool {
+	for _, v := range p.Params["TYPE"] {
+		for _, v := range strings.Split(v, ",") {
+			if strings.EqualFold(v, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Card is a vCard, the list of its properties in the order of the file
+// without BEGIN and END
+type Card []*Property
+
+// Get returns the first property with the name or nil
+func (c Card) Get(name string) *Property {
+	for _, p := range c {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// All returns the properties with the name
+func (c Card) All(name string) []*Property {
+	props := []*Property{}
+	for _, p := range c {
+		if p.Name == name {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// Reader reads the cards of a vCard file
+type Reader struct {
+	r    *bufio.Reader
+	line int
+	// next is the line read ahead to find out whether the previous line continues
+	next    string
+	hasNext bool
+}
+
+// NewReader returns a Reader which reads the cards from r
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Read returns the next card, io.EOF is returned after the last one
+func (r *Reader) Read() (Card, error) {
+	var card Card
+	inCard := false
+	// depth counts the cards nested in the current one, e.g. in AGENT
+	depth := 0
+	for {
+		line, err := r.readLine()
+		if err == io.EOF && inCard {
+			return nil, fmt.Errorf("line %d: missing END:VCARD", r.line)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", r.line, err)
+		}
+		switch {
+		case !inCard:
+			if p.Name != "BEGIN" || !strings.EqualFold(p.Value, "VCARD") {
+				return nil, fmt.Errorf("line %d: expected BEGIN:VCARD", r.line)
+			}
+			inCard = true
+		case p.Name == "BEGIN" && strings.EqualFold(p.Value, "VCARD"):
+			depth++
+		case p.Name == "END" && strings.EqualFold(p.Value, "VCARD"):
+			if depth == 0 {
+				return card, nil
+			}
+			depth--
+		case depth == 0:
+			card = append(card, p)
+		}
+	}
+}
+
+// readLine returns the next unfolded content line
+func (r *Reader) readLine() (string, error) {
+	line, err := r.readRawLine()
+	if err != nil {
+		return "", err
+	}
+	for {
+		next, err := r.readRawLine()
+		if err == io.EOF {
+			return line, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		if next == "" || (next[0] != ' ' && next[0] != '\t') {
+			r.next, r.hasNext = next, true
+			return line, nil
+		}
+		// a folded line continues after the leading white space
+		line += next[1:]
+	}
+}
+
+func (r *Reader) readRawLine() (string, error) {
+	if r.hasNext {
+		r.hasNext = false
+		return r.next, nil
+	}
+	line, err := r.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	r.line++
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// parseProperty parses an unfolded content line
+func parseProperty(line string) (*Property, error) {
+	// the name and the parameters end at the first colon outside quotes
+	end := -1
+	quoted := false
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("missing colon in %q", line)
+	}
+
+	p := &Property{Params: map[string][]string{}, Value: line[end+1:]}
+	parts := splitUnquoted(line[:end], ';')
+	p.Name = strings.ToUpper(parts[0])
+	if i := strings.LastIndex(p.Name, "."); i >= 0 {
+		p.Group, p.Name = parts[0][:i], p.Name[i+1:]
+	}
+	if p.Name == "" {
+		return nil, fmt.Errorf("missing property name in %q", line)
+	}
+	for _, param := range parts[1:] {
+		name, value := "TYPE", param
+		if i := strings.Index(param, "="); i >= 0 {
+			name, value = strings.ToUpper(param[:i]), param[i+1:]
+		}
+		// vCard 2.1 parameters have no name, e.g. EMAIL;HOME
+		p.Params[name] = append(p.Params[name], strings.Trim(value, `"`))
+	}
+	return p, nil
+}
+
+func splitUnquoted(s string, sep rune) []string {
+	parts := []string{}
+	quoted := false
+	start := 0
+	for i, c := range s {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == sep && !quoted {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// Writer writes cards to a vCard file
+type Writer struct {
+	w   io.Writer
+	err error
+}
+
+// NewWriter returns a Writer which writes the cards to w
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes the card with the version, a VERSION property of the card,
+// e.g. of a card which was read, is replaced
+func (w *Writer) Write(card Card, version string) error {
+	w.writeLine("BEGIN:VCARD")
+	w.writeLine("VERSION:" + version)
+	for _, p := range card {
+		if p.Name != "VERSION" {
+			w.writeProperty(p)
+		}
+	}
+	w.writeLine("END:VCARD")
+	return w.err
+}
+
+func (w *Writer) writeProperty(p *Property) {
+	var b bytes.Buffer
+	if p.Group != "" {
+		b.WriteString(p.Group + ".")
+	}
+	b.WriteString(p.Name)
+	for _, name := range sortedKeys(p.Params) {
+		for _, v := range p.Params[name] {
+			b.WriteString(";" + name + "=")
+			// commas separate the values of a list, they aren't quoted
+			if strings.ContainsAny(v, ":;") {
+				v = `"` + v + `"`
+			}
+			b.WriteString(v)
+		}
+	}
+	b.WriteString(":" + p.Value)
+	w.writeLine(b.String())
+}
+
+// writeLine writes the content line folded to lines of at most maxLineLength
+// octets, a multi-byte character is never split
+func (w *Writer) writeLine(line string) {
+	if w.err != nil {
+		return
+	}
+	var b bytes.Buffer
+	n := 0
+	for _, c := range line {
+		size := len(string(c))
+		if n+size > maxLineLength {
+			b.WriteString("\r\n ")
+			n = 1
+		}
+		b.WriteRune(c)
+		n += size
+	}
+	b.WriteString("\r\n")
+	_, w.err = io.WriteString(w.w, b.String())
+}
+
+// Escape escapes a text value, e.g. of NOTE
+func Escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", "", ",", `\,`, ";", `\;`).Replace(s)
+}
+
+// Unescape unescapes a text value
+func Unescape(s string) string {
+	var b bytes.Buffer
+	escaped := false
+	for _, c := range s {
+		switch {
+		case escaped && (c == 'n' || c == 'N'):
+			b.WriteRune('\n')
+			escaped = false
+		case escaped:
+			b.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// SplitValue splits a value at the separators which aren't escaped, e.g. the
+// components of a structured value at ';' or the values of a list at ','.
+// The parts are unescaped.
+func SplitValue(s string, sep rune) []string {
+	parts := []string{}
+	var b bytes.Buffer
+	escaped := false
+	for _, c := range s {
+		switch {
+		case escaped:
+			b.WriteRune('\\')
+			b.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == sep:
+			parts = append(parts, Unescape(b.String()))
+			b.Reset()
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return append(parts, Unescape(b.String()))
+}
+
+// JoinValue escapes and joins the parts of a structured or a list value
+func JoinValue(parts []string, sep string) string {
+	escaped := make([]string, len(parts))
+	for i, p := range parts {
+		escaped[i] = Escape(p)
+	}
+	return strings.Join(escaped, sep)
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// nonPublicNetworks are the private and shared address ranges which aren't
+// covered by the methods of net.IP
+var nonPublicNetworks = parseCIDRs(
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"100.64.0.0/10",
+	"fc00::/7",
+)
+
+// ValidateURL returns an error unless raw is an absolute http or https URL
+// with a host
+func ValidateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("the scheme must be http or https")
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("the host is missing")
+	}
+	return nil
+}
+
+// NewClient returns the HTTP client the deliveries are posted with. Unless
+// allowPrivate is set, the client refuses to connect to private, loopback and
+// link-local addresses, so the subscribers can't reach the internal network,
+// not even by a redirect or a host name which resolves to such an address.
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	if !allowPrivate {
+		transport.DialContext = dialPublic(dialer)
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// dialPublic returns a dial function which resolves the host itself and
+// connects to the checked address, so a second lookup can't return another one
+func dialPublic(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("no address found for %s", host)
+		}
+		for _, ip := range ips {
+			if !publicIP(ip.IP) {
+				return nil, fmt.Errorf("%s resolves to the non-public address %s", host, ip.IP)
+			}
+		}
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].IP.String(), port))
+	}
+}
+
+// publicIP reports whether ip is routable on the internet
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return false
+	}
+	for _, n := range nonPublicNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets[i] = n
+	}
+	return nets
+}
@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/outbox"
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+)
+
+const (
+	// SignatureHeader is the HTTP header with the HMAC-SHA256 signature of the
+	// request body, its format is "sha256=<hex encoded signature>"
+	SignatureHeader = "X-Webhook-Signature"
+	// EventHeader is the HTTP header with the event type, e.g. contact.created
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader is the HTTP header with the id of the delivery, it is the
+	// same for all the attempts to deliver an event
+	DeliveryHeader = "X-Webhook-Delivery"
+)
+
+// Event is the JSON body posted to the subscribers
+type Event struct {
+	// ID identifies the event, it is the same for all the subscriptions and
+	// for all the deliveries of an event published more than once
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	AccountID string    `json:"account_id"`
+	CreatedAt time.Time `json:"created_at"`
+	// Data is the resource after the change, only its id is set if the
+	// resource was deleted
+	Data json.RawMessage `json:"data"`
+}
+
+// Sign returns the value of the signature header of body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates a random secret for a subscription
+func NewSecret() (string, error) {
+	return randomHex(32)
+}
+
+type publisher struct {
+	db *gorm.DB
+}
+
+// NewPublisher returns an outbox.EventPublisher which queues a delivery of
+// every event to the subscriptions interested in it. The deliveries are sent
+// by the Worker.
+func NewPublisher(db *gorm.DB) outbox.EventPublisher {
+	return &publisher{db: db}
+}
+
+// Publish creates a pending delivery of the event for every enabled
+// subscription of the event's account interested in the event type
+func (p *publisher) Publish(ctx context.Context, e *outbox.Event) error {
+	subs := []pb.WebhookSubscriptionORM{}
+	if err := p.db.Where("account_id = ? AND NOT disabled", e.AccountID).Find(&subs).Error; err != nil {
+		return err
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(&Event{
+		ID:        strconv.FormatInt(e.ID, 10),
+		Type:      e.Type,
+		AccountID: e.AccountID,
+		CreatedAt: e.CreatedAt,
+		Data:      e.Data(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// the deliveries of an event are queued at once, so the event is queued
+	// again for all the subscriptions if it has to be published again
+	tx := p.db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	for _, sub := range subs {
+		if !subscribed(&sub, e.Type) {
+			continue
+		}
+		delivery := &pb.WebhookDeliveryORM{
+			AccountID:      e.AccountID,
+			SubscriptionId: sub.Id,
+			EventType:      e.Type,
+			Payload:        &postgres.Jsonb{RawMessage: body},
+			Status:         int32(pb.WebhookDelivery_PENDING),
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		}
+		if err := tx.Create(delivery).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
+// subscribed reports whether the subscription receives events of the type
+func subscribed(sub *pb.WebhookSubscriptionORM, eventType string) bool {
+	if sub.EventTypes == "" {
+		return true
+	}
+	for _, t := range strings.Split(sub.EventTypes, ",") {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("unable to generate random bytes: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
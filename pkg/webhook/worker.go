@@ -0,0 +1,241 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"expvar"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/infobloxopen/atlas-contacts-app/pkg/pb"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts used if a non-positive one is provided
+	DefaultMaxAttempts = 8
+	// DefaultBackoff is the delay before the first retry used if a non-positive one is provided
+	DefaultBackoff = 30 * time.Second
+	// MaxBackoff is the maximum delay between two attempts
+	MaxBackoff = time.Hour
+)
+
+var (
+	// batchSize is the maximum number of deliveries claimed at once
+	batchSize = 10
+	// claimLease is the period the claimed deliveries aren't due for, they are
+	// attempted again after it if the worker stops before it records them
+	claimLease = 5 * time.Minute
+)
+
+var webhookDeliveries = expvar.NewMap("webhook_deliveries")
+
+// Worker posts the pending deliveries to the subscribers
+type Worker struct {
+	db          *gorm.DB
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	logger      logrus.FieldLogger
+}
+
+// NewWorker returns a worker which makes at most maxAttempts attempts to
+// deliver an event. The delay before a retry starts at backoff and doubles
+// after every failed attempt.
+func NewWorker(db *gorm.DB, client *http.Client, maxAttempts int, backoff time.Duration, logger logrus.FieldLogger) *Worker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+	return &Worker{db: db, client: client, maxAttempts: maxAttempts, backoff: backoff, logger: logger}
+}
+
+// Run delivers the pending deliveries every interval until ctx is done
+func (w *Worker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Deliver(ctx); err != nil {
+			w.logger.Errorf("unable to deliver webhooks: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Deliver makes an attempt to deliver every pending delivery which is due and
+// returns the number of attempts. Deliveries locked by other replicas are skipped.
+func (w *Worker) Deliver(ctx context.Context) (int, error) {
+	var total int
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		n, err := w.deliverBatch(ctx)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if n < batchSize {
+			return total, nil
+		}
+	}
+}
+
+func (w *Worker) deliverBatch(ctx context.Context) (int, error) {
+	deliveries, err := w.claim()
+	if err != nil {
+		return 0, err
+	}
+
+	// the subscribers are posted to outside of any transaction, so a slow one
+	// holds neither row locks nor a database connection
+	for i := range deliveries {
+		d := &deliveries[i]
+		sub := pb.WebhookSubscriptionORM{}
+		err := w.db.Where("account_id = ? AND id = ?", d.AccountID, d.SubscriptionId).First(&sub).Error
+		switch {
+		case err == gorm.ErrRecordNotFound:
+			w.fail(d, 0, "the subscription was deleted")
+		case err != nil:
+			return i, err
+		case sub.Disabled:
+			w.fail(d, 0, "the subscription is disabled")
+		default:
+			w.attempt(ctx, d, &sub)
+		}
+		if err := w.db.Save(d).Error; err != nil {
+			return i, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// claim returns the pending deliveries which are due and postpones them by
+// the claim lease, so neither this nor another replica attempts them again
+// until their outcome is recorded. Deliveries locked by other replicas are skipped.
+func (w *Worker) claim() ([]pb.WebhookDeliveryORM, error) {
+	tx := w.db.Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	deliveries := []pb.WebhookDeliveryORM{}
+	if err := tx.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED").
+		Where("status = ? AND next_attempt_at <= ?", int32(pb.WebhookDelivery_PENDING), now).
+		Order("id").Limit(batchSize).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, len(deliveries))
+	for i, d := range deliveries {
+		ids[i] = d.Id
+	}
+	if err := tx.Model(&pb.WebhookDeliveryORM{}).Where("id IN (?)", ids).
+		UpdateColumn("next_attempt_at", now.Add(claimLease)).Error; err != nil {
+		return nil, err
+	}
+	return deliveries, tx.Commit().Error
+}
+
+// attempt posts the delivery to the subscription url and records the outcome
+func (w *Worker) attempt(ctx context.Context, d *pb.WebhookDeliveryORM, sub *pb.WebhookSubscriptionORM) {
+	d.Attempts++
+
+	code, err := w.post(ctx, d, sub)
+	if err == nil {
+		d.Status = int32(pb.WebhookDelivery_SUCCEEDED)
+		d.LastStatusCode = int32(code)
+		d.LastError = ""
+		webhookDeliveries.Add("succeeded", 1)
+		return
+	}
+
+	if int(d.Attempts) >= w.maxAttempts {
+		w.fail(d, code, err.Error())
+		return
+	}
+	d.LastStatusCode = int32(code)
+	d.LastError = err.Error()
+	d.NextAttemptAt = time.Now().UTC().Add(w.delay(int(d.Attempts)))
+	webhookDeliveries.Add("retried", 1)
+
+	w.logger.WithFields(logrus.Fields{
+		"delivery": d.Id,
+		"attempts": d.Attempts,
+		"next":     d.NextAttemptAt,
+	}).Debugf("webhook delivery failed: %v", err)
+}
+
+func (w *Worker) fail(d *pb.WebhookDeliveryORM, code int, reason string) {
+	d.Status = int32(pb.WebhookDelivery_FAILED)
+	d.LastStatusCode = int32(code)
+	d.LastError = reason
+	webhookDeliveries.Add("failed", 1)
+
+	w.logger.WithFields(logrus.Fields{
+		"delivery": d.Id,
+		"attempts": d.Attempts,
+	}).Warnf("webhook delivery failed permanently: %s", reason)
+}
+
+// delay returns the delay after the given number of failed attempts
+func (w *Worker) delay(attempts int) time.Duration {
+	delay := w.backoff
+	for i := 1; i < attempts && delay < MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > MaxBackoff {
+		delay = MaxBackoff
+	}
+	return delay
+}
+
+// post sends the signed payload of the delivery and returns the HTTP status
+// code, any status other than 2xx is an error
+func (w *Worker) post(ctx context.Context, d *pb.WebhookDeliveryORM, sub *pb.WebhookSubscriptionORM) (int, error) {
+	var body []byte
+	if d.Payload != nil {
+		body = d.Payload.RawMessage
+	}
+
+	req, err := http.NewRequest(http.MethodPost, sub.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, body))
+	req.Header.Set(EventHeader, d.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(d.Id, 10))
+
+	resp, err := w.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("the subscriber responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}